@@ -0,0 +1,70 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// assertionLeeway is the clock skew allowed when checking the exp, nbf and iat
+// claims of JWT assertions.
+const assertionLeeway = 30 * time.Second
+
+// assertionAlgorithms lists the signature algorithms accepted for JWT
+// assertions.
+var assertionAlgorithms = []string{"RS256", "PS256", "ES256", "EdDSA"}
+
+// assertionAudiences returns the audiences a JWT assertion may be issued
+// for: the issuer identifier, the token endpoint and the requested endpoint.
+func assertionAudiences(r *http.Request) []string {
+	return []string{issuer, issuer + "/token", issuer + r.URL.Path}
+}
+
+// parseUnverifiedAssertion reads the claims of a JWT assertion without
+// verifying it, to find out who issued it.
+func parseUnverifiedAssertion(assertion string) (*jwt.RegisteredClaims, error) {
+	claims := &jwt.RegisteredClaims{}
+	if _, _, err := jwt.NewParser().ParseUnverified(assertion, claims); err != nil {
+		return nil, err
+	}
+	return claims, nil
+}
+
+// verifyAssertion verifies the signature of a JWT assertion and its exp, nbf,
+// iat and aud claims. The jti and exp claims are required.
+func verifyAssertion(assertion string, keyfunc jwt.Keyfunc, methods []string, audiences []string) (*jwt.RegisteredClaims, error) {
+	claims := &jwt.RegisteredClaims{}
+	_, err := jwt.ParseWithClaims(assertion, claims, keyfunc,
+		jwt.WithValidMethods(methods),
+		jwt.WithExpirationRequired(),
+		jwt.WithIssuedAt(),
+		jwt.WithLeeway(assertionLeeway),
+	)
+	if err != nil {
+		return nil, err
+	}
+	if claims.ID == "" {
+		return nil, jwt.ErrTokenRequiredClaimMissing
+	}
+	if !slices.ContainsFunc(claims.Audience, func(aud string) bool {
+		return slices.Contains(audiences, aud)
+	}) {
+		return nil, jwt.ErrTokenInvalidAudience
+	}
+	return claims, nil
+}
+
+// useAssertionID records the jti of an assertion for as long as the assertion
+// is accepted, until its exp plus the leeway, and reports whether it was seen
+// before.
+func useAssertionID(ctx context.Context, claims *jwt.RegisteredClaims) (replayed bool, err error) {
+	ttl := time.Until(claims.ExpiresAt.Time) + assertionLeeway
+	ok, err := stateStore.SetNX(ctx, "jti:"+claims.Issuer+":"+claims.ID, []byte{1}, ttl)
+	if err != nil {
+		return false, err
+	}
+	return !ok, nil
+}
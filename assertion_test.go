@@ -0,0 +1,266 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-oauth2/oauth2/v4/errors"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+
+	"github.com/byebyebymyai/oauth2-api/ent"
+)
+
+// newTestAssertion signs a client assertion with an HMAC secret. Claims set
+// to nil are left out.
+func newTestAssertion(t *testing.T, method jwt.SigningMethod, secret string, claims jwt.MapClaims) string {
+	t.Helper()
+	for k, v := range claims {
+		if v == nil {
+			delete(claims, k)
+		}
+	}
+	assertion, err := jwt.NewWithClaims(method, claims).SignedString([]byte(secret))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return assertion
+}
+
+func TestVerifyAssertion(t *testing.T) {
+	const secret = "secret"
+	keyfunc := func(*jwt.Token) (interface{}, error) { return []byte(secret), nil }
+	audiences := []string{"https://as.example.com", "https://as.example.com/token"}
+
+	tests := []struct {
+		name    string
+		method  jwt.SigningMethod
+		secret  string
+		claims  jwt.MapClaims
+		wantErr bool
+	}{
+		{
+			name:   "valid",
+			claims: jwt.MapClaims{},
+		},
+		{
+			name:   "token endpoint audience",
+			claims: jwt.MapClaims{"aud": []string{"https://other.example.com", "https://as.example.com/token"}},
+		},
+		{
+			name:    "jti missing",
+			claims:  jwt.MapClaims{"jti": nil},
+			wantErr: true,
+		},
+		{
+			name:    "exp missing",
+			claims:  jwt.MapClaims{"exp": nil},
+			wantErr: true,
+		},
+		{
+			name:    "expired",
+			claims:  jwt.MapClaims{"exp": time.Now().Add(-time.Minute).Unix()},
+			wantErr: true,
+		},
+		{
+			name:    "issued in the future",
+			claims:  jwt.MapClaims{"iat": time.Now().Add(time.Minute).Unix()},
+			wantErr: true,
+		},
+		{
+			name:    "wrong audience",
+			claims:  jwt.MapClaims{"aud": "https://other.example.com"},
+			wantErr: true,
+		},
+		{
+			name:    "audience missing",
+			claims:  jwt.MapClaims{"aud": nil},
+			wantErr: true,
+		},
+		{
+			name:    "disallowed algorithm",
+			method:  jwt.SigningMethodHS384,
+			claims:  jwt.MapClaims{},
+			wantErr: true,
+		},
+		{
+			name:    "wrong secret",
+			secret:  "other",
+			claims:  jwt.MapClaims{},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			claims := jwt.MapClaims{
+				"iss": "client",
+				"sub": "client",
+				"aud": "https://as.example.com",
+				"exp": time.Now().Add(time.Minute).Unix(),
+				"iat": time.Now().Unix(),
+				"jti": uuid.NewString(),
+			}
+			for k, v := range tt.claims {
+				claims[k] = v
+			}
+			method, key := tt.method, tt.secret
+			if method == nil {
+				method = jwt.SigningMethodHS256
+			}
+			if key == "" {
+				key = secret
+			}
+			assertion := newTestAssertion(t, method, key, claims)
+
+			got, err := verifyAssertion(assertion, keyfunc, []string{"HS256"}, audiences)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("verifyAssertion() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && got.Subject != "client" {
+				t.Errorf("verifyAssertion() subject = %q, want %q", got.Subject, "client")
+			}
+		})
+	}
+}
+
+func TestUseAssertionID(t *testing.T) {
+	ctx := context.Background()
+	claims := func(iss, jti string) *jwt.RegisteredClaims {
+		return &jwt.RegisteredClaims{Issuer: iss, ID: jti, ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Minute))}
+	}
+
+	tests := []struct {
+		name   string
+		claims *jwt.RegisteredClaims
+		want   bool
+	}{
+		{"first use", claims("client-a", "jti-1"), false},
+		{"replayed", claims("client-a", "jti-1"), true},
+		{"same jti of another client", claims("client-b", "jti-1"), false},
+		{"expired within the leeway", &jwt.RegisteredClaims{Issuer: "client-a", ID: "jti-2", ExpiresAt: jwt.NewNumericDate(time.Now().Add(-time.Second))}, false},
+		{"expired within the leeway replayed", &jwt.RegisteredClaims{Issuer: "client-a", ID: "jti-2", ExpiresAt: jwt.NewNumericDate(time.Now().Add(-time.Second))}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			replayed, err := useAssertionID(ctx, tt.claims)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if replayed != tt.want {
+				t.Errorf("useAssertionID() = %v, want %v", replayed, tt.want)
+			}
+		})
+	}
+
+	// the assertion is still accepted within the leeway after it expired
+	expiring := &jwt.RegisteredClaims{Issuer: "client-a", ID: "jti-3", ExpiresAt: &jwt.NumericDate{Time: time.Now().Add(100 * time.Millisecond)}}
+	if replayed, err := useAssertionID(ctx, expiring); err != nil || replayed {
+		t.Fatalf("useAssertionID() = %v, %v", replayed, err)
+	}
+	time.Sleep(200 * time.Millisecond)
+	if replayed, err := useAssertionID(ctx, expiring); err != nil || !replayed {
+		t.Errorf("useAssertionID() after exp = %v, %v, want the jti kept for the leeway", replayed, err)
+	}
+}
+
+func TestAuthenticateClientSecretJWT(t *testing.T) {
+	withSecret := addTestClient(&ent.Oauth2Client{Secret: "client-secret", TokenEndpointAuthMethod: "client_secret_jwt"})
+	withoutSecret := addTestClient(&ent.Oauth2Client{TokenEndpointAuthMethod: "client_secret_jwt"})
+	// a second secret, and an expired one, after a rotation
+	rotated := addTestClient(&ent.Oauth2Client{Secret: "client-secret", TokenEndpointAuthMethod: "client_secret_jwt"})
+	for _, s := range []struct {
+		secret  string
+		expires time.Duration
+	}{{"new-secret", time.Hour}, {"expired-secret", -time.Hour}} {
+		ciphertext, err := encryptClientSecret(s.secret)
+		if err != nil {
+			t.Fatal(err)
+		}
+		expiresAt := time.Now().Add(s.expires)
+		rotated.Edges.Secrets = append(rotated.Edges.Secrets, &ent.ClientSecret{Ciphertext: ciphertext, ExpiresAt: &expiresAt})
+	}
+	basic := addTestClient(&ent.Oauth2Client{Secret: "client-secret", TokenEndpointAuthMethod: "client_secret_basic"})
+	replayed := uuid.NewString()
+
+	tests := []struct {
+		name    string
+		client  *ent.Oauth2Client
+		secret  string
+		jti     string
+		subject string
+		want    error
+	}{
+		{name: "valid", client: withSecret, secret: "client-secret"},
+		{name: "first use", client: withSecret, secret: "client-secret", jti: replayed},
+		{name: "replayed", client: withSecret, secret: "client-secret", jti: replayed, want: errors.ErrInvalidClient},
+		{name: "wrong secret", client: withSecret, secret: "other", want: errors.ErrInvalidClient},
+		{name: "empty secret", client: withoutSecret, secret: "", want: errors.ErrInvalidClient},
+		{name: "old secret after rotation", client: rotated, secret: "client-secret"},
+		{name: "new secret after rotation", client: rotated, secret: "new-secret"},
+		{name: "expired secret", client: rotated, secret: "expired-secret", want: errors.ErrInvalidClient},
+		{name: "not registered for client_secret_jwt", client: basic, secret: "client-secret", want: errors.ErrInvalidClient},
+		{name: "subject differs from issuer", client: withSecret, secret: "client-secret", subject: "other", want: errors.ErrInvalidClient},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			jti, sub := tt.jti, tt.subject
+			if jti == "" {
+				jti = uuid.NewString()
+			}
+			if sub == "" {
+				sub = tt.client.GetID()
+			}
+			assertion := newTestAssertion(t, jwt.SigningMethodHS256, tt.secret, jwt.MapClaims{
+				"iss": tt.client.GetID(),
+				"sub": sub,
+				"aud": "https://as.example.com/token",
+				"exp": time.Now().Add(time.Minute).Unix(),
+				"iat": time.Now().Unix(),
+				"jti": jti,
+			})
+			form := url.Values{"client_assertion_type": {clientAssertionType}, "client_assertion": {assertion}}
+			r := httptest.NewRequest("POST", "/token", strings.NewReader(form.Encode()))
+			r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+			client, err := authenticateClientAssertion(r)
+			if err != tt.want {
+				t.Fatalf("authenticateClientAssertion() error = %v, want %v", err, tt.want)
+			}
+			if err == nil && client.GetID() != tt.client.GetID() {
+				t.Errorf("authenticateClientAssertion() = %q, want %q", client.GetID(), tt.client.GetID())
+			}
+		})
+	}
+}
+
+func TestAuthenticateClientPrivateKeyJWT(t *testing.T) {
+	key, jwks := newTestIssuer(t)
+	client := addTestClient(&ent.Oauth2Client{TokenEndpointAuthMethod: "private_key_jwt", Jwks: jwks})
+
+	token := jwt.NewWithClaims(jwt.SigningMethodES256, jwt.MapClaims{
+		"iss": client.GetID(),
+		"sub": client.GetID(),
+		"aud": "https://as.example.com/device_authorization",
+		"exp": time.Now().Add(time.Minute).Unix(),
+		"iat": time.Now().Unix(),
+		"jti": uuid.NewString(),
+	})
+	token.Header["kid"] = "issuer-key"
+	assertion, err := token.SignedString(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	status, data := postTestForm(t, deviceAuthorizationHandler, "/device_authorization", url.Values{
+		"client_assertion_type": {clientAssertionType},
+		"client_assertion":      {assertion},
+	})
+	if status != http.StatusOK || data["device_code"] == nil {
+		t.Fatalf("status = %d, body %v", status, data)
+	}
+}
@@ -0,0 +1,122 @@
+package main
+
+import (
+	"net/http"
+	"net/url"
+	"slices"
+
+	"github.com/go-oauth2/oauth2/v4"
+	"github.com/go-oauth2/oauth2/v4/errors"
+
+	"github.com/byebyebymyai/oauth2-api/ent"
+)
+
+// authorizeHandler handles authorization requests like
+// srv.HandleAuthorizeRequest, after resolving their pushed request or request
+// object, enforcing the policies of the client and asking the user for
+// consent.
+func authorizeHandler(w http.ResponseWriter, r *http.Request) {
+	err := resolveRequestURI(r)
+	if err == nil {
+		err = resolveRequestObject(r)
+	}
+	if err == nil {
+		err = handleAuthorizeRequest(w, r)
+	}
+	if err != nil {
+		errorLogger.Error("[authorizeHandle]", "error", err.Error())
+		http.Error(w, err.Error(), http.StatusBadRequest)
+	}
+}
+
+// resumeAuthorizeRequest handles the authorization request with params,
+// resolved before the user was asked to log in or consent.
+func resumeAuthorizeRequest(w http.ResponseWriter, r *http.Request, params url.Values) {
+	r.Form = params
+	r.PostForm = nil
+	if err := handleAuthorizeRequest(w, r); err != nil {
+		errorLogger.Error("[authorizeHandle]", "error", err.Error())
+		http.Error(w, err.Error(), http.StatusBadRequest)
+	}
+}
+
+// handleAuthorizeRequest handles the authorization request r, whose
+// parameters have been resolved.
+func handleAuthorizeRequest(w http.ResponseWriter, r *http.Request) error {
+	client, err := getOauth2Client(r.Context(), r.FormValue("client_id"))
+	if err != nil {
+		return errors.ErrInvalidClient
+	}
+	// the redirect URI must be valid before the user can deny
+	if err := validateAuthorizeRequest(r, client); err != nil {
+		return err
+	}
+	consented, err := checkConsent(w, r, client)
+	if err != nil || !consented {
+		return err
+	}
+	return srv.HandleAuthorizeRequest(w, r)
+}
+
+// validateAuthorizeRequest checks an authorization request of client as far
+// as possible without the user: the server and client policies, and the
+// redirect URI. A missing redirect URI is set to the only one the client
+// registered, and a missing scope to the default scopes.
+func validateAuthorizeRequest(r *http.Request, client *ent.Oauth2Client) error {
+	if err := defaultRedirectURI(r, client); err != nil {
+		return err
+	}
+	if r.FormValue("scope") == "" {
+		scope, err := defaultScope(r.Context(), client)
+		if err != nil {
+			return err
+		}
+		if scope != "" {
+			r.Form.Set("scope", scope)
+		}
+	}
+	req, err := srv.ValidationAuthorizeRequest(r)
+	if err != nil {
+		return err
+	}
+	if req.ClientID != client.GetID() {
+		return errors.ErrInvalidClient
+	}
+	if err := validateAuthorizePKCE(r); err != nil {
+		return err
+	}
+	if err := validateRedirectURI(client, req.RedirectURI); err != nil {
+		return err
+	}
+	if len(client.ResponseTypes) > 0 && !slices.Contains(client.ResponseTypes, req.ResponseType.String()) {
+		return errors.ErrUnsupportedResponseType
+	}
+
+	gt := oauth2.AuthorizationCode
+	if req.ResponseType == oauth2.Token {
+		gt = oauth2.Implicit
+	}
+	if fn := srv.ClientAuthorizedHandler; fn != nil {
+		allowed, err := fn(req.ClientID, gt)
+		if err != nil {
+			return err
+		}
+		if !allowed {
+			return errors.ErrUnauthorizedClient
+		}
+	}
+	if fn := srv.ClientScopeHandler; fn != nil {
+		allowed, err := fn(&oauth2.TokenGenerateRequest{
+			ClientID: req.ClientID,
+			Scope:    req.Scope,
+			Request:  r,
+		})
+		if err != nil {
+			return err
+		}
+		if !allowed {
+			return errors.ErrInvalidScope
+		}
+	}
+	return nil
+}
@@ -0,0 +1,130 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+	"strings"
+
+	"github.com/go-oauth2/oauth2/v4"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+
+	"github.com/byebyebymyai/oauth2-api/ent"
+)
+
+// rbacClaimSource names the source of the RBAC claims that are replaced with
+// a reference.
+const rbacClaimSource = "rbac"
+
+// rbacClaims are the RBAC claims of the user added to the access tokens of
+// clients with token_claims. When they are too large, the token refers to the
+// introspection endpoint instead, as OpenID Connect distributed claims.
+type rbacClaims struct {
+	Roles        []string                     `json:"roles,omitempty"`
+	Permissions  []string                     `json:"permissions,omitempty"`
+	Group        string                       `json:"group,omitempty"`
+	Position     string                       `json:"position,omitempty"`
+	ClaimNames   map[string]string            `json:"_claim_names,omitempty"`
+	ClaimSources map[string]map[string]string `json:"_claim_sources,omitempty"`
+}
+
+// compactPermission returns the compact form of a permission, such as
+// "GET api.example.com/orders".
+func compactPermission(p *Permission) string {
+	return strings.ToUpper(p.Method) + " " + p.Host + p.Path
+}
+
+// newRBACClaims maps the user to the claims in names.
+func newRBACClaims(user User, names []string) *rbacClaims {
+	c := &rbacClaims{}
+	roles := userRoles(user)
+	if slices.Contains(names, "roles") {
+		for _, role := range roles {
+			c.Roles = append(c.Roles, role.Name)
+		}
+	}
+	if slices.Contains(names, "permissions") {
+		for _, p := range userPermissions(user) {
+			if cp := compactPermission(p); !slices.Contains(c.Permissions, cp) {
+				c.Permissions = append(c.Permissions, cp)
+			}
+		}
+	}
+	if user.Edges != nil {
+		if slices.Contains(names, "group") && user.Edges.Group != nil {
+			c.Group = user.Edges.Group.Code
+		}
+		if slices.Contains(names, "position") && user.Edges.Position != nil {
+			c.Position = user.Edges.Position.Code
+		}
+	}
+	return c
+}
+
+// userRBACClaims returns the token_claims of the client for the user, or nil
+// if there are none.
+func userRBACClaims(ctx context.Context, client *ent.Oauth2Client, userID string) (*rbacClaims, error) {
+	uid, err := uuid.Parse(userID)
+	if err != nil || len(client.TokenClaims) == 0 {
+		return nil, nil
+	}
+	user, err := makeProxyUserService(ctx, rbac)(&defaultUserService{}).Get(uid)
+	if err != nil {
+		return nil, err
+	}
+	return newRBACClaims(user, client.TokenClaims), nil
+}
+
+// accessTokenRBACClaims returns the RBAC claims of a new access token. Claims
+// larger than tokenClaimsMaxSize are replaced with a reference to the
+// introspection endpoint, which returns them in full.
+func accessTokenRBACClaims(ctx context.Context, data *oauth2.GenerateBasic) (*rbacClaims, error) {
+	client, err := getOauth2Client(ctx, data.Client.GetID())
+	if err != nil {
+		return nil, err
+	}
+	c, err := userRBACClaims(ctx, client, data.UserID)
+	if err != nil || c == nil || tokenClaimsMaxSize <= 0 {
+		return c, err
+	}
+	b, err := json.Marshal(c)
+	if err != nil {
+		return nil, err
+	}
+	if len(b) <= tokenClaimsMaxSize {
+		return c, nil
+	}
+
+	ref := &rbacClaims{
+		ClaimNames:   make(map[string]string),
+		ClaimSources: map[string]map[string]string{rbacClaimSource: {"endpoint": issuer + "/introspect"}},
+	}
+	for _, name := range client.TokenClaims {
+		ref.ClaimNames[name] = rbacClaimSource
+	}
+	return ref, nil
+}
+
+// setClaims adds the claims to the claims of a JWT.
+func (c *rbacClaims) setClaims(claims jwt.MapClaims) error {
+	b, err := json.Marshal(c)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(b, &claims)
+}
+
+// setIntrospectionRBACClaims adds the RBAC claims of the access token ti to
+// its introspection response.
+func setIntrospectionRBACClaims(ctx context.Context, ti oauth2.TokenInfo, data map[string]interface{}) error {
+	client, err := getOauth2Client(ctx, ti.GetClientID())
+	if err != nil {
+		return err
+	}
+	c, err := userRBACClaims(ctx, client, ti.GetUserID())
+	if err != nil || c == nil {
+		return err
+	}
+	return c.setClaims(data)
+}
@@ -0,0 +1,102 @@
+package main
+
+import (
+	"context"
+	"net/url"
+	"reflect"
+	"testing"
+
+	"github.com/go-oauth2/oauth2/v4"
+	"github.com/google/uuid"
+
+	"github.com/byebyebymyai/oauth2-api/ent"
+)
+
+// testRBACUser has a permission of its own and two roles that share a
+// permission.
+var testRBACUser = User{
+	Permission: []*Permission{{Method: "post", Host: "api.example.com", Path: "/invoices"}},
+	Edges: &UserEdges{
+		Roles: []*Role{
+			{Name: "admin", Edges: RoleEdges{Permissions: []*Permission{
+				{Method: "get", Host: "api.example.com", Path: "/orders"},
+				{Method: "DELETE", Host: "api.example.com", Path: "/orders"},
+			}}},
+			{Name: "viewer", Edges: RoleEdges{Permissions: []*Permission{
+				{Method: "GET", Host: "api.example.com", Path: "/orders"},
+			}}},
+		},
+		Group:    &Group{Code: "G1"},
+		Position: &Position{Code: "P1"},
+	},
+}
+
+func TestNewRBACClaims(t *testing.T) {
+	tests := []struct {
+		names []string
+		want  *rbacClaims
+	}{
+		{
+			names: []string{"roles", "permissions", "group", "position"},
+			want: &rbacClaims{
+				Roles:       []string{"admin", "viewer"},
+				Permissions: []string{"POST api.example.com/invoices", "GET api.example.com/orders", "DELETE api.example.com/orders"},
+				Group:       "G1",
+				Position:    "P1",
+			},
+		},
+		{names: []string{"roles"}, want: &rbacClaims{Roles: []string{"admin", "viewer"}}},
+		{names: nil, want: &rbacClaims{}},
+	}
+	for _, tt := range tests {
+		if got := newRBACClaims(testRBACUser, tt.names); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("newRBACClaims(%q) = %+v, want %+v", tt.names, got, tt.want)
+		}
+	}
+}
+
+func TestAccessTokenRBACClaims(t *testing.T) {
+	serveTestUser(t, testRBACUser)
+	client := addTestClient(&ent.Oauth2Client{TokenClaims: []string{"roles", "group"}})
+	data := &oauth2.GenerateBasic{Client: client, UserID: uuid.NewString()}
+	ctx := context.Background()
+
+	c, err := accessTokenRBACClaims(ctx, data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := (&rbacClaims{Roles: []string{"admin", "viewer"}, Group: "G1"}); !reflect.DeepEqual(c, want) {
+		t.Errorf("accessTokenRBACClaims() = %+v, want %+v", c, want)
+	}
+
+	max := tokenClaimsMaxSize
+	tokenClaimsMaxSize = 10
+	t.Cleanup(func() { tokenClaimsMaxSize = max })
+	c, err = accessTokenRBACClaims(ctx, data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &rbacClaims{
+		ClaimNames:   map[string]string{"roles": "rbac", "group": "rbac"},
+		ClaimSources: map[string]map[string]string{"rbac": {"endpoint": issuer + "/introspect"}},
+	}
+	if !reflect.DeepEqual(c, want) {
+		t.Errorf("large claims = %+v, want the reference %+v", c, want)
+	}
+
+	if c, err := accessTokenRBACClaims(ctx, &oauth2.GenerateBasic{Client: addTestClient(&ent.Oauth2Client{}), UserID: uuid.NewString()}); err != nil || c != nil {
+		t.Errorf("accessTokenRBACClaims() without token_claims = %+v, %v", c, err)
+	}
+}
+
+func TestIntrospectRBACClaims(t *testing.T) {
+	serveTestUser(t, testRBACUser)
+	client := addTestClient(&ent.Oauth2Client{Secret: "secret", TokenClaims: []string{"permissions"}})
+	ti := addTestToken(t, client, uuid.NewString(), "")
+
+	data := introspect(t, client, "secret", url.Values{"token": {ti.Access}})
+	want := []interface{}{"POST api.example.com/invoices", "GET api.example.com/orders", "DELETE api.example.com/orders"}
+	if !reflect.DeepEqual(data["permissions"], want) {
+		t.Errorf("permissions = %v, want %v", data["permissions"], want)
+	}
+}
@@ -0,0 +1,300 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/go-oauth2/oauth2/v4/errors"
+	"github.com/go-oauth2/oauth2/v4/server"
+	"github.com/golang-jwt/jwt/v5"
+
+	"github.com/byebyebymyai/oauth2-api/ent"
+	httpTransport "github.com/byebyebymyai/oauth2-api/transport/http"
+)
+
+// clientAssertionType is the client assertion type of JWT client
+// authentication (RFC 7523).
+const clientAssertionType = "urn:ietf:params:oauth:client-assertion-type:jwt-bearer"
+
+// tokenEndpointAuthMethods lists the client authentication methods accepted
+// by the token endpoint, see clientInfoHandler.
+var tokenEndpointAuthMethods = []string{"client_secret_basic", "client_secret_post", "client_secret_jwt", "private_key_jwt", "tls_client_auth", "self_signed_tls_client_auth", "none"}
+
+// clientAuthMethods lists the client authentication methods accepted by
+// authenticateClient, used by the introspection and revocation endpoints.
+var clientAuthMethods = []string{"client_secret_basic", "client_secret_post", "client_secret_jwt", "private_key_jwt", "tls_client_auth", "self_signed_tls_client_auth"}
+
+// clientSecretJWTAlgorithms lists the algorithms accepted for
+// client_secret_jwt assertions.
+var clientSecretJWTAlgorithms = []string{"HS256", "HS384", "HS512"}
+
+// authenticateClient authenticates the calling client from a JWT client
+// assertion, HTTP basic authorization or the client_id and client_secret form
+// values. Clients registered for TLS client authentication authenticate with
+// their certificate and public clients with their client_id alone.
+func authenticateClient(r *http.Request) (*ent.Oauth2Client, error) {
+	ctx := r.Context()
+	if clientID := authenticatedClientFromContext(ctx); clientID != "" {
+		return getOauth2Client(ctx, clientID)
+	}
+	if hasClientAssertion(r) {
+		return authenticateClientAssertion(r)
+	}
+
+	clientID, clientSecret := clientCredentials(r)
+	if clientID == "" {
+		return nil, errors.ErrInvalidClient
+	}
+
+	client, err := getOauth2Client(ctx, clientID)
+	if err != nil {
+		return nil, err
+	}
+	switch client.TokenEndpointAuthMethod {
+	case "tls_client_auth", "self_signed_tls_client_auth":
+		if err := verifyClientCertificate(r, client); err != nil {
+			return nil, err
+		}
+		return client, nil
+	}
+	if !client.VerifyPassword(clientSecret) {
+		return nil, errors.ErrInvalidClient
+	}
+	return client, nil
+}
+
+// hasClientAssertion reports whether the client authenticates with a JWT
+// client assertion.
+func hasClientAssertion(r *http.Request) bool {
+	return r.FormValue("client_assertion_type") != "" || r.FormValue("client_assertion") != ""
+}
+
+// clientCredentials returns the client credentials of the request, from HTTP
+// basic authorization or the form.
+func clientCredentials(r *http.Request) (clientID, clientSecret string) {
+	if clientID, clientSecret, ok := r.BasicAuth(); ok {
+		return clientID, clientSecret
+	}
+	return r.FormValue("client_id"), r.FormValue("client_secret")
+}
+
+// authenticateClientAssertion authenticates a client with a JWT signed with
+// its private key (private_key_jwt) or its secret (client_secret_jwt). The
+// jti of an assertion can be used only once.
+func authenticateClientAssertion(r *http.Request) (*ent.Oauth2Client, error) {
+	ctx := r.Context()
+	assertion := r.FormValue("client_assertion")
+	if r.FormValue("client_assertion_type") != clientAssertionType || assertion == "" {
+		return nil, errors.ErrInvalidRequest
+	}
+
+	unverified, err := parseUnverifiedAssertion(assertion)
+	if err != nil || unverified.Issuer == "" || unverified.Issuer != unverified.Subject {
+		return nil, errors.ErrInvalidClient
+	}
+	if clientID := r.FormValue("client_id"); clientID != "" && clientID != unverified.Subject {
+		return nil, errors.ErrInvalidClient
+	}
+	client, err := getOauth2Client(ctx, unverified.Subject)
+	if err != nil {
+		return nil, err
+	}
+
+	var keyfunc jwt.Keyfunc
+	var methods []string
+	switch client.TokenEndpointAuthMethod {
+	case "private_key_jwt":
+		kf, err := clientKeyfunc(ctx, client)
+		if err != nil {
+			errorLogger.Error("[authenticateClientAssertion]", "error", err.Error(), "clientID", client.ID)
+			return nil, errors.ErrInvalidClient
+		}
+		keyfunc, methods = kf, assertionAlgorithms
+	case "client_secret_jwt":
+		// any unexpired secret can sign, so that secrets can be rotated
+		keys, err := clientSecretKeys(client)
+		if err != nil {
+			errorLogger.Error("[authenticateClientAssertion]", "error", err.Error(), "clientID", client.ID)
+			return nil, errors.ErrInvalidClient
+		}
+		keyfunc = func(*jwt.Token) (interface{}, error) {
+			return keys, nil
+		}
+		methods = clientSecretJWTAlgorithms
+	default:
+		return nil, errors.ErrInvalidClient
+	}
+
+	claims, err := verifyAssertion(assertion, keyfunc, methods, assertionAudiences(r))
+	if err != nil {
+		errorLogger.Error("[authenticateClientAssertion]", "error", err.Error(), "clientID", client.ID)
+		return nil, errors.ErrInvalidClient
+	}
+	replayed, err := useAssertionID(ctx, claims)
+	if err != nil {
+		return nil, err
+	}
+	if replayed {
+		errorLogger.Error("[authenticateClientAssertion]", "error", "assertion replayed", "clientID", client.ID, "jti", claims.ID)
+		return nil, errors.ErrInvalidClient
+	}
+	return client, nil
+}
+
+// clientInfoHandler is the srv.ClientInfoHandler. Clients authenticated with
+// a JWT assertion or a TLS certificate by the token handler are taken from
+// the request context, other clients from HTTP basic authorization or the
+// form.
+func clientInfoHandler(r *http.Request) (clientID, clientSecret string, err error) {
+	if clientID := authenticatedClientFromContext(r.Context()); clientID != "" {
+		return clientID, "", nil
+	}
+	if _, _, ok := r.BasicAuth(); ok {
+		return server.ClientBasicHandler(r)
+	}
+	return server.ClientFormHandler(r)
+}
+
+type authenticatedClientKey struct{}
+
+// contextWithAuthenticatedClient marks the client as authenticated for the
+// token manager, which would otherwise verify its secret.
+func contextWithAuthenticatedClient(ctx context.Context, clientID string) context.Context {
+	return context.WithValue(ctx, authenticatedClientKey{}, clientID)
+}
+
+// authenticatedClientFromContext returns the id of the client authenticated
+// by the token handler, or "".
+func authenticatedClientFromContext(ctx context.Context) string {
+	clientID, _ := ctx.Value(authenticatedClientKey{}).(string)
+	return clientID
+}
+
+// authenticatedClient is a client that authenticated without its secret. It
+// passes the secret verification of the token manager.
+type authenticatedClient struct {
+	*ent.Oauth2Client
+}
+
+func (authenticatedClient) VerifyPassword(string) bool {
+	return true
+}
+
+const (
+	// jwksCacheExpiration is how long the keys published at a jwks_uri are
+	// cached, like the JOSE keys.
+	jwksCacheExpiration = 5 * time.Minute
+	// jwksRefetchInterval is how soon the keys are fetched again for a kid
+	// that is not among them, as the client may have rotated its keys.
+	jwksRefetchInterval = 30 * time.Second
+	// jwksCacheSize is how many jwks_uri the keys are cached for.
+	jwksCacheSize = 1024
+)
+
+// jwksCacheKey identifies the keys fetched from a JWKS URI with an HTTP
+// client, so that keys fetched for the operator are not served to clients.
+type jwksCacheKey struct {
+	client httpTransport.HTTPClient
+	uri    string
+}
+
+// cachedJSONWebKeySet are the keys fetched from a jwks_uri.
+type cachedJSONWebKeySet struct {
+	keys      *JSONWebKeySet
+	fetchedAt time.Time
+}
+
+var (
+	jwksCacheMu sync.Mutex
+	jwksCache   = make(map[jwksCacheKey]cachedJSONWebKeySet)
+)
+
+// clientKeys returns the registered JWKS of the client, or the keys published
+// at its jwks_uri.
+func clientKeys(ctx context.Context, client *ent.Oauth2Client) (*JSONWebKeySet, error) {
+	if len(client.Jwks) > 0 {
+		return parseJSONWebKeySet(client.Jwks)
+	}
+	if client.JwksURI == "" {
+		return nil, errors.New("client has no jwks")
+	}
+	return fetchJSONWebKeySet(ctx, externalHTTPClient, client.JwksURI, false)
+}
+
+// clientKeyfunc returns a jwt.Keyfunc for the keys of the client. Keys from a
+// jwks_uri are fetched again when the token's kid is not among them.
+func clientKeyfunc(ctx context.Context, client *ent.Oauth2Client) (jwt.Keyfunc, error) {
+	keys, err := clientKeys(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+	if len(client.Jwks) > 0 {
+		return keys.Keyfunc(), nil
+	}
+	return jwksURIKeyfunc(ctx, externalHTTPClient, client.JwksURI, keys), nil
+}
+
+// jwksURIKeyfunc returns a jwt.Keyfunc for keys, which were fetched from uri
+// with httpClient. A token whose kid is not among them fetches the keys again.
+func jwksURIKeyfunc(ctx context.Context, httpClient httpTransport.HTTPClient, uri string, keys *JSONWebKeySet) jwt.Keyfunc {
+	return func(token *jwt.Token) (interface{}, error) {
+		key, err := keys.Keyfunc()(token)
+		if err == nil {
+			return key, nil
+		}
+		refetched, ferr := fetchJSONWebKeySet(ctx, httpClient, uri, true)
+		if ferr != nil || refetched == keys {
+			return nil, err
+		}
+		return refetched.Keyfunc()(token)
+	}
+}
+
+// fetchJSONWebKeySet returns the keys published at a JWKS URI, fetched with
+// httpClient: externalHTTPClient for the URIs of clients. They are cached for
+// jwksCacheExpiration. With refetch, cached keys older than
+// jwksRefetchInterval are fetched again.
+func fetchJSONWebKeySet(ctx context.Context, httpClient httpTransport.HTTPClient, uri string, refetch bool) (*JSONWebKeySet, error) {
+	key := jwksCacheKey{client: httpClient, uri: uri}
+	jwksCacheMu.Lock()
+	cached, ok := jwksCache[key]
+	jwksCacheMu.Unlock()
+	if ok {
+		age := time.Since(cached.fetchedAt)
+		if age < jwksCacheExpiration && (!refetch || age < jwksRefetchInterval) {
+			return cached.keys, nil
+		}
+	}
+
+	u, err := url.Parse(uri)
+	if err != nil {
+		return nil, err
+	}
+	res, err := jwksURIEndpoint(ctx, u, httpClient)(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	keys := &JSONWebKeySet{Keys: res.([]*JSONWebKey)}
+
+	jwksCacheMu.Lock()
+	defer jwksCacheMu.Unlock()
+	if _, ok := jwksCache[key]; !ok && len(jwksCache) >= jwksCacheSize {
+		// make room, preferably by dropping expired keys
+		for k, c := range jwksCache {
+			if time.Since(c.fetchedAt) >= jwksCacheExpiration {
+				delete(jwksCache, k)
+			}
+		}
+		for k := range jwksCache {
+			if len(jwksCache) < jwksCacheSize {
+				break
+			}
+			delete(jwksCache, k)
+		}
+	}
+	jwksCache[key] = cachedJSONWebKeySet{keys: keys, fetchedAt: time.Now()}
+	return keys, nil
+}
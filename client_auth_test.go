@@ -0,0 +1,98 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+func TestIsPublicIP(t *testing.T) {
+	tests := map[string]bool{
+		"93.184.216.34": true,
+		"2606:4700::1":  true,
+		"127.0.0.1":     false,
+		"::1":           false,
+		"10.1.2.3":      false,
+		"172.16.0.1":    false,
+		"192.168.1.1":   false,
+		"169.254.1.1":   false,
+		"100.64.0.1":    false,
+		"fd00::1":       false,
+		"fe80::1":       false,
+		"0.0.0.0":       false,
+		"224.0.0.1":     false,
+	}
+	for ip, want := range tests {
+		if got := isPublicIP(net.ParseIP(ip)); got != want {
+			t.Errorf("isPublicIP(%s) = %v, want %v", ip, got, want)
+		}
+	}
+}
+
+func TestExternalHTTPClientLoopback(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer ts.Close()
+	r, err := http.NewRequest("GET", ts.URL, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res, err := externalHTTPClient.Do(r); err == nil {
+		res.Body.Close()
+		t.Error("externalHTTPClient connected to a loopback address")
+	}
+}
+
+func TestJWKSURIKeyfunc(t *testing.T) {
+	key, jwks := newTestIssuer(t)
+	var fetches atomic.Int32
+	var published atomic.Value
+	published.Store(json.RawMessage(`{"keys":[]}`))
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fetches.Add(1)
+		w.Write(published.Load().(json.RawMessage))
+	}))
+	defer ts.Close()
+	client := externalHTTPClient
+	externalHTTPClient = ts.Client()
+	t.Cleanup(func() { externalHTTPClient = client })
+
+	ctx := context.Background()
+	keys, err := fetchJSONWebKeySet(ctx, externalHTTPClient, ts.URL, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := fetchJSONWebKeySet(ctx, externalHTTPClient, ts.URL, false); err != nil || fetches.Load() != 1 {
+		t.Fatalf("fetches = %d, %v, want the keys cached", fetches.Load(), err)
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodES256, jwt.MapClaims{"sub": "client"})
+	token.Header["kid"] = "issuer-key"
+	signed, err := token.SignedString(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	parse := func() error {
+		_, err := jwt.Parse(signed, jwksURIKeyfunc(ctx, externalHTTPClient, ts.URL, keys))
+		return err
+	}
+
+	// the client rotates its keys, but the cached keys are too recent to fetch
+	published.Store(jwks)
+	if err := parse(); err == nil || fetches.Load() != 1 {
+		t.Errorf("parse() = %v with %d fetches, want the recent keys kept", err, fetches.Load())
+	}
+
+	jwksCacheMu.Lock()
+	jwksCache[jwksCacheKey{client: externalHTTPClient, uri: ts.URL}] = cachedJSONWebKeySet{keys: keys, fetchedAt: time.Now().Add(-jwksRefetchInterval)}
+	jwksCacheMu.Unlock()
+	if err := parse(); err != nil || fetches.Load() != 2 {
+		t.Errorf("parse() = %v with %d fetches, want the keys fetched again", err, fetches.Load())
+	}
+}
@@ -0,0 +1,120 @@
+package main
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/go-oauth2/oauth2/v4"
+	"github.com/go-oauth2/oauth2/v4/errors"
+	"github.com/go-oauth2/oauth2/v4/manage"
+
+	"github.com/byebyebymyai/oauth2-api/ent"
+)
+
+// clientAuthorizedHandler is the srv.ClientAuthorizedHandler. It allows the
+// grant types listed in the client's grant_types, or any grant type when the
+// list is empty.
+func clientAuthorizedHandler(clientID string, grant oauth2.GrantType) (bool, error) {
+	client, err := getOauth2Client(context.Background(), clientID)
+	if err != nil {
+		return false, err
+	}
+	if len(client.GrantTypes) == 0 {
+		return true, nil
+	}
+	// GrantType.String is empty for the implicit and the extension grants
+	gt := string(grant)
+	if grant == oauth2.Implicit {
+		gt = "implicit"
+	}
+	return slices.Contains(client.GrantTypes, gt), nil
+}
+
+// clientScopeHandler is the srv.ClientScopeHandler. It allows the scopes
+// listed in the client's allowed_scopes, or any scope when the list is empty.
+func clientScopeHandler(tgr *oauth2.TokenGenerateRequest) (bool, error) {
+	ctx := context.Background()
+	if tgr.Request != nil {
+		ctx = tgr.Request.Context()
+	}
+	client, err := getOauth2Client(ctx, tgr.ClientID)
+	if err != nil {
+		return false, err
+	}
+	if len(client.AllowedScopes) == 0 {
+		return true, nil
+	}
+	for _, s := range strings.Fields(tgr.Scope) {
+		if !slices.Contains(client.AllowedScopes, s) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// refreshingScopeHandler is the srv.RefreshingScopeHandler. A refresh can
+// narrow the scope of the token, but not widen it (RFC 6749, section 6).
+func refreshingScopeHandler(tgr *oauth2.TokenGenerateRequest, oldScope string) (bool, error) {
+	for _, s := range strings.Fields(tgr.Scope) {
+		if !hasScope(oldScope, s) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// validateRedirectURI checks the redirect URI of an authorization request
+// against the client's redirect_uris, or against its domain for clients
+// without registered redirect URIs.
+func validateRedirectURI(client *ent.Oauth2Client, redirectURI string) error {
+	if len(client.RedirectUris) == 0 {
+		if err := manage.DefaultValidateURI(client.GetDomain(), redirectURI); err != nil {
+			return errors.ErrInvalidRequest
+		}
+		return nil
+	}
+	for _, uri := range client.RedirectUris {
+		if uri == redirectURI || matchLoopbackRedirectURI(uri, redirectURI) {
+			return nil
+		}
+	}
+	return errors.ErrInvalidRequest
+}
+
+// matchLoopbackRedirectURI reports whether redirectURI matches the registered
+// loopback redirect URI of a native app on any port (RFC 8252, section 7.3).
+func matchLoopbackRedirectURI(registered, redirectURI string) bool {
+	reg, err := url.Parse(registered)
+	if err != nil || reg.Scheme != "http" {
+		return false
+	}
+	if ip := net.ParseIP(reg.Hostname()); ip == nil || !ip.IsLoopback() {
+		return false
+	}
+	u, err := url.Parse(redirectURI)
+	if err != nil || u.User != nil || u.Fragment != "" {
+		return false
+	}
+	return u.Scheme == reg.Scheme &&
+		u.Hostname() == reg.Hostname() &&
+		u.Path == reg.Path &&
+		u.RawQuery == reg.RawQuery
+}
+
+// defaultRedirectURI fills in the redirect URI of an authorization request
+// without one, when the client has registered a single redirect URI. Without
+// a registered redirect URI, or with several, the request must name one.
+func defaultRedirectURI(r *http.Request, client *ent.Oauth2Client) error {
+	if r.FormValue("redirect_uri") != "" {
+		return nil
+	}
+	if len(client.RedirectUris) != 1 {
+		return errors.ErrInvalidRequest
+	}
+	r.Form.Set("redirect_uri", client.RedirectUris[0])
+	return nil
+}
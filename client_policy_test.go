@@ -0,0 +1,188 @@
+package main
+
+import (
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/go-oauth2/oauth2/v4"
+	"github.com/go-oauth2/oauth2/v4/errors"
+	"github.com/google/uuid"
+
+	"github.com/byebyebymyai/oauth2-api/ent"
+)
+
+func TestMatchLoopbackRedirectURI(t *testing.T) {
+	tests := []struct {
+		registered  string
+		redirectURI string
+		want        bool
+	}{
+		{"http://127.0.0.1/callback", "http://127.0.0.1:51004/callback", true},
+		{"http://127.0.0.1:8080/callback", "http://127.0.0.1:51004/callback", true},
+		{"http://[::1]/callback", "http://[::1]:51004/callback", true},
+		{"http://127.0.0.1/callback?app=1", "http://127.0.0.1:51004/callback?app=1", true},
+		{"http://127.0.0.1/callback", "http://127.0.0.1:51004/other", false},
+		{"http://127.0.0.1/callback", "http://127.0.0.1:51004/callback?x=1", false},
+		{"http://127.0.0.1/callback", "http://127.0.0.1:51004/callback#x", false},
+		{"http://127.0.0.1/callback", "http://user@127.0.0.1:51004/callback", false},
+		{"http://127.0.0.1/callback", "https://127.0.0.1:51004/callback", false},
+		{"http://127.0.0.1/callback", "http://127.0.0.2:51004/callback", false},
+		{"http://127.0.0.1/callback", "http://evil.example.com:51004/callback", false},
+		{"http://localhost/callback", "http://localhost:51004/callback", false},
+		{"https://127.0.0.1/callback", "https://127.0.0.1:51004/callback", false},
+		{"https://app.example.com/callback", "https://app.example.com:8443/callback", false},
+	}
+	for _, tt := range tests {
+		if got := matchLoopbackRedirectURI(tt.registered, tt.redirectURI); got != tt.want {
+			t.Errorf("matchLoopbackRedirectURI(%q, %q) = %v, want %v", tt.registered, tt.redirectURI, got, tt.want)
+		}
+	}
+}
+
+func TestValidateRedirectURI(t *testing.T) {
+	registered := &ent.Oauth2Client{RedirectUris: []string{"https://app.example.com/callback", "http://127.0.0.1/callback"}}
+	domain := &ent.Oauth2Client{Domain: "https://app.example.com"}
+
+	tests := []struct {
+		name        string
+		client      *ent.Oauth2Client
+		redirectURI string
+		want        error
+	}{
+		{"exact", registered, "https://app.example.com/callback", nil},
+		{"loopback on any port", registered, "http://127.0.0.1:51004/callback", nil},
+		{"prefix of a registered uri", registered, "https://app.example.com/callback/other", errors.ErrInvalidRequest},
+		{"query added", registered, "https://app.example.com/callback?x=1", errors.ErrInvalidRequest},
+		{"not registered", registered, "https://evil.example.com/callback", errors.ErrInvalidRequest},
+		{"empty", registered, "", errors.ErrInvalidRequest},
+		{"under the domain", domain, "https://app.example.com/callback", nil},
+		{"outside the domain", domain, "https://evil.example.com/callback", errors.ErrInvalidRequest},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := validateRedirectURI(tt.client, tt.redirectURI); err != tt.want {
+				t.Errorf("validateRedirectURI(%q) = %v, want %v", tt.redirectURI, err, tt.want)
+			}
+		})
+	}
+}
+
+func TestDefaultRedirectURI(t *testing.T) {
+	tests := []struct {
+		name        string
+		registered  []string
+		redirectURI string
+		want        string
+		wantErr     error
+	}{
+		{name: "one registered", registered: []string{"https://app.example.com/callback"}, want: "https://app.example.com/callback"},
+		{name: "none registered", wantErr: errors.ErrInvalidRequest},
+		{name: "several registered", registered: []string{"https://app.example.com/a", "https://app.example.com/b"}, wantErr: errors.ErrInvalidRequest},
+		{name: "given", registered: []string{"https://app.example.com/a", "https://app.example.com/b"}, redirectURI: "https://app.example.com/b", want: "https://app.example.com/b"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			params := url.Values{"client_id": {"client"}}
+			if tt.redirectURI != "" {
+				params.Set("redirect_uri", tt.redirectURI)
+			}
+			r := httptest.NewRequest("GET", "/authorize?"+params.Encode(), nil)
+			if err := r.ParseForm(); err != nil {
+				t.Fatal(err)
+			}
+			err := defaultRedirectURI(r, &ent.Oauth2Client{RedirectUris: tt.registered})
+			if err != tt.wantErr {
+				t.Fatalf("defaultRedirectURI() error = %v, want %v", err, tt.wantErr)
+			}
+			if got := r.FormValue("redirect_uri"); err == nil && got != tt.want {
+				t.Errorf("redirect_uri = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClientAuthorizedHandler(t *testing.T) {
+	restricted := addTestClient(&ent.Oauth2Client{GrantTypes: []string{"authorization_code", "refresh_token", "implicit", deviceCodeGrantType}})
+	unrestricted := addTestClient(&ent.Oauth2Client{})
+
+	tests := []struct {
+		name   string
+		client *ent.Oauth2Client
+		grant  oauth2.GrantType
+		want   bool
+	}{
+		{"listed", restricted, oauth2.AuthorizationCode, true},
+		{"implicit", restricted, oauth2.Implicit, true},
+		{"not listed", restricted, oauth2.ClientCredentials, false},
+		{"extension grant", restricted, deviceCodeGrantType, true},
+		{"extension grant not listed", restricted, tokenExchangeGrantType, false},
+		{"no grant types", unrestricted, oauth2.PasswordCredentials, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := clientAuthorizedHandler(tt.client.GetID(), tt.grant)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("clientAuthorizedHandler(%q) = %v, want %v", tt.grant, got, tt.want)
+			}
+		})
+	}
+
+	if _, err := clientAuthorizedHandler(uuid.NewString(), oauth2.AuthorizationCode); err == nil {
+		t.Error("clientAuthorizedHandler() of an unknown client succeeded")
+	}
+}
+
+func TestClientScopeHandler(t *testing.T) {
+	restricted := addTestClient(&ent.Oauth2Client{AllowedScopes: []string{"openid", "profile"}})
+	unrestricted := addTestClient(&ent.Oauth2Client{})
+
+	tests := []struct {
+		name   string
+		client *ent.Oauth2Client
+		scope  string
+		want   bool
+	}{
+		{"allowed", restricted, "openid profile", true},
+		{"empty", restricted, "", true},
+		{"not allowed", restricted, "openid admin", false},
+		{"no allowed scopes", unrestricted, "admin", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := clientScopeHandler(&oauth2.TokenGenerateRequest{ClientID: tt.client.GetID(), Scope: tt.scope})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("clientScopeHandler(%q) = %v, want %v", tt.scope, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRefreshingScopeHandler(t *testing.T) {
+	tests := []struct {
+		scope    string
+		oldScope string
+		want     bool
+	}{
+		{"", "openid profile", true},
+		{"openid", "openid profile", true},
+		{"profile openid", "openid profile", true},
+		{"openid email", "openid profile", false},
+		{"openid", "", false},
+	}
+	for _, tt := range tests {
+		got, err := refreshingScopeHandler(&oauth2.TokenGenerateRequest{Scope: tt.scope}, tt.oldScope)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != tt.want {
+			t.Errorf("refreshingScopeHandler(%q, %q) = %v, want %v", tt.scope, tt.oldScope, got, tt.want)
+		}
+	}
+}
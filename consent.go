@@ -0,0 +1,384 @@
+package main
+
+import (
+	"context"
+	"html/template"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/go-oauth2/oauth2/v4"
+	"github.com/go-oauth2/oauth2/v4/errors"
+	"github.com/go-oauth2/oauth2/v4/server"
+	"github.com/google/uuid"
+
+	"github.com/byebyebymyai/oauth2-api/ent"
+	"github.com/byebyebymyai/oauth2-api/ent/consent"
+	"github.com/byebyebymyai/oauth2-api/ent/oauth2client"
+)
+
+// consentRequestExp is how long the user has to decide on the consent screen.
+const consentRequestExp = 10 * time.Minute
+
+// consentRequest is an authorization request waiting for the consent of the
+// user. It is resumed with Params, its resolved parameters, once the user has
+// approved it.
+type consentRequest struct {
+	UserID       string     `json:"user_id"`
+	ClientID     string     `json:"client_id"`
+	Scope        string     `json:"scope,omitempty"`
+	RedirectURI  string     `json:"redirect_uri"`
+	ResponseType string     `json:"response_type"`
+	State        string     `json:"state,omitempty"`
+	Params       url.Values `json:"params"`
+}
+
+func consentRequestKey(id string) string {
+	return "consent:" + id
+}
+
+// checkConsent reports whether the user has consented to the authorization
+// request r of client, which has been validated. If not, the login page or
+// the consent screen has been written to w instead. First-party clients need
+// no consent. Scopes registered without requires_consent need none either, but
+// the user must still have consented to the client itself.
+func checkConsent(w http.ResponseWriter, r *http.Request, client *ent.Oauth2Client) (bool, error) {
+	ctx := r.Context()
+	if client.FirstParty {
+		return true, nil
+	}
+	scopes, err := consentScopes(ctx, strings.Fields(r.FormValue("scope")))
+	if err != nil {
+		return false, err
+	}
+
+	userID, err := srv.UserAuthorizationHandler(w, r)
+	if err != nil || userID == "" {
+		return false, err
+	}
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return false, err
+	}
+	consented, err := hasConsent(ctx, uid, client.ID, scopes)
+	if err != nil || consented {
+		return consented, err
+	}
+
+	id, err := randomString(32)
+	if err != nil {
+		return false, err
+	}
+	cr := consentRequest{
+		UserID:       userID,
+		ClientID:     client.GetID(),
+		Scope:        strings.Join(scopes, " "),
+		RedirectURI:  r.FormValue("redirect_uri"),
+		ResponseType: r.FormValue("response_type"),
+		State:        r.FormValue("state"),
+		Params:       r.Form,
+	}
+	if err := setJSON(ctx, stateStore, consentRequestKey(id), cr, consentRequestExp); err != nil {
+		return false, err
+	}
+	page, err := newConsentPage(r, id, client, cr.Scope)
+	if err != nil {
+		return false, err
+	}
+	renderConsentPage(w, http.StatusOK, page)
+	return false, nil
+}
+
+// hasConsent reports whether the user has an unexpired consent for the client
+// that covers scopes.
+func hasConsent(ctx context.Context, userID, clientID uuid.UUID, scopes []string) (bool, error) {
+	c, err := entClient.Consent.Query().
+		Where(consent.UserID(userID), consent.HasClientWith(oauth2client.ID(clientID))).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	if c.ExpiresAt != nil && c.ExpiresAt.Before(time.Now()) {
+		return false, nil
+	}
+	for _, s := range scopes {
+		if !slices.Contains(c.Scopes, s) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// saveConsent records that the user granted scopes to the client, in addition
+// to the scopes of an unexpired earlier consent.
+func saveConsent(ctx context.Context, userID, clientID uuid.UUID, scopes []string) error {
+	var expiresAt *time.Time
+	if consentExpiration > 0 {
+		t := time.Now().Add(consentExpiration)
+		expiresAt = &t
+	}
+
+	c, err := entClient.Consent.Query().
+		Where(consent.UserID(userID), consent.HasClientWith(oauth2client.ID(clientID))).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return entClient.Consent.Create().
+			SetUserID(userID).
+			SetClientID(clientID).
+			SetScopes(scopes).
+			SetNillableExpiresAt(expiresAt).
+			Exec(ctx)
+	}
+	if err != nil {
+		return err
+	}
+
+	if c.ExpiresAt == nil || c.ExpiresAt.After(time.Now()) {
+		for _, s := range c.Scopes {
+			if !slices.Contains(scopes, s) {
+				scopes = append(scopes, s)
+			}
+		}
+	}
+	return c.Update().
+		SetScopes(scopes).
+		SetGrantedAt(time.Now()).
+		SetNillableExpiresAt(expiresAt).
+		Exec(ctx)
+}
+
+var consentTemplate = template.Must(template.New("consent").Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>Authorize {{.ClientName}}</title>
+</head>
+<body>
+{{if .LogoURI}}<img src="{{.LogoURI}}" alt="" height="64">{{end}}
+<p><strong>{{.ClientName}}</strong> asks for access to your account.</p>
+{{if .Scopes}}
+<ul>
+{{range .Scopes}}<li>{{if .Description}}{{.Description}}{{else}}{{.Name}}{{end}}</li>
+{{end}}</ul>
+{{end}}
+<form method="post" action="/consent">
+<input type="hidden" name="consent_id" value="{{.ID}}">
+<button name="action" value="approve">Approve</button>
+<button name="action" value="deny">Deny</button>
+</form>
+</body>
+</html>
+`))
+
+type consentPage struct {
+	ID         string
+	ClientName string
+	LogoURI    string
+	Scopes     []consentPageScope
+}
+
+type consentPageScope struct {
+	Name        string
+	Description string
+}
+
+// newConsentPage returns the consent screen for scope, with the descriptions
+// of the registered scopes in the language of the browser.
+func newConsentPage(r *http.Request, id string, client *ent.Oauth2Client, scope string) (consentPage, error) {
+	page := consentPage{
+		ID:         id,
+		ClientName: client.ClientName,
+		LogoURI:    client.LogoURI,
+	}
+	if page.ClientName == "" {
+		page.ClientName = client.GetDomain()
+	}
+
+	names := strings.Fields(scope)
+	scopes, err := registeredScopes(r.Context(), names)
+	if err != nil {
+		return page, err
+	}
+	for _, name := range names {
+		ps := consentPageScope{Name: name}
+		if s, ok := scopes[name]; ok {
+			ps.Description = scopeDescription(s, r.Header.Get("Accept-Language"))
+		}
+		page.Scopes = append(page.Scopes, ps)
+	}
+	return page, nil
+}
+
+func renderConsentPage(w http.ResponseWriter, statusCode int, page consentPage) {
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.Header().Set("Cache-Control", "no-store")
+	w.Header().Set("X-Frame-Options", "DENY")
+	w.WriteHeader(statusCode)
+	if err := consentTemplate.Execute(w, page); err != nil {
+		errorLogger.Error("[consentHandle]", "error", err.Error())
+	}
+}
+
+// consentHandler is the consent screen. On approval the consent is saved and
+// the authorization request resumed; on denial the client gets an
+// access_denied error.
+func consentHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	id := r.FormValue("consent_id")
+	var cr consentRequest
+	if err := getJSON(ctx, stateStore, consentRequestKey(id), &cr); err != nil {
+		http.Error(w, "The consent request is invalid or has expired.", http.StatusBadRequest)
+		return
+	}
+
+	userID, err := srv.UserAuthorizationHandler(w, r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusUnauthorized)
+		return
+	}
+	if userID == "" {
+		// redirected to the login page
+		return
+	}
+	if userID != cr.UserID {
+		http.Error(w, "The consent request belongs to another user.", http.StatusForbidden)
+		return
+	}
+
+	if r.Method != http.MethodPost {
+		client, err := getOauth2Client(ctx, cr.ClientID)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		page, err := newConsentPage(r, id, client, cr.Scope)
+		if err != nil {
+			errorLogger.Error("[consentHandle]", "error", err.Error())
+			http.Error(w, "Something went wrong, please try again.", http.StatusInternalServerError)
+			return
+		}
+		renderConsentPage(w, http.StatusOK, page)
+		return
+	}
+	stateStore.Del(ctx, consentRequestKey(id))
+
+	if r.PostFormValue("action") != "approve" {
+		logger.Info("[consentHandle]", "msg", "consent denied", "clientID", cr.ClientID, "userID", userID)
+		data, _, _ := srv.GetErrorData(errors.ErrAccessDenied)
+		uri, err := srv.GetRedirectURI(&server.AuthorizeRequest{
+			RedirectURI:  cr.RedirectURI,
+			ResponseType: oauth2.ResponseType(cr.ResponseType),
+			State:        cr.State,
+		}, data)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		http.Redirect(w, r, uri, http.StatusFound)
+		return
+	}
+
+	if err := saveConsent(ctx, uuid.MustParse(cr.UserID), uuid.MustParse(cr.ClientID), strings.Fields(cr.Scope)); err != nil {
+		errorLogger.Error("[consentHandle]", "error", err.Error())
+		http.Error(w, "Something went wrong, please try again.", http.StatusInternalServerError)
+		return
+	}
+	logger.Info("[consentHandle]", "msg", "consent granted", "clientID", cr.ClientID, "userID", userID, "scope", cr.Scope)
+	resumeAuthorizeRequest(w, r, cr.Params)
+}
+
+type consentResponse struct {
+	ID         uuid.UUID  `json:"id"`
+	ClientID   uuid.UUID  `json:"client_id"`
+	ClientName string     `json:"client_name,omitempty"`
+	Scopes     []string   `json:"scopes"`
+	GrantedAt  time.Time  `json:"granted_at"`
+	ExpiresAt  *time.Time `json:"expires_at,omitempty"`
+}
+
+// consentsUser returns the user of the access token of r, or writes the
+// error response and returns false.
+func consentsUser(w http.ResponseWriter, r *http.Request) (uuid.UUID, bool) {
+	ti, err := validateAccessToken(w, r)
+	if err == ErrUseDPoPNonce {
+		w.Header().Set("WWW-Authenticate", `DPoP error="use_dpop_nonce"`)
+		tokenError(w, err)
+		return uuid.Nil, false
+	}
+	var userID uuid.UUID
+	if err == nil {
+		userID, err = uuid.Parse(ti.GetUserID())
+	}
+	if err != nil {
+		w.Header().Set("WWW-Authenticate", `Bearer error="invalid_token"`)
+		tokenError(w, ErrInvalidToken)
+		return uuid.Nil, false
+	}
+	return userID, true
+}
+
+// consentsHandler lists the consents of the user of the access token.
+func consentsHandler(w http.ResponseWriter, r *http.Request) {
+	userID, ok := consentsUser(w, r)
+	if !ok {
+		return
+	}
+
+	consents, err := entClient.Consent.Query().
+		Where(consent.UserID(userID)).
+		WithClient().
+		Order(ent.Desc(consent.FieldGrantedAt)).
+		All(r.Context())
+	if err != nil {
+		errorLogger.Error("[consentsHandle]", "error", err.Error())
+		tokenError(w, err)
+		return
+	}
+
+	res := make([]consentResponse, 0, len(consents))
+	for _, c := range consents {
+		res = append(res, consentResponse{
+			ID:         c.ID,
+			ClientID:   c.Edges.Client.ID,
+			ClientName: c.Edges.Client.ClientName,
+			Scopes:     c.Scopes,
+			GrantedAt:  c.GrantedAt,
+			ExpiresAt:  c.ExpiresAt,
+		})
+	}
+	writeJSON(w, res, nil, http.StatusOK)
+}
+
+// revokeConsentHandler revokes a consent of the user of the access token. The
+// client has to ask for consent again on its next authorization request.
+func revokeConsentHandler(w http.ResponseWriter, r *http.Request) {
+	userID, ok := consentsUser(w, r)
+	if !ok {
+		return
+	}
+	id, err := uuid.Parse(r.PathValue("id"))
+	if err != nil {
+		http.Error(w, "consent not found", http.StatusNotFound)
+		return
+	}
+
+	n, err := entClient.Consent.Delete().
+		Where(consent.ID(id), consent.UserID(userID)).
+		Exec(r.Context())
+	if err != nil {
+		errorLogger.Error("[revokeConsentHandle]", "error", err.Error())
+		tokenError(w, err)
+		return
+	}
+	if n == 0 {
+		http.Error(w, "consent not found", http.StatusNotFound)
+		return
+	}
+	logger.Info("[revokeConsentHandle]", "msg", "consent revoked", "consentID", id, "userID", userID)
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,195 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/byebyebymyai/oauth2-api/ent"
+)
+
+func TestNewConsentPage(t *testing.T) {
+	r := httptest.NewRequest("GET", "/consent", nil)
+	client := &ent.Oauth2Client{ID: uuid.New(), Domain: "https://app.example.com"}
+	page, err := newConsentPage(r, "id", client, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if page.ClientName != "https://app.example.com" || len(page.Scopes) != 0 {
+		t.Errorf("newConsentPage() = %+v, want the domain as the name", page)
+	}
+
+	client.ClientName = "App"
+	if page, _ := newConsentPage(r, "id", client, ""); page.ClientName != "App" {
+		t.Errorf("ClientName = %q", page.ClientName)
+	}
+}
+
+// saveTestClient stores client in the database, for the consents that refer
+// to it.
+func saveTestClient(t *testing.T, client *ent.Oauth2Client) *ent.Oauth2Client {
+	t.Helper()
+	if err := entClient.Oauth2Client.Create().SetID(client.ID).SetDomain(client.Domain).Exec(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	return client
+}
+
+// withTestUser makes the user authorization handler of srv return userID.
+func withTestUser(t *testing.T, userID string) {
+	handler := srv.UserAuthorizationHandler
+	srv.SetUserAuthorizationHandler(func(w http.ResponseWriter, r *http.Request) (string, error) {
+		return userID, nil
+	})
+	t.Cleanup(func() { srv.UserAuthorizationHandler = handler })
+}
+
+func TestConsentHandler(t *testing.T) {
+	client := addTestClient(&ent.Oauth2Client{Domain: "https://app.example.com", ClientName: "<b>App</b>"})
+	userID := uuid.NewString()
+	withTestUser(t, userID)
+
+	id := "consent-id"
+	err := setJSON(context.Background(), stateStore, consentRequestKey(id), consentRequest{
+		UserID:       userID,
+		ClientID:     client.GetID(),
+		RedirectURI:  "https://app.example.com/cb",
+		ResponseType: "code",
+		State:        "xyz",
+		Params:       url.Values{"client_id": {client.GetID()}},
+	}, consentRequestExp)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	consent := func(method string, form url.Values) *httptest.ResponseRecorder {
+		r := httptest.NewRequest(method, "/consent?consent_id="+id, strings.NewReader(form.Encode()))
+		r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		w := httptest.NewRecorder()
+		consentHandler(w, r)
+		return w
+	}
+
+	w := consent("GET", nil)
+	if w.Code != http.StatusOK {
+		t.Fatalf("GET status = %d", w.Code)
+	}
+	if body := w.Body.String(); strings.Contains(body, "<b>App</b>") || !strings.Contains(body, `value="consent-id"`) {
+		t.Errorf("GET body = %s", body)
+	}
+	if w.Header().Get("X-Frame-Options") != "DENY" {
+		t.Errorf("X-Frame-Options = %q", w.Header().Get("X-Frame-Options"))
+	}
+
+	withTestUser(t, uuid.NewString())
+	if w := consent("POST", url.Values{"action": {"approve"}}); w.Code != http.StatusForbidden {
+		t.Errorf("other user: status = %d, want %d", w.Code, http.StatusForbidden)
+	}
+
+	withTestUser(t, userID)
+	w = consent("POST", url.Values{"action": {"deny"}})
+	if w.Code != http.StatusFound {
+		t.Fatalf("deny: status = %d", w.Code)
+	}
+	u, err := url.Parse(w.Header().Get("Location"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if u.Host != "app.example.com" || u.Query().Get("error") != "access_denied" || u.Query().Get("state") != "xyz" {
+		t.Errorf("deny: Location = %q", u)
+	}
+
+	if w := consent("POST", url.Values{"action": {"approve"}}); w.Code != http.StatusBadRequest {
+		t.Errorf("decided twice: status = %d, want %d", w.Code, http.StatusBadRequest)
+	}
+}
+
+func TestConsentsHandlerUnauthorized(t *testing.T) {
+	r := httptest.NewRequest("GET", "/consents", nil)
+	w := httptest.NewRecorder()
+	consentsHandler(w, r)
+	if w.Code != http.StatusUnauthorized || !strings.HasPrefix(w.Header().Get("WWW-Authenticate"), "Bearer") {
+		t.Errorf("status = %d, WWW-Authenticate %q", w.Code, w.Header().Get("WWW-Authenticate"))
+	}
+}
+
+func TestCheckConsent(t *testing.T) {
+	createTestScope(t, "no-consent", false, false)
+	userID := uuid.New()
+	withTestUser(t, userID.String())
+	thirdParty := addTestClient(&ent.Oauth2Client{Domain: "https://app.example.com"})
+	firstParty := addTestClient(&ent.Oauth2Client{Domain: "https://app.example.com", FirstParty: true})
+	consented := saveTestClient(t, addTestClient(&ent.Oauth2Client{Domain: "https://app.example.com"}))
+	if err := saveConsent(context.Background(), userID, consented.ID, []string{"openid"}); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name   string
+		client *ent.Oauth2Client
+		scope  string
+		want   bool
+	}{
+		{name: "first party", client: firstParty, scope: "openid", want: true},
+		{name: "third party", client: thirdParty, scope: "openid"},
+		{name: "third party without consent scopes", client: thirdParty, scope: "no-consent"},
+		{name: "consented", client: consented, scope: "openid no-consent", want: true},
+		{name: "new scope", client: consented, scope: "openid profile"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			params := url.Values{"client_id": {tt.client.GetID()}, "response_type": {"code"}, "scope": {tt.scope}}
+			r := httptest.NewRequest("GET", "/authorize?"+params.Encode(), nil)
+			w := httptest.NewRecorder()
+			got, err := checkConsent(w, r, tt.client)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("checkConsent() = %v, want %v", got, tt.want)
+			}
+			if !got && !strings.Contains(w.Body.String(), `name="consent_id"`) {
+				t.Errorf("no consent screen: %s", w.Body)
+			}
+		})
+	}
+}
+
+func TestConsentHandlerApprove(t *testing.T) {
+	client := saveTestClient(t, addTestClient(&ent.Oauth2Client{Secret: "secret", Domain: "https://app.example.com", RedirectUris: []string{"https://app.example.com/cb"}}))
+	userID := uuid.NewString()
+	withTestUser(t, userID)
+
+	// the pushed request is gone, only the consent request keeps the parameters
+	id := "approve-id"
+	err := setJSON(context.Background(), stateStore, consentRequestKey(id), consentRequest{
+		UserID:       userID,
+		ClientID:     client.GetID(),
+		Scope:        "openid",
+		RedirectURI:  "https://app.example.com/cb",
+		ResponseType: "code",
+		Params:       url.Values{"client_id": {client.GetID()}, "response_type": {"code"}, "scope": {"openid"}, "state": {"xyz"}},
+	}, consentRequestExp)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	form := url.Values{"consent_id": {id}, "action": {"approve"}}
+	r := httptest.NewRequest("POST", "/consent", strings.NewReader(form.Encode()))
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	w := httptest.NewRecorder()
+	consentHandler(w, r)
+
+	u, err := url.Parse(w.Header().Get("Location"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if w.Code != http.StatusFound || u.Host != "app.example.com" || u.Query().Get("code") == "" || u.Query().Get("state") != "xyz" {
+		t.Errorf("status = %d, Location %q, want the code", w.Code, u)
+	}
+}
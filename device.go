@@ -0,0 +1,474 @@
+package main
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"html/template"
+	"math/big"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/go-oauth2/oauth2/v4"
+	"github.com/go-oauth2/oauth2/v4/errors"
+	"github.com/google/uuid"
+)
+
+// deviceCodeGrantType is the device authorization grant (RFC 8628).
+const deviceCodeGrantType = "urn:ietf:params:oauth:grant-type:device_code"
+
+const (
+	deviceCodeExp      = 10 * time.Minute
+	deviceCodeInterval = 5 * time.Second
+	// userCodeAlphabet leaves out vowels and easily confused characters.
+	userCodeAlphabet = "BCDFGHJKLMNPQRSTVWXZ"
+)
+
+var (
+	ErrAuthorizationPending = errors.New("authorization_pending")
+	ErrSlowDown             = errors.New("slow_down")
+	ErrExpiredToken         = errors.New("expired_token")
+)
+
+func init() {
+	errors.Descriptions[ErrAuthorizationPending] = "The authorization request is still pending as the end user hasn't yet completed the user interaction steps"
+	errors.Descriptions[ErrSlowDown] = "The client is polling too quickly and should increase its polling interval"
+	errors.Descriptions[ErrExpiredToken] = "The device code has expired"
+	errors.StatusCodes[ErrAuthorizationPending] = http.StatusBadRequest
+	errors.StatusCodes[ErrSlowDown] = http.StatusBadRequest
+	errors.StatusCodes[ErrExpiredToken] = http.StatusBadRequest
+}
+
+type deviceStatus string
+
+const (
+	devicePending  deviceStatus = "pending"
+	deviceApproved deviceStatus = "approved"
+	deviceDenied   deviceStatus = "denied"
+)
+
+// deviceAuthorization is a pending device authorization, stored under its
+// device code.
+type deviceAuthorization struct {
+	ClientID  string       `json:"client_id"`
+	Scope     string       `json:"scope,omitempty"`
+	UserCode  string       `json:"user_code"`
+	Status    deviceStatus `json:"status"`
+	UserID    string       `json:"user_id,omitempty"`
+	ExpiresAt time.Time    `json:"expires_at"`
+}
+
+// devicePolling is how the device polls for its device code. It is stored
+// apart from the deviceAuthorization, so that polling never overwrites the
+// decision of the user.
+type devicePolling struct {
+	Interval time.Duration `json:"interval"`
+	PolledAt time.Time     `json:"polled_at"`
+}
+
+// deviceConfirmation is a device authorization shown to the user, waiting for
+// approval or denial.
+type deviceConfirmation struct {
+	UserID     string `json:"user_id"`
+	DeviceCode string `json:"device_code"`
+}
+
+func deviceCodeKey(deviceCode string) string {
+	return "device:code:" + deviceCode
+}
+
+func devicePollingKey(deviceCode string) string {
+	return "device:poll:" + deviceCode
+}
+
+func deviceConfirmationKey(id string) string {
+	return "device:confirm:" + id
+}
+
+func userCodeKey(userCode string) string {
+	return "device:user:" + normalizeUserCode(userCode)
+}
+
+// normalizeUserCode makes user codes case and separator insensitive.
+func normalizeUserCode(userCode string) string {
+	return strings.Map(func(r rune) rune {
+		if r == '-' || r == ' ' {
+			return -1
+		}
+		return r
+	}, strings.ToUpper(userCode))
+}
+
+// saveDeviceAuthorization stores da until it expires.
+func saveDeviceAuthorization(ctx context.Context, deviceCode string, da *deviceAuthorization) error {
+	ttl := time.Until(da.ExpiresAt)
+	if ttl <= 0 {
+		return ErrExpiredToken
+	}
+	return setJSON(ctx, stateStore, deviceCodeKey(deviceCode), da, ttl)
+}
+
+// deviceAuthorizationHandler starts a device authorization (RFC 8628) and
+// returns the device code and the user code to enter on the verification page.
+func deviceAuthorizationHandler(w http.ResponseWriter, r *http.Request) {
+	client, err := authenticateClient(r)
+	if err != nil {
+		tokenError(w, err)
+		return
+	}
+	if !srv.CheckGrantType(deviceCodeGrantType) {
+		tokenError(w, errors.ErrUnauthorizedClient)
+		return
+	}
+	// the client must be allowed the grant and the scope before the user is
+	// asked, as the token request checks them again
+	allowed, err := srv.ClientAuthorizedHandler(client.GetID(), deviceCodeGrantType)
+	if err == nil && !allowed {
+		err = errors.ErrUnauthorizedClient
+	}
+	if err == nil {
+		allowed, err = srv.ClientScopeHandler(&oauth2.TokenGenerateRequest{
+			ClientID: client.GetID(),
+			Scope:    r.FormValue("scope"),
+			Request:  r,
+		})
+		if err == nil && !allowed {
+			err = errors.ErrInvalidScope
+		}
+	}
+	if err != nil {
+		tokenError(w, err)
+		return
+	}
+
+	deviceCode, err := randomString(32)
+	if err != nil {
+		tokenError(w, err)
+		return
+	}
+	userCode, err := newUserCode()
+	if err != nil {
+		tokenError(w, err)
+		return
+	}
+
+	ctx := r.Context()
+	da := &deviceAuthorization{
+		ClientID:  client.GetID(),
+		Scope:     r.FormValue("scope"),
+		UserCode:  userCode,
+		Status:    devicePending,
+		ExpiresAt: time.Now().Add(deviceCodeExp),
+	}
+	if err := saveDeviceAuthorization(ctx, deviceCode, da); err != nil {
+		tokenError(w, err)
+		return
+	}
+	if err := stateStore.Set(ctx, userCodeKey(userCode), []byte(deviceCode), deviceCodeExp); err != nil {
+		tokenError(w, err)
+		return
+	}
+
+	verificationURI := issuer + "/device"
+	writeJSON(w, map[string]interface{}{
+		"device_code":               deviceCode,
+		"user_code":                 userCode,
+		"verification_uri":          verificationURI,
+		"verification_uri_complete": verificationURI + "?user_code=" + userCode,
+		"expires_in":                int64(deviceCodeExp / time.Second),
+		"interval":                  int64(deviceCodeInterval / time.Second),
+	}, nil, http.StatusOK)
+}
+
+// deviceCodeGrant exchanges an approved device code for tokens. Until the
+// user has decided the client gets authorization_pending, or slow_down when it
+// polls faster than the interval.
+func deviceCodeGrant(w http.ResponseWriter, r *http.Request) {
+	client, err := authenticateClient(r)
+	if err != nil {
+		tokenError(w, err)
+		return
+	}
+
+	ctx := r.Context()
+	deviceCode := r.FormValue("device_code")
+	if deviceCode == "" {
+		tokenError(w, errors.ErrInvalidRequest)
+		return
+	}
+
+	var da deviceAuthorization
+	if err := getJSON(ctx, stateStore, deviceCodeKey(deviceCode), &da); err != nil {
+		if err == errKeyNotFound {
+			err = ErrExpiredToken
+		}
+		tokenError(w, err)
+		return
+	}
+	if da.ClientID != client.GetID() {
+		tokenError(w, errors.ErrInvalidGrant)
+		return
+	}
+
+	switch da.Status {
+	case devicePending:
+		tokenError(w, pollDeviceCode(ctx, deviceCode, da.ExpiresAt))
+		return
+	case deviceDenied:
+		stateStore.Del(ctx, deviceCodeKey(deviceCode))
+		stateStore.Del(ctx, devicePollingKey(deviceCode))
+		tokenError(w, errors.ErrAccessDenied)
+		return
+	}
+
+	// the device code can be redeemed once: only one request gets it
+	if err := getDelJSON(ctx, stateStore, deviceCodeKey(deviceCode), &da); err != nil {
+		if err == errKeyNotFound {
+			err = errors.ErrInvalidGrant
+		}
+		tokenError(w, err)
+		return
+	}
+	stateStore.Del(ctx, devicePollingKey(deviceCode))
+	if da.Status != deviceApproved || da.ClientID != client.GetID() {
+		tokenError(w, errors.ErrInvalidGrant)
+		return
+	}
+
+	_, clientSecret := clientCredentials(r)
+	ti, err := generateExtensionToken(ctx, deviceCodeGrantType, &oauth2.TokenGenerateRequest{
+		ClientID:     client.GetID(),
+		ClientSecret: clientSecret,
+		UserID:       da.UserID,
+		Scope:        da.Scope,
+		Request:      r,
+	}, true)
+	if err != nil {
+		tokenError(w, err)
+		return
+	}
+	writeJSON(w, tokenData(ctx, ti), nil, http.StatusOK)
+}
+
+// pollDeviceCode records a poll for a pending device code and returns
+// authorization_pending, or slow_down when the device polls faster than its
+// interval, which then grows.
+func pollDeviceCode(ctx context.Context, deviceCode string, expiresAt time.Time) error {
+	p := devicePolling{Interval: deviceCodeInterval}
+	if err := getJSON(ctx, stateStore, devicePollingKey(deviceCode), &p); err != nil && err != errKeyNotFound {
+		return err
+	}
+	err := ErrAuthorizationPending
+	now := time.Now()
+	if now.Sub(p.PolledAt) < p.Interval {
+		err = ErrSlowDown
+		p.Interval += deviceCodeInterval
+	}
+	p.PolledAt = now
+	ttl := time.Until(expiresAt)
+	if ttl <= 0 {
+		return ErrExpiredToken
+	}
+	if serr := setJSON(ctx, stateStore, devicePollingKey(deviceCode), p, ttl); serr != nil {
+		return serr
+	}
+	return err
+}
+
+var deviceTemplate = template.Must(template.New("device").Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>Device login</title>
+</head>
+<body>
+{{if .Message}}<p>{{.Message}}</p>{{end}}
+{{with .Consent}}
+{{if .LogoURI}}<img src="{{.LogoURI}}" alt="" height="64">{{end}}
+<p><strong>{{.ClientName}}</strong> asks for access to your account on the device showing the code {{$.UserCode}}.</p>
+{{if .Scopes}}
+<ul>
+{{range .Scopes}}<li>{{if .Description}}{{.Description}}{{else}}{{.Name}}{{end}}</li>
+{{end}}</ul>
+{{end}}
+<form method="post" action="/device">
+<input type="hidden" name="confirm_id" value="{{.ID}}">
+<button name="action" value="approve">Approve</button>
+<button name="action" value="deny">Deny</button>
+</form>
+{{else}}{{if not .Done}}
+<form method="post" action="/device">
+<label>Code <input name="user_code" value="{{.UserCode}}" autocomplete="off" required></label>
+<button>Continue</button>
+</form>
+{{end}}{{end}}
+</body>
+</html>
+`))
+
+type devicePage struct {
+	UserCode string
+	Message  string
+	Done     bool
+	// Consent shows the client and the scopes of the device authorization
+	// to approve.
+	Consent *consentPage
+}
+
+func renderDevicePage(w http.ResponseWriter, statusCode int, page devicePage) {
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.Header().Set("Cache-Control", "no-store")
+	w.Header().Set("X-Frame-Options", "DENY")
+	w.WriteHeader(statusCode)
+	if err := deviceTemplate.Execute(w, page); err != nil {
+		errorLogger.Error("[deviceHandle]", "error", err.Error())
+	}
+}
+
+// deviceHandler is the verification page. The logged-in user enters the user
+// code shown on the device, is shown the client and the scopes it asks for,
+// and approves or denies them. Approving also records the consent of the user
+// to the client.
+func deviceHandler(w http.ResponseWriter, r *http.Request) {
+	userID, err := srv.UserAuthorizationHandler(w, r)
+	if err != nil {
+		renderDevicePage(w, http.StatusUnauthorized, devicePage{Message: "Please log in first.", Done: true})
+		return
+	}
+	if userID == "" {
+		// redirected to the login page
+		return
+	}
+
+	if r.Method == http.MethodPost && r.PostFormValue("confirm_id") != "" {
+		decideDeviceAuthorization(w, r, userID)
+		return
+	}
+
+	userCode := r.FormValue("user_code")
+	if userCode == "" {
+		renderDevicePage(w, http.StatusOK, devicePage{})
+		return
+	}
+
+	ctx := r.Context()
+	deviceCode, err := stateStore.Get(ctx, userCodeKey(userCode))
+	var da deviceAuthorization
+	if err == nil {
+		err = getJSON(ctx, stateStore, deviceCodeKey(string(deviceCode)), &da)
+	}
+	if err != nil || da.Status != devicePending {
+		renderDevicePage(w, http.StatusBadRequest, devicePage{UserCode: userCode, Message: "The code is invalid or has expired."})
+		return
+	}
+
+	client, err := getOauth2Client(ctx, da.ClientID)
+	var id string
+	if err == nil {
+		id, err = randomString(32)
+	}
+	if err == nil {
+		dc := deviceConfirmation{UserID: userID, DeviceCode: string(deviceCode)}
+		err = setJSON(ctx, stateStore, deviceConfirmationKey(id), dc, time.Until(da.ExpiresAt))
+	}
+	var page consentPage
+	if err == nil {
+		page, err = newConsentPage(r, id, client, da.Scope)
+	}
+	if err != nil {
+		errorLogger.Error("[deviceHandle]", "error", err.Error())
+		renderDevicePage(w, http.StatusInternalServerError, devicePage{UserCode: userCode, Message: "Something went wrong, please try again."})
+		return
+	}
+	renderDevicePage(w, http.StatusOK, devicePage{UserCode: da.UserCode, Consent: &page})
+}
+
+// decideDeviceAuthorization approves or denies the device authorization the
+// user was shown. The user code is consumed at once, so that the device
+// authorization is decided only once.
+func decideDeviceAuthorization(w http.ResponseWriter, r *http.Request, userID string) {
+	ctx := r.Context()
+	var dc deviceConfirmation
+	err := getDelJSON(ctx, stateStore, deviceConfirmationKey(r.PostFormValue("confirm_id")), &dc)
+	if err == nil && dc.UserID != userID {
+		err = errKeyNotFound
+	}
+	var da deviceAuthorization
+	if err == nil {
+		err = getJSON(ctx, stateStore, deviceCodeKey(dc.DeviceCode), &da)
+	}
+	var deviceCode []byte
+	if err == nil {
+		deviceCode, err = stateStore.GetDel(ctx, userCodeKey(da.UserCode))
+	}
+	if err != nil || string(deviceCode) != dc.DeviceCode || da.Status != devicePending {
+		renderDevicePage(w, http.StatusBadRequest, devicePage{Message: "The code is invalid or has expired."})
+		return
+	}
+
+	message := "The device has been approved, you can return to it now."
+	da.Status, da.UserID = deviceApproved, userID
+	if r.PostFormValue("action") != "approve" {
+		message = "The device has been denied."
+		da.Status = deviceDenied
+	} else {
+		err = saveDeviceConsent(ctx, userID, &da)
+	}
+	if err == nil {
+		err = saveDeviceAuthorization(ctx, dc.DeviceCode, &da)
+	}
+	if err != nil {
+		errorLogger.Error("[deviceHandle]", "error", err.Error())
+		renderDevicePage(w, http.StatusInternalServerError, devicePage{Message: "Something went wrong, please try again."})
+		return
+	}
+
+	logger.Info("[deviceHandle]", "msg", "device authorization decided", "clientID", da.ClientID, "userID", userID, "status", da.Status)
+	renderDevicePage(w, http.StatusOK, devicePage{Message: message, Done: true})
+}
+
+// saveDeviceConsent records the consent of the user to the scopes of an
+// approved device authorization, like the consent screen, for clients that
+// are not first party.
+func saveDeviceConsent(ctx context.Context, userID string, da *deviceAuthorization) error {
+	client, err := getOauth2Client(ctx, da.ClientID)
+	if err != nil || client.FirstParty {
+		return err
+	}
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return err
+	}
+	scopes, err := consentScopes(ctx, strings.Fields(da.Scope))
+	if err != nil {
+		return err
+	}
+	return saveConsent(ctx, uid, client.ID, scopes)
+}
+
+// newUserCode returns a random user code formatted as XXXX-XXXX.
+func newUserCode() (string, error) {
+	var b strings.Builder
+	max := big.NewInt(int64(len(userCodeAlphabet)))
+	for i := 0; i < 8; i++ {
+		if i == 4 {
+			b.WriteByte('-')
+		}
+		n, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			return "", err
+		}
+		b.WriteByte(userCodeAlphabet[n.Int64()])
+	}
+	return b.String(), nil
+}
+
+// randomString returns n random bytes, base64url encoded.
+func randomString(n int) (string, error) {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
@@ -0,0 +1,195 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"regexp"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/byebyebymyai/oauth2-api/ent"
+	"github.com/byebyebymyai/oauth2-api/ent/consent"
+	"github.com/byebyebymyai/oauth2-api/ent/oauth2client"
+)
+
+func postTestForm(t *testing.T, handler http.HandlerFunc, target string, form url.Values) (int, map[string]interface{}) {
+	t.Helper()
+	r := httptest.NewRequest("POST", target, strings.NewReader(form.Encode()))
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	w := httptest.NewRecorder()
+	handler(w, r)
+	var data map[string]interface{}
+	json.Unmarshal(w.Body.Bytes(), &data)
+	return w.Code, data
+}
+
+// decideTestDevice enters userCode on the verification page as a logged-in
+// user and approves or denies the device authorization shown.
+func decideTestDevice(t *testing.T, userCode, action string) {
+	t.Helper()
+	withTestUser(t, uuid.NewString())
+
+	r := httptest.NewRequest("GET", "/device?user_code="+url.QueryEscape(userCode), nil)
+	w := httptest.NewRecorder()
+	deviceHandler(w, r)
+	m := regexp.MustCompile(`name="confirm_id" value="([^"]+)"`).FindStringSubmatch(w.Body.String())
+	if w.Code != http.StatusOK || m == nil {
+		t.Fatalf("device page status = %d, body %s", w.Code, w.Body)
+	}
+
+	status, _ := postTestForm(t, deviceHandler, "/device", url.Values{"confirm_id": {m[1]}, "action": {action}})
+	if status != http.StatusOK {
+		t.Fatalf("device decision status = %d", status)
+	}
+}
+
+func TestDeviceAuthorizationGrant(t *testing.T) {
+	client := addTestClient(&ent.Oauth2Client{Secret: "secret", FirstParty: true})
+	credentials := url.Values{"client_id": {client.GetID()}, "client_secret": {"secret"}}
+
+	authorize := func(t *testing.T) (deviceCode, userCode string) {
+		t.Helper()
+		form := url.Values{"scope": {"openid"}}
+		for k, v := range credentials {
+			form[k] = v
+		}
+		status, data := postTestForm(t, deviceAuthorizationHandler, "/device_authorization", form)
+		if status != http.StatusOK {
+			t.Fatalf("status = %d, body %v", status, data)
+		}
+		if !strings.HasSuffix(data["verification_uri"].(string), "/device") {
+			t.Errorf("verification_uri = %v", data["verification_uri"])
+		}
+		return data["device_code"].(string), data["user_code"].(string)
+	}
+	poll := func(t *testing.T, deviceCode string) (int, map[string]interface{}) {
+		t.Helper()
+		form := url.Values{"grant_type": {deviceCodeGrantType}, "device_code": {deviceCode}}
+		for k, v := range credentials {
+			form[k] = v
+		}
+		return postTestForm(t, tokenHandler, "/token", form)
+	}
+
+	t.Run("approved", func(t *testing.T) {
+		deviceCode, userCode := authorize(t)
+
+		if _, data := poll(t, deviceCode); data["error"] != "authorization_pending" {
+			t.Errorf("first poll = %v", data)
+		}
+		if _, data := poll(t, deviceCode); data["error"] != "slow_down" {
+			t.Errorf("second poll = %v", data)
+		}
+
+		decideTestDevice(t, strings.ToLower(userCode), "approve")
+		status, data := poll(t, deviceCode)
+		if status != http.StatusOK || data["access_token"] == nil || data["refresh_token"] == nil {
+			t.Fatalf("status = %d, body %v", status, data)
+		}
+
+		if _, data := poll(t, deviceCode); data["error"] != "expired_token" {
+			t.Errorf("redeemed device code = %v", data)
+		}
+	})
+	t.Run("denied", func(t *testing.T) {
+		deviceCode, userCode := authorize(t)
+		decideTestDevice(t, userCode, "deny")
+		if _, data := poll(t, deviceCode); data["error"] != "access_denied" {
+			t.Errorf("poll = %v", data)
+		}
+	})
+	t.Run("decided by another user", func(t *testing.T) {
+		_, userCode := authorize(t)
+		withTestUser(t, uuid.NewString())
+		r := httptest.NewRequest("GET", "/device?user_code="+url.QueryEscape(userCode), nil)
+		w := httptest.NewRecorder()
+		deviceHandler(w, r)
+		m := regexp.MustCompile(`name="confirm_id" value="([^"]+)"`).FindStringSubmatch(w.Body.String())
+		if m == nil {
+			t.Fatalf("device page = %s", w.Body)
+		}
+
+		withTestUser(t, uuid.NewString())
+		form := url.Values{"confirm_id": {m[1]}, "action": {"approve"}}
+		if status, _ := postTestForm(t, deviceHandler, "/device", form); status != http.StatusBadRequest {
+			t.Errorf("status = %d, want %d", status, http.StatusBadRequest)
+		}
+	})
+	t.Run("other client", func(t *testing.T) {
+		deviceCode, _ := authorize(t)
+		other := addTestClient(&ent.Oauth2Client{Secret: "secret"})
+		form := url.Values{
+			"grant_type":    {deviceCodeGrantType},
+			"device_code":   {deviceCode},
+			"client_id":     {other.GetID()},
+			"client_secret": {"secret"},
+		}
+		if _, data := postTestForm(t, tokenHandler, "/token", form); data["error"] != "invalid_grant" {
+			t.Errorf("poll = %v", data)
+		}
+	})
+}
+
+func TestDeviceApprovalConsent(t *testing.T) {
+	client := saveTestClient(t, addTestClient(&ent.Oauth2Client{Secret: "secret", Domain: "https://app.example.com"}))
+	_, data := postTestForm(t, deviceAuthorizationHandler, "/device_authorization", url.Values{
+		"client_id":     {client.GetID()},
+		"client_secret": {"secret"},
+		"scope":         {"openid"},
+	})
+	userCode, _ := data["user_code"].(string)
+
+	decideTestDevice(t, userCode, "approve")
+	consents, err := entClient.Consent.Query().Where(consent.HasClientWith(oauth2client.ID(client.ID))).All(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(consents) != 1 || !slices.Equal(consents[0].Scopes, []string{"openid"}) {
+		t.Errorf("consents = %v", consents)
+	}
+}
+
+func TestDeviceAuthorizationClientPolicy(t *testing.T) {
+	tests := []struct {
+		name   string
+		client *ent.Oauth2Client
+		scope  string
+		want   string
+	}{
+		{name: "grant not allowed", client: &ent.Oauth2Client{Secret: "secret", GrantTypes: []string{"authorization_code"}}, want: "unauthorized_client"},
+		{name: "scope not allowed", client: &ent.Oauth2Client{Secret: "secret", AllowedScopes: []string{"openid"}}, scope: "openid admin", want: "invalid_scope"},
+		{name: "allowed", client: &ent.Oauth2Client{Secret: "secret", GrantTypes: []string{deviceCodeGrantType}, AllowedScopes: []string{"openid"}}, scope: "openid"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := addTestClient(tt.client)
+			_, data := postTestForm(t, deviceAuthorizationHandler, "/device_authorization", url.Values{
+				"client_id":     {client.GetID()},
+				"client_secret": {"secret"},
+				"scope":         {tt.scope},
+			})
+			if tt.want == "" && data["device_code"] == nil || tt.want != "" && data["error"] != tt.want {
+				t.Errorf("response = %v, want %q", data, tt.want)
+			}
+		})
+	}
+}
+
+func TestNormalizeUserCode(t *testing.T) {
+	code, err := newUserCode()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(code) != 9 || code[4] != '-' {
+		t.Errorf("newUserCode() = %q", code)
+	}
+	if got := normalizeUserCode(" bcdf-ghjk"); got != "BCDFGHJK" {
+		t.Errorf("normalizeUserCode() = %q", got)
+	}
+}
@@ -0,0 +1,162 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"slices"
+
+	"github.com/go-oauth2/oauth2/v4"
+
+	"github.com/byebyebymyai/oauth2-api/ent/scope"
+)
+
+// scopesSupported lists the scopes advertised in the server metadata, besides
+// the registered scopes.
+var scopesSupported = []string{"openid", "profile", "phone", "group", "position", "roles"}
+
+// serverMetadata builds the authorization server metadata (RFC 8414) from the
+// configuration of srv.
+func serverMetadata(r *http.Request) map[string]interface{} {
+	base := issuer
+
+	grantTypes, responseTypes := supportedGrantTypes(), supportedResponseTypes()
+	var codeChallengeMethods []string
+	for _, ccm := range srv.Config.AllowedCodeChallengeMethods {
+		codeChallengeMethods = append(codeChallengeMethods, ccm.String())
+	}
+	authSigningAlgorithms := assertionAlgorithms
+	if len(clientSecretKey) > 0 {
+		authSigningAlgorithms = slices.Concat(assertionAlgorithms, clientSecretJWTAlgorithms)
+	}
+
+	metadata := map[string]interface{}{
+		"issuer":                                           base,
+		"authorization_endpoint":                           base + "/authorize",
+		"token_endpoint":                                   base + "/token",
+		"jwks_uri":                                         base + "/.well-known/jwks.json",
+		"introspection_endpoint":                           base + "/introspect",
+		"revocation_endpoint":                              base + "/revoke",
+		"pushed_authorization_request_endpoint":            base + "/par",
+		"registration_endpoint":                            base + "/register",
+		"end_session_endpoint":                             base + "/logout",
+		"request_parameter_supported":                      true,
+		"request_uri_parameter_supported":                  true,
+		"require_request_uri_registration":                 true,
+		"request_object_signing_alg_values_supported":      assertionAlgorithms,
+		"grant_types_supported":                            grantTypes,
+		"response_types_supported":                         responseTypes,
+		"response_modes_supported":                         []string{"query", "fragment"},
+		"code_challenge_methods_supported":                 codeChallengeMethods,
+		"token_endpoint_auth_methods_supported":            supportedAuthMethods(tokenEndpointAuthMethods),
+		"token_endpoint_auth_signing_alg_values_supported": authSigningAlgorithms,
+		"introspection_endpoint_auth_methods_supported":    supportedAuthMethods(clientAuthMethods),
+		"revocation_endpoint_auth_methods_supported":       supportedAuthMethods(clientAuthMethods),
+		"dpop_signing_alg_values_supported":                assertionAlgorithms,
+	}
+	if slices.Contains(grantTypes, deviceCodeGrantType) {
+		metadata["device_authorization_endpoint"] = base + "/device_authorization"
+	}
+	if mtlsEnabled() {
+		mtlsBase := mtlsBaseURL()
+		metadata["tls_client_certificate_bound_access_tokens"] = true
+		metadata["mtls_endpoint_aliases"] = map[string]string{
+			"token_endpoint":                        mtlsBase + "/token",
+			"introspection_endpoint":                mtlsBase + "/introspect",
+			"revocation_endpoint":                   mtlsBase + "/revoke",
+			"pushed_authorization_request_endpoint": mtlsBase + "/par",
+			"userinfo_endpoint":                     mtlsBase + "/userinfo",
+		}
+	}
+	scopes := slices.Clone(scopesSupported)
+	names, err := entClient.Scope.Query().Select(scope.FieldName).Strings(r.Context())
+	if err != nil {
+		errorLogger.Error("[serverMetadata]", "error", err.Error())
+	}
+	for _, name := range names {
+		if !slices.Contains(scopes, name) {
+			scopes = append(scopes, name)
+		}
+	}
+	if len(scopes) > 0 {
+		metadata["scopes_supported"] = scopes
+	}
+	return metadata
+}
+
+// supportedAuthMethods returns the client authentication methods of methods
+// that the server can check: the TLS client authentication methods only when
+// mutual TLS is enabled, and client_secret_jwt only with CLIENT_SECRET_KEY.
+func supportedAuthMethods(methods []string) []string {
+	return slices.DeleteFunc(slices.Clone(methods), func(m string) bool {
+		switch m {
+		case "tls_client_auth", "self_signed_tls_client_auth":
+			return !mtlsEnabled()
+		case "client_secret_jwt":
+			return len(clientSecretKey) == 0
+		}
+		return false
+	})
+}
+
+// supportedGrantTypes returns the grant types allowed by srv, including
+// implicit when the token response type is allowed.
+func supportedGrantTypes() []string {
+	var grantTypes []string
+	for _, gt := range srv.Config.AllowedGrantTypes {
+		grantTypes = append(grantTypes, string(gt))
+	}
+	if slices.Contains(srv.Config.AllowedResponseTypes, oauth2.Token) {
+		grantTypes = append(grantTypes, "implicit")
+	}
+	return grantTypes
+}
+
+// supportedResponseTypes returns the response types allowed by srv.
+func supportedResponseTypes() []string {
+	var responseTypes []string
+	for _, rt := range srv.Config.AllowedResponseTypes {
+		responseTypes = append(responseTypes, rt.String())
+	}
+	return responseTypes
+}
+
+// signingAlgorithms returns the algorithms of the local signing keys, which
+// sign the ID tokens.
+func signingAlgorithms() []string {
+	keys, err := signingKeys.Keys()
+	if err != nil {
+		errorLogger.Error("[signingAlgorithms]", "error", err.Error())
+		return nil
+	}
+	var algs []string
+	for _, k := range keys {
+		if k.Alg != "" && !slices.Contains(algs, k.Alg) {
+			algs = append(algs, k.Alg)
+		}
+	}
+	return algs
+}
+
+// authorizationServerMetadataHandler serves
+// /.well-known/oauth-authorization-server.
+func authorizationServerMetadataHandler(w http.ResponseWriter, r *http.Request) {
+	writeMetadata(w, serverMetadata(r))
+}
+
+// openIDConfigurationHandler serves /.well-known/openid-configuration.
+func openIDConfigurationHandler(w http.ResponseWriter, r *http.Request) {
+	metadata := serverMetadata(r)
+	metadata["userinfo_endpoint"] = issuer + "/userinfo"
+	metadata["subject_types_supported"] = []string{"public"}
+	metadata["claims_supported"] = []string{"sub", "iss", "aud", "exp", "iat", "auth_time", "nonce", "preferred_username", "name", "phone_number", "group", "position", "roles"}
+	metadata["id_token_signing_alg_values_supported"] = signingAlgorithms()
+	writeMetadata(w, metadata)
+}
+
+func writeMetadata(w http.ResponseWriter, metadata map[string]interface{}) {
+	w.Header().Set("Content-Type", "application/json;charset=UTF-8")
+	w.Header().Set("Cache-Control", "public, max-age=300")
+	if err := json.NewEncoder(w).Encode(metadata); err != nil {
+		errorLogger.Error("[writeMetadata]", "error", err.Error())
+	}
+}
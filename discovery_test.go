@@ -0,0 +1,98 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http/httptest"
+	"slices"
+	"testing"
+)
+
+func TestOpenIDConfigurationHandler(t *testing.T) {
+	r := httptest.NewRequest("GET", "/.well-known/openid-configuration", nil)
+	// the issuer is configured, whatever the request says
+	r.Host = "evil.example.com"
+	r.Header.Set("X-Forwarded-Proto", "http")
+	w := httptest.NewRecorder()
+	openIDConfigurationHandler(w, r)
+
+	var metadata struct {
+		Issuer        string   `json:"issuer"`
+		TokenEndpoint string   `json:"token_endpoint"`
+		GrantTypes    []string `json:"grant_types_supported"`
+		DeviceAuthz   string   `json:"device_authorization_endpoint"`
+		ResponseTypes []string `json:"response_types_supported"`
+		SigningAlgs   []string `json:"id_token_signing_alg_values_supported"`
+	}
+	if err := json.Unmarshal(w.Body.Bytes(), &metadata); err != nil {
+		t.Fatal(err)
+	}
+	if metadata.Issuer != "https://as.example.com" || metadata.TokenEndpoint != "https://as.example.com/token" {
+		t.Errorf("issuer = %q, token_endpoint = %q", metadata.Issuer, metadata.TokenEndpoint)
+	}
+	if !slices.Contains(metadata.GrantTypes, "authorization_code") || !slices.Contains(metadata.ResponseTypes, "code") {
+		t.Errorf("grant_types_supported = %v, response_types_supported = %v", metadata.GrantTypes, metadata.ResponseTypes)
+	}
+	if !slices.Contains(metadata.GrantTypes, deviceCodeGrantType) || metadata.DeviceAuthz != "https://as.example.com/device_authorization" {
+		t.Errorf("grant_types_supported = %v, device_authorization_endpoint = %q", metadata.GrantTypes, metadata.DeviceAuthz)
+	}
+	if !slices.Equal(metadata.SigningAlgs, []string{"RS256"}) {
+		t.Errorf("id_token_signing_alg_values_supported = %v", metadata.SigningAlgs)
+	}
+}
+
+func TestServerMetadataMutualTLS(t *testing.T) {
+	metadata := func() map[string]interface{} {
+		w := httptest.NewRecorder()
+		authorizationServerMetadataHandler(w, httptest.NewRequest("GET", "/.well-known/oauth-authorization-server", nil))
+		var metadata map[string]interface{}
+		if err := json.Unmarshal(w.Body.Bytes(), &metadata); err != nil {
+			t.Fatal(err)
+		}
+		return metadata
+	}
+	hasMethod := func(m map[string]interface{}, method string) bool {
+		methods, _ := m["token_endpoint_auth_methods_supported"].([]interface{})
+		return slices.Contains(methods, interface{}(method))
+	}
+
+	m := metadata()
+	if hasMethod(m, "tls_client_auth") || hasMethod(m, "self_signed_tls_client_auth") || m["tls_client_certificate_bound_access_tokens"] != nil || m["mtls_endpoint_aliases"] != nil {
+		t.Errorf("metadata without mutual TLS = %v", m)
+	}
+
+	certFile, caFile := tlsCertFile, tlsClientCAFile
+	tlsCertFile, tlsClientCAFile = "server.crt", "ca.crt"
+	t.Cleanup(func() { tlsCertFile, tlsClientCAFile = certFile, caFile })
+	m = metadata()
+	aliases, _ := m["mtls_endpoint_aliases"].(map[string]interface{})
+	if !hasMethod(m, "tls_client_auth") || m["tls_client_certificate_bound_access_tokens"] != true || aliases["token_endpoint"] != "https://as.example.com:8443/token" {
+		t.Errorf("metadata with mutual TLS = %v", m)
+	}
+}
+
+func TestServerMetadataClientSecretJWT(t *testing.T) {
+	metadata := func() map[string]interface{} {
+		w := httptest.NewRecorder()
+		authorizationServerMetadataHandler(w, httptest.NewRequest("GET", "/.well-known/oauth-authorization-server", nil))
+		var metadata map[string]interface{}
+		if err := json.Unmarshal(w.Body.Bytes(), &metadata); err != nil {
+			t.Fatal(err)
+		}
+		return metadata
+	}
+	supports := func(m map[string]interface{}) bool {
+		methods, _ := m["token_endpoint_auth_methods_supported"].([]interface{})
+		algs, _ := m["token_endpoint_auth_signing_alg_values_supported"].([]interface{})
+		return slices.Contains(methods, interface{}("client_secret_jwt")) && slices.Contains(algs, interface{}("HS256"))
+	}
+
+	if m := metadata(); !supports(m) {
+		t.Errorf("metadata with a client secret key = %v", m)
+	}
+	key := clientSecretKey
+	clientSecretKey = nil
+	t.Cleanup(func() { clientSecretKey = key })
+	if m := metadata(); supports(m) {
+		t.Errorf("metadata without a client secret key = %v", m)
+	}
+}
@@ -0,0 +1,216 @@
+package main
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/go-oauth2/oauth2/v4"
+	"github.com/go-oauth2/oauth2/v4/errors"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	// dpopProofWindow is how old a DPoP proof may be. Its jti is remembered
+	// as long.
+	dpopProofWindow = 5 * time.Minute
+	// dpopNonceExpiration is how long a server nonce is accepted.
+	dpopNonceExpiration = 5 * time.Minute
+)
+
+var (
+	ErrInvalidDPoPProof = errors.New("invalid_dpop_proof")
+	ErrUseDPoPNonce     = errors.New("use_dpop_nonce")
+)
+
+func init() {
+	errors.Descriptions[ErrInvalidDPoPProof] = "The DPoP proof is missing or invalid"
+	errors.Descriptions[ErrUseDPoPNonce] = "The DPoP proof must contain the nonce of the DPoP-Nonce header"
+	errors.StatusCodes[ErrInvalidDPoPProof] = http.StatusBadRequest
+	errors.StatusCodes[ErrUseDPoPNonce] = http.StatusBadRequest
+}
+
+// tokenRequestDPoP validates the DPoP proof of a token request and returns
+// the thumbprint of its key, or "" without proof. Clients with
+// dpop_bound_access_tokens must send one.
+func tokenRequestDPoP(w http.ResponseWriter, r *http.Request) (string, error) {
+	if r.Header.Get("DPoP") == "" {
+		clientID := authenticatedClientFromContext(r.Context())
+		if clientID == "" {
+			clientID, _ = clientCredentials(r)
+		}
+		if client, err := getOauth2Client(r.Context(), clientID); err == nil && client.DpopBoundAccessTokens {
+			return "", ErrInvalidDPoPProof
+		}
+		return "", nil
+	}
+	return validateDPoPProof(w, r, r.Method, issuer+r.URL.Path, "")
+}
+
+// validateDPoPProof validates the DPoP proof of a request (RFC 9449) and
+// returns the thumbprint of its key. The proof must be made for the method
+// and the URI of the resource request, which is r itself unless r asks about
+// another request. accessToken is the token the proof is presented with on
+// resource requests, or "" on token requests. When a nonce is required and
+// missing, a new one is set in the DPoP-Nonce header.
+func validateDPoPProof(w http.ResponseWriter, r *http.Request, method, uri, accessToken string) (string, error) {
+	proofs := r.Header.Values("DPoP")
+	if len(proofs) != 1 {
+		return "", ErrInvalidDPoPProof
+	}
+
+	var jwk JSONWebKey
+	claims := jwt.MapClaims{}
+	_, err := jwt.ParseWithClaims(proofs[0], claims, func(token *jwt.Token) (interface{}, error) {
+		if typ, _ := token.Header["typ"].(string); typ != "dpop+jwt" {
+			return nil, errors.New("invalid typ")
+		}
+		b, err := json.Marshal(token.Header["jwk"])
+		if err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(b, &jwk); err != nil {
+			return nil, err
+		}
+		return jwk.PublicKey()
+	}, jwt.WithValidMethods(assertionAlgorithms))
+	if err != nil {
+		errorLogger.Error("[validateDPoPProof]", "error", err.Error())
+		return "", ErrInvalidDPoPProof
+	}
+
+	htm, _ := claims["htm"].(string)
+	htu, _ := claims["htu"].(string)
+	jti, _ := claims["jti"].(string)
+	iat, err := claims.GetIssuedAt()
+	if htm != method || !sameHTU(htu, uri) || jti == "" || err != nil || iat == nil {
+		return "", ErrInvalidDPoPProof
+	}
+	now := time.Now()
+	if iat.Before(now.Add(-dpopProofWindow)) || iat.After(now.Add(30*time.Second)) {
+		return "", ErrInvalidDPoPProof
+	}
+	if accessToken != "" {
+		sum := sha256.Sum256([]byte(accessToken))
+		if ath, _ := claims["ath"].(string); ath != base64.RawURLEncoding.EncodeToString(sum[:]) {
+			return "", ErrInvalidDPoPProof
+		}
+	}
+
+	ctx := r.Context()
+	if dpopNonceRequired {
+		nonce, _ := claims["nonce"].(string)
+		if nonce == "" {
+			return "", useDPoPNonce(ctx, w)
+		}
+		if _, err := stateStore.Get(ctx, dpopNonceKey(nonce)); err != nil {
+			return "", useDPoPNonce(ctx, w)
+		}
+	}
+
+	jkt, err := jwk.Thumbprint()
+	if err != nil {
+		return "", ErrInvalidDPoPProof
+	}
+	ok, err := stateStore.SetNX(ctx, "dpop:jti:"+jkt+":"+jti, []byte{1}, dpopProofWindow)
+	if err != nil {
+		return "", err
+	}
+	if !ok {
+		errorLogger.Error("[validateDPoPProof]", "error", "proof replayed", "jkt", jkt, "jti", jti)
+		return "", ErrInvalidDPoPProof
+	}
+	return jkt, nil
+}
+
+// useDPoPNonce sets a new server nonce in the DPoP-Nonce header and returns
+// ErrUseDPoPNonce.
+func useDPoPNonce(ctx context.Context, w http.ResponseWriter) error {
+	nonce, err := randomString(32)
+	if err != nil {
+		return err
+	}
+	if err := stateStore.Set(ctx, dpopNonceKey(nonce), []byte{1}, dpopNonceExpiration); err != nil {
+		return err
+	}
+	w.Header().Set("DPoP-Nonce", nonce)
+	return ErrUseDPoPNonce
+}
+
+func dpopNonceKey(nonce string) string {
+	return "dpop:nonce:" + nonce
+}
+
+// sameHTU compares the htu claim with the request URI, ignoring the query
+// and fragment.
+func sameHTU(htu string, uri string) bool {
+	u, err := url.Parse(htu)
+	if err != nil {
+		return false
+	}
+	u.RawQuery, u.Fragment = "", ""
+	return u.String() == uri
+}
+
+// tokenJKT returns the DPoP key thumbprint an access token is bound to, or
+// "".
+func tokenJKT(ti oauth2.TokenInfo) string {
+	cnf, _ := accessTokenClaims(ti)["cnf"].(map[string]interface{})
+	jkt, _ := cnf["jkt"].(string)
+	return jkt
+}
+
+// certificateBound reports whether r was sent with the client certificate ti
+// is bound to, or ti is not bound to one.
+func certificateBound(r *http.Request, ti oauth2.TokenInfo) bool {
+	cnf, _ := accessTokenClaims(ti)["cnf"].(map[string]interface{})
+	x5t, _ := cnf["x5t#S256"].(string)
+	if x5t == "" {
+		return true
+	}
+	cert := clientCertificate(r)
+	return cert != nil && certificateThumbprint(cert) == x5t
+}
+
+// validateAccessToken validates the access token of a resource request like
+// srv.ValidationBearerToken. Tokens bound to a DPoP key must be sent with the
+// DPoP scheme and a proof of that key, and tokens bound to a certificate over
+// mutual TLS with that certificate.
+func validateAccessToken(w http.ResponseWriter, r *http.Request) (oauth2.TokenInfo, error) {
+	return validateAccessTokenFor(w, r, r.Method, issuer+r.URL.Path)
+}
+
+// validateAccessTokenFor validates the access token of r like
+// validateAccessToken, for a resource request with method and uri, such as
+// the original request a reverse proxy asks about.
+func validateAccessTokenFor(w http.ResponseWriter, r *http.Request, method, uri string) (oauth2.TokenInfo, error) {
+	auth := r.Header.Get("Authorization")
+	if token, ok := strings.CutPrefix(auth, "DPoP "); ok {
+		jkt, err := validateDPoPProof(w, r, method, uri, token)
+		if err != nil {
+			return nil, err
+		}
+		ti, err := srv.Manager.LoadAccessToken(r.Context(), token)
+		if err != nil {
+			return nil, err
+		}
+		if tokenJKT(ti) != jkt || !certificateBound(r, ti) {
+			return nil, errors.ErrInvalidAccessToken
+		}
+		return ti, nil
+	}
+
+	ti, err := srv.ValidationBearerToken(r)
+	if err != nil {
+		return nil, err
+	}
+	if tokenJKT(ti) != "" || !certificateBound(r, ti) {
+		return nil, errors.ErrInvalidAccessToken
+	}
+	return ti, nil
+}
@@ -0,0 +1,334 @@
+package main
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-oauth2/oauth2/v4/models"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+
+	"github.com/byebyebymyai/oauth2-api/ent"
+)
+
+// newTestDPoPProof signs a DPoP proof with key. Claims set to nil are left
+// out.
+func newTestDPoPProof(t *testing.T, key *ecdsa.PrivateKey, typ string, claims jwt.MapClaims) string {
+	t.Helper()
+	jwk, err := newJSONWebKey(key.Public())
+	if err != nil {
+		t.Fatal(err)
+	}
+	for k, v := range claims {
+		if v == nil {
+			delete(claims, k)
+		}
+	}
+	token := jwt.NewWithClaims(jwt.SigningMethodES256, claims)
+	token.Header["typ"] = typ
+	token.Header["jwk"] = jwk
+	proof, err := token.SignedString(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return proof
+}
+
+func TestValidateDPoPProof(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	jwk, err := newJSONWebKey(key.Public())
+	if err != nil {
+		t.Fatal(err)
+	}
+	jkt, err := jwk.Thumbprint()
+	if err != nil {
+		t.Fatal(err)
+	}
+	const accessToken = "access-token"
+	sum := sha256.Sum256([]byte(accessToken))
+	ath := base64.RawURLEncoding.EncodeToString(sum[:])
+	replayed := uuid.NewString()
+
+	tests := []struct {
+		name        string
+		typ         string
+		claims      jwt.MapClaims
+		accessToken string
+		wantErr     bool
+	}{
+		{
+			name:   "token request",
+			claims: jwt.MapClaims{},
+		},
+		{
+			name:   "htu query ignored",
+			claims: jwt.MapClaims{"htu": "https://as.example.com/token?x=1"},
+		},
+		{
+			name:        "resource request with ath",
+			claims:      jwt.MapClaims{"ath": ath},
+			accessToken: accessToken,
+		},
+		{
+			name:        "ath missing",
+			claims:      jwt.MapClaims{},
+			accessToken: accessToken,
+			wantErr:     true,
+		},
+		{
+			name:        "ath of another token",
+			claims:      jwt.MapClaims{"ath": "other"},
+			accessToken: accessToken,
+			wantErr:     true,
+		},
+		{
+			name:    "typ",
+			typ:     "JWT",
+			claims:  jwt.MapClaims{},
+			wantErr: true,
+		},
+		{
+			name:    "htm",
+			claims:  jwt.MapClaims{"htm": "GET"},
+			wantErr: true,
+		},
+		{
+			name:    "htu",
+			claims:  jwt.MapClaims{"htu": "https://as.example.com/userinfo"},
+			wantErr: true,
+		},
+		{
+			name:    "jti missing",
+			claims:  jwt.MapClaims{"jti": nil},
+			wantErr: true,
+		},
+		{
+			name:    "iat missing",
+			claims:  jwt.MapClaims{"iat": nil},
+			wantErr: true,
+		},
+		{
+			name:    "iat too old",
+			claims:  jwt.MapClaims{"iat": time.Now().Add(-dpopProofWindow - time.Minute).Unix()},
+			wantErr: true,
+		},
+		{
+			name:    "iat in the future",
+			claims:  jwt.MapClaims{"iat": time.Now().Add(time.Minute).Unix()},
+			wantErr: true,
+		},
+		{
+			name:   "first use",
+			claims: jwt.MapClaims{"jti": replayed},
+		},
+		{
+			name:    "replayed",
+			claims:  jwt.MapClaims{"jti": replayed},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			claims := jwt.MapClaims{
+				"htm": "POST",
+				"htu": "https://as.example.com/token",
+				"iat": time.Now().Unix(),
+				"jti": uuid.NewString(),
+			}
+			for k, v := range tt.claims {
+				claims[k] = v
+			}
+			typ := tt.typ
+			if typ == "" {
+				typ = "dpop+jwt"
+			}
+			r := httptest.NewRequest("POST", "/token", nil)
+			r.Header.Set("DPoP", newTestDPoPProof(t, key, typ, claims))
+
+			got, err := validateDPoPProof(httptest.NewRecorder(), r, "POST", "https://as.example.com/token", tt.accessToken)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("validateDPoPProof() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && got != jkt {
+				t.Errorf("validateDPoPProof() = %q, want %q", got, jkt)
+			}
+		})
+	}
+}
+
+func TestValidateDPoPProofHeaders(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	proof := func() string {
+		return newTestDPoPProof(t, key, "dpop+jwt", jwt.MapClaims{"htm": "POST", "htu": "https://as.example.com/token", "iat": time.Now().Unix(), "jti": uuid.NewString()})
+	}
+
+	tests := []struct {
+		name   string
+		proofs []string
+	}{
+		{name: "missing"},
+		{name: "two proofs", proofs: []string{proof(), proof()}},
+		{name: "not a JWT", proofs: []string{"proof"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest("POST", "/token", nil)
+			for _, p := range tt.proofs {
+				r.Header.Add("DPoP", p)
+			}
+			if _, err := validateDPoPProof(httptest.NewRecorder(), r, "POST", "https://as.example.com/token", ""); err != ErrInvalidDPoPProof {
+				t.Errorf("validateDPoPProof() error = %v, want %v", err, ErrInvalidDPoPProof)
+			}
+		})
+	}
+}
+
+func TestValidateDPoPProofNonce(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dpopNonceRequired = true
+	t.Cleanup(func() { dpopNonceRequired = false })
+
+	validate := func(nonce interface{}) (*httptest.ResponseRecorder, error) {
+		r := httptest.NewRequest("POST", "/token", nil)
+		r.Header.Set("DPoP", newTestDPoPProof(t, key, "dpop+jwt", jwt.MapClaims{
+			"htm":   "POST",
+			"htu":   "https://as.example.com/token",
+			"iat":   time.Now().Unix(),
+			"jti":   uuid.NewString(),
+			"nonce": nonce,
+		}))
+		w := httptest.NewRecorder()
+		_, err := validateDPoPProof(w, r, "POST", "https://as.example.com/token", "")
+		return w, err
+	}
+
+	w, err := validate(nil)
+	nonce := w.Header().Get("DPoP-Nonce")
+	if err != ErrUseDPoPNonce || nonce == "" {
+		t.Fatalf("without nonce: error = %v, DPoP-Nonce = %q", err, nonce)
+	}
+	if _, err := validate("unknown"); err != ErrUseDPoPNonce {
+		t.Errorf("unknown nonce: error = %v, want %v", err, ErrUseDPoPNonce)
+	}
+	if _, err := validate(nonce); err != nil {
+		t.Errorf("server nonce: error = %v", err)
+	}
+}
+
+func TestTokenHandlerDPoP(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := addTestClient(&ent.Oauth2Client{Secret: "secret", DpopBoundAccessTokens: true})
+
+	token := func(proof string) (int, map[string]interface{}) {
+		form := url.Values{"grant_type": {"client_credentials"}, "client_id": {client.GetID()}, "client_secret": {"secret"}}
+		r := httptest.NewRequest("POST", "/token", strings.NewReader(form.Encode()))
+		r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		if proof != "" {
+			r.Header.Set("DPoP", proof)
+		}
+		w := httptest.NewRecorder()
+		tokenHandler(w, r)
+		var data map[string]interface{}
+		json.Unmarshal(w.Body.Bytes(), &data)
+		return w.Code, data
+	}
+
+	if _, data := token(""); data["error"] != "invalid_dpop_proof" {
+		t.Errorf("without proof: %v", data)
+	}
+
+	status, data := token(newTestDPoPProof(t, key, "dpop+jwt", jwt.MapClaims{
+		"htm": "POST", "htu": "https://as.example.com/token", "iat": time.Now().Unix(), "jti": uuid.NewString(),
+	}))
+	if status != http.StatusOK || data["token_type"] != "DPoP" {
+		t.Fatalf("status = %d, body %v", status, data)
+	}
+	jwk, _ := newJSONWebKey(key.Public())
+	jkt, _ := jwk.Thumbprint()
+	if got := tokenJKT(&models.Token{Access: data["access_token"].(string)}); got != jkt {
+		t.Errorf("cnf.jkt = %q, want %q", got, jkt)
+	}
+}
+
+func TestValidateAccessToken(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	other, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	jwk, _ := newJSONWebKey(key.Public())
+	jkt, _ := jwk.Thumbprint()
+
+	client := addTestClient(&ent.Oauth2Client{})
+	access, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"cnf": map[string]interface{}{"jkt": jkt},
+	}).SignedString([]byte("key"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	bound := addTestToken(t, client, access, "")
+	bearer := addTestToken(t, client, uuid.NewString(), "")
+
+	proof := func(key *ecdsa.PrivateKey, token string) string {
+		sum := sha256.Sum256([]byte(token))
+		return newTestDPoPProof(t, key, "dpop+jwt", jwt.MapClaims{
+			"htm": "GET",
+			"htu": "https://as.example.com/userinfo",
+			"iat": time.Now().Unix(),
+			"jti": uuid.NewString(),
+			"ath": base64.RawURLEncoding.EncodeToString(sum[:]),
+		})
+	}
+
+	tests := []struct {
+		name    string
+		scheme  string
+		token   string
+		proof   string
+		wantErr bool
+	}{
+		{name: "bearer", scheme: "Bearer", token: bearer.Access},
+		{name: "DPoP", scheme: "DPoP", token: bound.Access, proof: proof(key, bound.Access)},
+		{name: "DPoP bound token as bearer", scheme: "Bearer", token: bound.Access, wantErr: true},
+		{name: "proof of another key", scheme: "DPoP", token: bound.Access, proof: proof(other, bound.Access), wantErr: true},
+		{name: "DPoP without proof", scheme: "DPoP", token: bound.Access, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest("GET", "/userinfo", nil)
+			r.Header.Set("Authorization", tt.scheme+" "+tt.token)
+			if tt.proof != "" {
+				r.Header.Set("DPoP", tt.proof)
+			}
+			if _, err := validateAccessToken(httptest.NewRecorder(), r); (err != nil) != tt.wantErr {
+				t.Errorf("validateAccessToken() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -15,7 +15,12 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/byebyebymyai/oauth2-api/ent/clientsecret"
+	"github.com/byebyebymyai/oauth2-api/ent/consent"
 	"github.com/byebyebymyai/oauth2-api/ent/oauth2client"
+	"github.com/byebyebymyai/oauth2-api/ent/scope"
+	"github.com/byebyebymyai/oauth2-api/ent/scopepermission"
 )
 
 // Client is the client that holds all ent builders.
@@ -23,8 +28,16 @@ type Client struct {
 	config
 	// Schema is the client for creating, migrating and dropping schema.
 	Schema *migrate.Schema
+	// ClientSecret is the client for interacting with the ClientSecret builders.
+	ClientSecret *ClientSecretClient
+	// Consent is the client for interacting with the Consent builders.
+	Consent *ConsentClient
 	// Oauth2Client is the client for interacting with the Oauth2Client builders.
 	Oauth2Client *Oauth2ClientClient
+	// Scope is the client for interacting with the Scope builders.
+	Scope *ScopeClient
+	// ScopePermission is the client for interacting with the ScopePermission builders.
+	ScopePermission *ScopePermissionClient
 }
 
 // NewClient creates a new client configured with the given options.
@@ -36,7 +49,11 @@ func NewClient(opts ...Option) *Client {
 
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
+	c.ClientSecret = NewClientSecretClient(c.config)
+	c.Consent = NewConsentClient(c.config)
 	c.Oauth2Client = NewOauth2ClientClient(c.config)
+	c.Scope = NewScopeClient(c.config)
+	c.ScopePermission = NewScopePermissionClient(c.config)
 }
 
 type (
@@ -127,9 +144,13 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:          ctx,
-		config:       cfg,
-		Oauth2Client: NewOauth2ClientClient(cfg),
+		ctx:             ctx,
+		config:          cfg,
+		ClientSecret:    NewClientSecretClient(cfg),
+		Consent:         NewConsentClient(cfg),
+		Oauth2Client:    NewOauth2ClientClient(cfg),
+		Scope:           NewScopeClient(cfg),
+		ScopePermission: NewScopePermissionClient(cfg),
 	}, nil
 }
 
@@ -147,16 +168,20 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:          ctx,
-		config:       cfg,
-		Oauth2Client: NewOauth2ClientClient(cfg),
+		ctx:             ctx,
+		config:          cfg,
+		ClientSecret:    NewClientSecretClient(cfg),
+		Consent:         NewConsentClient(cfg),
+		Oauth2Client:    NewOauth2ClientClient(cfg),
+		Scope:           NewScopeClient(cfg),
+		ScopePermission: NewScopePermissionClient(cfg),
 	}, nil
 }
 
 // Debug returns a new debug-client. It's used to get verbose logging on specific operations.
 //
 //	client.Debug().
-//		Oauth2Client.
+//		ClientSecret.
 //		Query().
 //		Count(ctx)
 func (c *Client) Debug() *Client {
@@ -178,25 +203,339 @@ func (c *Client) Close() error {
 // Use adds the mutation hooks to all the entity clients.
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
+	c.ClientSecret.Use(hooks...)
+	c.Consent.Use(hooks...)
 	c.Oauth2Client.Use(hooks...)
+	c.Scope.Use(hooks...)
+	c.ScopePermission.Use(hooks...)
 }
 
 // Intercept adds the query interceptors to all the entity clients.
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
+	c.ClientSecret.Intercept(interceptors...)
+	c.Consent.Intercept(interceptors...)
 	c.Oauth2Client.Intercept(interceptors...)
+	c.Scope.Intercept(interceptors...)
+	c.ScopePermission.Intercept(interceptors...)
 }
 
 // Mutate implements the ent.Mutator interface.
 func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
+	case *ClientSecretMutation:
+		return c.ClientSecret.mutate(ctx, m)
+	case *ConsentMutation:
+		return c.Consent.mutate(ctx, m)
 	case *Oauth2ClientMutation:
 		return c.Oauth2Client.mutate(ctx, m)
+	case *ScopeMutation:
+		return c.Scope.mutate(ctx, m)
+	case *ScopePermissionMutation:
+		return c.ScopePermission.mutate(ctx, m)
 	default:
 		return nil, fmt.Errorf("ent: unknown mutation type %T", m)
 	}
 }
 
+// ClientSecretClient is a client for the ClientSecret schema.
+type ClientSecretClient struct {
+	config
+}
+
+// NewClientSecretClient returns a client for the ClientSecret from the given config.
+func NewClientSecretClient(c config) *ClientSecretClient {
+	return &ClientSecretClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `clientsecret.Hooks(f(g(h())))`.
+func (c *ClientSecretClient) Use(hooks ...Hook) {
+	c.hooks.ClientSecret = append(c.hooks.ClientSecret, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `clientsecret.Intercept(f(g(h())))`.
+func (c *ClientSecretClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ClientSecret = append(c.inters.ClientSecret, interceptors...)
+}
+
+// Create returns a builder for creating a ClientSecret entity.
+func (c *ClientSecretClient) Create() *ClientSecretCreate {
+	mutation := newClientSecretMutation(c.config, OpCreate)
+	return &ClientSecretCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ClientSecret entities.
+func (c *ClientSecretClient) CreateBulk(builders ...*ClientSecretCreate) *ClientSecretCreateBulk {
+	return &ClientSecretCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ClientSecretClient) MapCreateBulk(slice any, setFunc func(*ClientSecretCreate, int)) *ClientSecretCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ClientSecretCreateBulk{err: fmt.Errorf("calling to ClientSecretClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ClientSecretCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ClientSecretCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ClientSecret.
+func (c *ClientSecretClient) Update() *ClientSecretUpdate {
+	mutation := newClientSecretMutation(c.config, OpUpdate)
+	return &ClientSecretUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ClientSecretClient) UpdateOne(cs *ClientSecret) *ClientSecretUpdateOne {
+	mutation := newClientSecretMutation(c.config, OpUpdateOne, withClientSecret(cs))
+	return &ClientSecretUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ClientSecretClient) UpdateOneID(id uuid.UUID) *ClientSecretUpdateOne {
+	mutation := newClientSecretMutation(c.config, OpUpdateOne, withClientSecretID(id))
+	return &ClientSecretUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ClientSecret.
+func (c *ClientSecretClient) Delete() *ClientSecretDelete {
+	mutation := newClientSecretMutation(c.config, OpDelete)
+	return &ClientSecretDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ClientSecretClient) DeleteOne(cs *ClientSecret) *ClientSecretDeleteOne {
+	return c.DeleteOneID(cs.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ClientSecretClient) DeleteOneID(id uuid.UUID) *ClientSecretDeleteOne {
+	builder := c.Delete().Where(clientsecret.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ClientSecretDeleteOne{builder}
+}
+
+// Query returns a query builder for ClientSecret.
+func (c *ClientSecretClient) Query() *ClientSecretQuery {
+	return &ClientSecretQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeClientSecret},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ClientSecret entity by its id.
+func (c *ClientSecretClient) Get(ctx context.Context, id uuid.UUID) (*ClientSecret, error) {
+	return c.Query().Where(clientsecret.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ClientSecretClient) GetX(ctx context.Context, id uuid.UUID) *ClientSecret {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryClient queries the client edge of a ClientSecret.
+func (c *ClientSecretClient) QueryClient(cs *ClientSecret) *Oauth2ClientQuery {
+	query := (&Oauth2ClientClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := cs.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(clientsecret.Table, clientsecret.FieldID, id),
+			sqlgraph.To(oauth2client.Table, oauth2client.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, clientsecret.ClientTable, clientsecret.ClientColumn),
+		)
+		fromV = sqlgraph.Neighbors(cs.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ClientSecretClient) Hooks() []Hook {
+	return c.hooks.ClientSecret
+}
+
+// Interceptors returns the client interceptors.
+func (c *ClientSecretClient) Interceptors() []Interceptor {
+	return c.inters.ClientSecret
+}
+
+func (c *ClientSecretClient) mutate(ctx context.Context, m *ClientSecretMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ClientSecretCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ClientSecretUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ClientSecretUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ClientSecretDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ClientSecret mutation op: %q", m.Op())
+	}
+}
+
+// ConsentClient is a client for the Consent schema.
+type ConsentClient struct {
+	config
+}
+
+// NewConsentClient returns a client for the Consent from the given config.
+func NewConsentClient(c config) *ConsentClient {
+	return &ConsentClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `consent.Hooks(f(g(h())))`.
+func (c *ConsentClient) Use(hooks ...Hook) {
+	c.hooks.Consent = append(c.hooks.Consent, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `consent.Intercept(f(g(h())))`.
+func (c *ConsentClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Consent = append(c.inters.Consent, interceptors...)
+}
+
+// Create returns a builder for creating a Consent entity.
+func (c *ConsentClient) Create() *ConsentCreate {
+	mutation := newConsentMutation(c.config, OpCreate)
+	return &ConsentCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Consent entities.
+func (c *ConsentClient) CreateBulk(builders ...*ConsentCreate) *ConsentCreateBulk {
+	return &ConsentCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ConsentClient) MapCreateBulk(slice any, setFunc func(*ConsentCreate, int)) *ConsentCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ConsentCreateBulk{err: fmt.Errorf("calling to ConsentClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ConsentCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ConsentCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Consent.
+func (c *ConsentClient) Update() *ConsentUpdate {
+	mutation := newConsentMutation(c.config, OpUpdate)
+	return &ConsentUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ConsentClient) UpdateOne(co *Consent) *ConsentUpdateOne {
+	mutation := newConsentMutation(c.config, OpUpdateOne, withConsent(co))
+	return &ConsentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ConsentClient) UpdateOneID(id uuid.UUID) *ConsentUpdateOne {
+	mutation := newConsentMutation(c.config, OpUpdateOne, withConsentID(id))
+	return &ConsentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Consent.
+func (c *ConsentClient) Delete() *ConsentDelete {
+	mutation := newConsentMutation(c.config, OpDelete)
+	return &ConsentDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ConsentClient) DeleteOne(co *Consent) *ConsentDeleteOne {
+	return c.DeleteOneID(co.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ConsentClient) DeleteOneID(id uuid.UUID) *ConsentDeleteOne {
+	builder := c.Delete().Where(consent.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ConsentDeleteOne{builder}
+}
+
+// Query returns a query builder for Consent.
+func (c *ConsentClient) Query() *ConsentQuery {
+	return &ConsentQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeConsent},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Consent entity by its id.
+func (c *ConsentClient) Get(ctx context.Context, id uuid.UUID) (*Consent, error) {
+	return c.Query().Where(consent.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ConsentClient) GetX(ctx context.Context, id uuid.UUID) *Consent {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryClient queries the client edge of a Consent.
+func (c *ConsentClient) QueryClient(co *Consent) *Oauth2ClientQuery {
+	query := (&Oauth2ClientClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := co.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(consent.Table, consent.FieldID, id),
+			sqlgraph.To(oauth2client.Table, oauth2client.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, consent.ClientTable, consent.ClientColumn),
+		)
+		fromV = sqlgraph.Neighbors(co.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ConsentClient) Hooks() []Hook {
+	return c.hooks.Consent
+}
+
+// Interceptors returns the client interceptors.
+func (c *ConsentClient) Interceptors() []Interceptor {
+	return c.inters.Consent
+}
+
+func (c *ConsentClient) mutate(ctx context.Context, m *ConsentMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ConsentCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ConsentUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ConsentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ConsentDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Consent mutation op: %q", m.Op())
+	}
+}
+
 // Oauth2ClientClient is a client for the Oauth2Client schema.
 type Oauth2ClientClient struct {
 	config
@@ -305,6 +644,38 @@ func (c *Oauth2ClientClient) GetX(ctx context.Context, id uuid.UUID) *Oauth2Clie
 	return obj
 }
 
+// QueryConsents queries the consents edge of a Oauth2Client.
+func (c *Oauth2ClientClient) QueryConsents(o *Oauth2Client) *ConsentQuery {
+	query := (&ConsentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := o.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(oauth2client.Table, oauth2client.FieldID, id),
+			sqlgraph.To(consent.Table, consent.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, oauth2client.ConsentsTable, oauth2client.ConsentsColumn),
+		)
+		fromV = sqlgraph.Neighbors(o.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySecrets queries the secrets edge of a Oauth2Client.
+func (c *Oauth2ClientClient) QuerySecrets(o *Oauth2Client) *ClientSecretQuery {
+	query := (&ClientSecretClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := o.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(oauth2client.Table, oauth2client.FieldID, id),
+			sqlgraph.To(clientsecret.Table, clientsecret.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, oauth2client.SecretsTable, oauth2client.SecretsColumn),
+		)
+		fromV = sqlgraph.Neighbors(o.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *Oauth2ClientClient) Hooks() []Hook {
 	return c.hooks.Oauth2Client
@@ -330,12 +701,310 @@ func (c *Oauth2ClientClient) mutate(ctx context.Context, m *Oauth2ClientMutation
 	}
 }
 
+// ScopeClient is a client for the Scope schema.
+type ScopeClient struct {
+	config
+}
+
+// NewScopeClient returns a client for the Scope from the given config.
+func NewScopeClient(c config) *ScopeClient {
+	return &ScopeClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `scope.Hooks(f(g(h())))`.
+func (c *ScopeClient) Use(hooks ...Hook) {
+	c.hooks.Scope = append(c.hooks.Scope, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `scope.Intercept(f(g(h())))`.
+func (c *ScopeClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Scope = append(c.inters.Scope, interceptors...)
+}
+
+// Create returns a builder for creating a Scope entity.
+func (c *ScopeClient) Create() *ScopeCreate {
+	mutation := newScopeMutation(c.config, OpCreate)
+	return &ScopeCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Scope entities.
+func (c *ScopeClient) CreateBulk(builders ...*ScopeCreate) *ScopeCreateBulk {
+	return &ScopeCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ScopeClient) MapCreateBulk(slice any, setFunc func(*ScopeCreate, int)) *ScopeCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ScopeCreateBulk{err: fmt.Errorf("calling to ScopeClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ScopeCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ScopeCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Scope.
+func (c *ScopeClient) Update() *ScopeUpdate {
+	mutation := newScopeMutation(c.config, OpUpdate)
+	return &ScopeUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ScopeClient) UpdateOne(s *Scope) *ScopeUpdateOne {
+	mutation := newScopeMutation(c.config, OpUpdateOne, withScope(s))
+	return &ScopeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ScopeClient) UpdateOneID(id uuid.UUID) *ScopeUpdateOne {
+	mutation := newScopeMutation(c.config, OpUpdateOne, withScopeID(id))
+	return &ScopeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Scope.
+func (c *ScopeClient) Delete() *ScopeDelete {
+	mutation := newScopeMutation(c.config, OpDelete)
+	return &ScopeDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ScopeClient) DeleteOne(s *Scope) *ScopeDeleteOne {
+	return c.DeleteOneID(s.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ScopeClient) DeleteOneID(id uuid.UUID) *ScopeDeleteOne {
+	builder := c.Delete().Where(scope.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ScopeDeleteOne{builder}
+}
+
+// Query returns a query builder for Scope.
+func (c *ScopeClient) Query() *ScopeQuery {
+	return &ScopeQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeScope},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Scope entity by its id.
+func (c *ScopeClient) Get(ctx context.Context, id uuid.UUID) (*Scope, error) {
+	return c.Query().Where(scope.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ScopeClient) GetX(ctx context.Context, id uuid.UUID) *Scope {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryPermissions queries the permissions edge of a Scope.
+func (c *ScopeClient) QueryPermissions(s *Scope) *ScopePermissionQuery {
+	query := (&ScopePermissionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := s.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(scope.Table, scope.FieldID, id),
+			sqlgraph.To(scopepermission.Table, scopepermission.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, scope.PermissionsTable, scope.PermissionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(s.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ScopeClient) Hooks() []Hook {
+	return c.hooks.Scope
+}
+
+// Interceptors returns the client interceptors.
+func (c *ScopeClient) Interceptors() []Interceptor {
+	return c.inters.Scope
+}
+
+func (c *ScopeClient) mutate(ctx context.Context, m *ScopeMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ScopeCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ScopeUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ScopeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ScopeDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Scope mutation op: %q", m.Op())
+	}
+}
+
+// ScopePermissionClient is a client for the ScopePermission schema.
+type ScopePermissionClient struct {
+	config
+}
+
+// NewScopePermissionClient returns a client for the ScopePermission from the given config.
+func NewScopePermissionClient(c config) *ScopePermissionClient {
+	return &ScopePermissionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `scopepermission.Hooks(f(g(h())))`.
+func (c *ScopePermissionClient) Use(hooks ...Hook) {
+	c.hooks.ScopePermission = append(c.hooks.ScopePermission, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `scopepermission.Intercept(f(g(h())))`.
+func (c *ScopePermissionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ScopePermission = append(c.inters.ScopePermission, interceptors...)
+}
+
+// Create returns a builder for creating a ScopePermission entity.
+func (c *ScopePermissionClient) Create() *ScopePermissionCreate {
+	mutation := newScopePermissionMutation(c.config, OpCreate)
+	return &ScopePermissionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ScopePermission entities.
+func (c *ScopePermissionClient) CreateBulk(builders ...*ScopePermissionCreate) *ScopePermissionCreateBulk {
+	return &ScopePermissionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ScopePermissionClient) MapCreateBulk(slice any, setFunc func(*ScopePermissionCreate, int)) *ScopePermissionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ScopePermissionCreateBulk{err: fmt.Errorf("calling to ScopePermissionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ScopePermissionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ScopePermissionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ScopePermission.
+func (c *ScopePermissionClient) Update() *ScopePermissionUpdate {
+	mutation := newScopePermissionMutation(c.config, OpUpdate)
+	return &ScopePermissionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ScopePermissionClient) UpdateOne(sp *ScopePermission) *ScopePermissionUpdateOne {
+	mutation := newScopePermissionMutation(c.config, OpUpdateOne, withScopePermission(sp))
+	return &ScopePermissionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ScopePermissionClient) UpdateOneID(id uuid.UUID) *ScopePermissionUpdateOne {
+	mutation := newScopePermissionMutation(c.config, OpUpdateOne, withScopePermissionID(id))
+	return &ScopePermissionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ScopePermission.
+func (c *ScopePermissionClient) Delete() *ScopePermissionDelete {
+	mutation := newScopePermissionMutation(c.config, OpDelete)
+	return &ScopePermissionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ScopePermissionClient) DeleteOne(sp *ScopePermission) *ScopePermissionDeleteOne {
+	return c.DeleteOneID(sp.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ScopePermissionClient) DeleteOneID(id uuid.UUID) *ScopePermissionDeleteOne {
+	builder := c.Delete().Where(scopepermission.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ScopePermissionDeleteOne{builder}
+}
+
+// Query returns a query builder for ScopePermission.
+func (c *ScopePermissionClient) Query() *ScopePermissionQuery {
+	return &ScopePermissionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeScopePermission},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ScopePermission entity by its id.
+func (c *ScopePermissionClient) Get(ctx context.Context, id uuid.UUID) (*ScopePermission, error) {
+	return c.Query().Where(scopepermission.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ScopePermissionClient) GetX(ctx context.Context, id uuid.UUID) *ScopePermission {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryScope queries the scope edge of a ScopePermission.
+func (c *ScopePermissionClient) QueryScope(sp *ScopePermission) *ScopeQuery {
+	query := (&ScopeClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := sp.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(scopepermission.Table, scopepermission.FieldID, id),
+			sqlgraph.To(scope.Table, scope.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, scopepermission.ScopeTable, scopepermission.ScopeColumn),
+		)
+		fromV = sqlgraph.Neighbors(sp.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ScopePermissionClient) Hooks() []Hook {
+	return c.hooks.ScopePermission
+}
+
+// Interceptors returns the client interceptors.
+func (c *ScopePermissionClient) Interceptors() []Interceptor {
+	return c.inters.ScopePermission
+}
+
+func (c *ScopePermissionClient) mutate(ctx context.Context, m *ScopePermissionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ScopePermissionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ScopePermissionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ScopePermissionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ScopePermissionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ScopePermission mutation op: %q", m.Op())
+	}
+}
+
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		Oauth2Client []ent.Hook
+		ClientSecret, Consent, Oauth2Client, Scope, ScopePermission []ent.Hook
 	}
 	inters struct {
-		Oauth2Client []ent.Interceptor
+		ClientSecret, Consent, Oauth2Client, Scope, ScopePermission []ent.Interceptor
 	}
 )
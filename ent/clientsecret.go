@@ -0,0 +1,182 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/byebyebymyai/oauth2-api/ent/clientsecret"
+	"github.com/byebyebymyai/oauth2-api/ent/oauth2client"
+	"github.com/google/uuid"
+)
+
+// ClientSecret is the model entity for the ClientSecret schema.
+type ClientSecret struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Hash holds the value of the "hash" field.
+	Hash string `json:"-"`
+	// Ciphertext holds the value of the "ciphertext" field.
+	Ciphertext []byte `json:"-"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// ExpiresAt holds the value of the "expires_at" field.
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ClientSecretQuery when eager-loading is set.
+	Edges                ClientSecretEdges `json:"edges"`
+	oauth2client_secrets *uuid.UUID
+	selectValues         sql.SelectValues
+}
+
+// ClientSecretEdges holds the relations/edges for other nodes in the graph.
+type ClientSecretEdges struct {
+	// Client holds the value of the client edge.
+	Client *Oauth2Client `json:"client,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// ClientOrErr returns the Client value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ClientSecretEdges) ClientOrErr() (*Oauth2Client, error) {
+	if e.Client != nil {
+		return e.Client, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: oauth2client.Label}
+	}
+	return nil, &NotLoadedError{edge: "client"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ClientSecret) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case clientsecret.FieldCiphertext:
+			values[i] = new([]byte)
+		case clientsecret.FieldHash:
+			values[i] = new(sql.NullString)
+		case clientsecret.FieldCreatedAt, clientsecret.FieldExpiresAt:
+			values[i] = new(sql.NullTime)
+		case clientsecret.FieldID:
+			values[i] = new(uuid.UUID)
+		case clientsecret.ForeignKeys[0]: // oauth2client_secrets
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ClientSecret fields.
+func (cs *ClientSecret) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case clientsecret.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				cs.ID = *value
+			}
+		case clientsecret.FieldHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field hash", values[i])
+			} else if value.Valid {
+				cs.Hash = value.String
+			}
+		case clientsecret.FieldCiphertext:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field ciphertext", values[i])
+			} else if value != nil {
+				cs.Ciphertext = *value
+			}
+		case clientsecret.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				cs.CreatedAt = value.Time
+			}
+		case clientsecret.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				cs.ExpiresAt = new(time.Time)
+				*cs.ExpiresAt = value.Time
+			}
+		case clientsecret.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field oauth2client_secrets", values[i])
+			} else if value.Valid {
+				cs.oauth2client_secrets = new(uuid.UUID)
+				*cs.oauth2client_secrets = *value.S.(*uuid.UUID)
+			}
+		default:
+			cs.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ClientSecret.
+// This includes values selected through modifiers, order, etc.
+func (cs *ClientSecret) Value(name string) (ent.Value, error) {
+	return cs.selectValues.Get(name)
+}
+
+// QueryClient queries the "client" edge of the ClientSecret entity.
+func (cs *ClientSecret) QueryClient() *Oauth2ClientQuery {
+	return NewClientSecretClient(cs.config).QueryClient(cs)
+}
+
+// Update returns a builder for updating this ClientSecret.
+// Note that you need to call ClientSecret.Unwrap() before calling this method if this ClientSecret
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (cs *ClientSecret) Update() *ClientSecretUpdateOne {
+	return NewClientSecretClient(cs.config).UpdateOne(cs)
+}
+
+// Unwrap unwraps the ClientSecret entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (cs *ClientSecret) Unwrap() *ClientSecret {
+	_tx, ok := cs.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ClientSecret is not a transactional entity")
+	}
+	cs.config.driver = _tx.drv
+	return cs
+}
+
+// String implements the fmt.Stringer.
+func (cs *ClientSecret) String() string {
+	var builder strings.Builder
+	builder.WriteString("ClientSecret(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", cs.ID))
+	builder.WriteString("hash=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("ciphertext=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(cs.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := cs.ExpiresAt; v != nil {
+		builder.WriteString("expires_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ClientSecrets is a parsable slice of ClientSecret.
+type ClientSecrets []*ClientSecret
@@ -0,0 +1,304 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/byebyebymyai/oauth2-api/ent/clientsecret"
+	"github.com/byebyebymyai/oauth2-api/ent/oauth2client"
+	"github.com/google/uuid"
+)
+
+// ClientSecretCreate is the builder for creating a ClientSecret entity.
+type ClientSecretCreate struct {
+	config
+	mutation *ClientSecretMutation
+	hooks    []Hook
+}
+
+// SetHash sets the "hash" field.
+func (csc *ClientSecretCreate) SetHash(s string) *ClientSecretCreate {
+	csc.mutation.SetHash(s)
+	return csc
+}
+
+// SetCiphertext sets the "ciphertext" field.
+func (csc *ClientSecretCreate) SetCiphertext(b []byte) *ClientSecretCreate {
+	csc.mutation.SetCiphertext(b)
+	return csc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (csc *ClientSecretCreate) SetCreatedAt(t time.Time) *ClientSecretCreate {
+	csc.mutation.SetCreatedAt(t)
+	return csc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (csc *ClientSecretCreate) SetNillableCreatedAt(t *time.Time) *ClientSecretCreate {
+	if t != nil {
+		csc.SetCreatedAt(*t)
+	}
+	return csc
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (csc *ClientSecretCreate) SetExpiresAt(t time.Time) *ClientSecretCreate {
+	csc.mutation.SetExpiresAt(t)
+	return csc
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (csc *ClientSecretCreate) SetNillableExpiresAt(t *time.Time) *ClientSecretCreate {
+	if t != nil {
+		csc.SetExpiresAt(*t)
+	}
+	return csc
+}
+
+// SetID sets the "id" field.
+func (csc *ClientSecretCreate) SetID(u uuid.UUID) *ClientSecretCreate {
+	csc.mutation.SetID(u)
+	return csc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (csc *ClientSecretCreate) SetNillableID(u *uuid.UUID) *ClientSecretCreate {
+	if u != nil {
+		csc.SetID(*u)
+	}
+	return csc
+}
+
+// SetClientID sets the "client" edge to the Oauth2Client entity by ID.
+func (csc *ClientSecretCreate) SetClientID(id uuid.UUID) *ClientSecretCreate {
+	csc.mutation.SetClientID(id)
+	return csc
+}
+
+// SetClient sets the "client" edge to the Oauth2Client entity.
+func (csc *ClientSecretCreate) SetClient(o *Oauth2Client) *ClientSecretCreate {
+	return csc.SetClientID(o.ID)
+}
+
+// Mutation returns the ClientSecretMutation object of the builder.
+func (csc *ClientSecretCreate) Mutation() *ClientSecretMutation {
+	return csc.mutation
+}
+
+// Save creates the ClientSecret in the database.
+func (csc *ClientSecretCreate) Save(ctx context.Context) (*ClientSecret, error) {
+	csc.defaults()
+	return withHooks(ctx, csc.sqlSave, csc.mutation, csc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (csc *ClientSecretCreate) SaveX(ctx context.Context) *ClientSecret {
+	v, err := csc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (csc *ClientSecretCreate) Exec(ctx context.Context) error {
+	_, err := csc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (csc *ClientSecretCreate) ExecX(ctx context.Context) {
+	if err := csc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (csc *ClientSecretCreate) defaults() {
+	if _, ok := csc.mutation.CreatedAt(); !ok {
+		v := clientsecret.DefaultCreatedAt()
+		csc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := csc.mutation.ID(); !ok {
+		v := clientsecret.DefaultID()
+		csc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (csc *ClientSecretCreate) check() error {
+	if _, ok := csc.mutation.Hash(); !ok {
+		return &ValidationError{Name: "hash", err: errors.New(`ent: missing required field "ClientSecret.hash"`)}
+	}
+	if v, ok := csc.mutation.Hash(); ok {
+		if err := clientsecret.HashValidator(v); err != nil {
+			return &ValidationError{Name: "hash", err: fmt.Errorf(`ent: validator failed for field "ClientSecret.hash": %w`, err)}
+		}
+	}
+	if _, ok := csc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ClientSecret.created_at"`)}
+	}
+	if len(csc.mutation.ClientIDs()) == 0 {
+		return &ValidationError{Name: "client", err: errors.New(`ent: missing required edge "ClientSecret.client"`)}
+	}
+	return nil
+}
+
+func (csc *ClientSecretCreate) sqlSave(ctx context.Context) (*ClientSecret, error) {
+	if err := csc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := csc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, csc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	csc.mutation.id = &_node.ID
+	csc.mutation.done = true
+	return _node, nil
+}
+
+func (csc *ClientSecretCreate) createSpec() (*ClientSecret, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ClientSecret{config: csc.config}
+		_spec = sqlgraph.NewCreateSpec(clientsecret.Table, sqlgraph.NewFieldSpec(clientsecret.FieldID, field.TypeUUID))
+	)
+	if id, ok := csc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := csc.mutation.Hash(); ok {
+		_spec.SetField(clientsecret.FieldHash, field.TypeString, value)
+		_node.Hash = value
+	}
+	if value, ok := csc.mutation.Ciphertext(); ok {
+		_spec.SetField(clientsecret.FieldCiphertext, field.TypeBytes, value)
+		_node.Ciphertext = value
+	}
+	if value, ok := csc.mutation.CreatedAt(); ok {
+		_spec.SetField(clientsecret.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := csc.mutation.ExpiresAt(); ok {
+		_spec.SetField(clientsecret.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = &value
+	}
+	if nodes := csc.mutation.ClientIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   clientsecret.ClientTable,
+			Columns: []string{clientsecret.ClientColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(oauth2client.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.oauth2client_secrets = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ClientSecretCreateBulk is the builder for creating many ClientSecret entities in bulk.
+type ClientSecretCreateBulk struct {
+	config
+	err      error
+	builders []*ClientSecretCreate
+}
+
+// Save creates the ClientSecret entities in the database.
+func (cscb *ClientSecretCreateBulk) Save(ctx context.Context) ([]*ClientSecret, error) {
+	if cscb.err != nil {
+		return nil, cscb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(cscb.builders))
+	nodes := make([]*ClientSecret, len(cscb.builders))
+	mutators := make([]Mutator, len(cscb.builders))
+	for i := range cscb.builders {
+		func(i int, root context.Context) {
+			builder := cscb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ClientSecretMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, cscb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, cscb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, cscb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cscb *ClientSecretCreateBulk) SaveX(ctx context.Context) []*ClientSecret {
+	v, err := cscb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (cscb *ClientSecretCreateBulk) Exec(ctx context.Context) error {
+	_, err := cscb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cscb *ClientSecretCreateBulk) ExecX(ctx context.Context) {
+	if err := cscb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
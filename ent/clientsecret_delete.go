@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/byebyebymyai/oauth2-api/ent/clientsecret"
+	"github.com/byebyebymyai/oauth2-api/ent/predicate"
+)
+
+// ClientSecretDelete is the builder for deleting a ClientSecret entity.
+type ClientSecretDelete struct {
+	config
+	hooks    []Hook
+	mutation *ClientSecretMutation
+}
+
+// Where appends a list predicates to the ClientSecretDelete builder.
+func (csd *ClientSecretDelete) Where(ps ...predicate.ClientSecret) *ClientSecretDelete {
+	csd.mutation.Where(ps...)
+	return csd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (csd *ClientSecretDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, csd.sqlExec, csd.mutation, csd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (csd *ClientSecretDelete) ExecX(ctx context.Context) int {
+	n, err := csd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (csd *ClientSecretDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(clientsecret.Table, sqlgraph.NewFieldSpec(clientsecret.FieldID, field.TypeUUID))
+	if ps := csd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, csd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	csd.mutation.done = true
+	return affected, err
+}
+
+// ClientSecretDeleteOne is the builder for deleting a single ClientSecret entity.
+type ClientSecretDeleteOne struct {
+	csd *ClientSecretDelete
+}
+
+// Where appends a list predicates to the ClientSecretDelete builder.
+func (csdo *ClientSecretDeleteOne) Where(ps ...predicate.ClientSecret) *ClientSecretDeleteOne {
+	csdo.csd.mutation.Where(ps...)
+	return csdo
+}
+
+// Exec executes the deletion query.
+func (csdo *ClientSecretDeleteOne) Exec(ctx context.Context) error {
+	n, err := csdo.csd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{clientsecret.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (csdo *ClientSecretDeleteOne) ExecX(ctx context.Context) {
+	if err := csdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,615 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/byebyebymyai/oauth2-api/ent/clientsecret"
+	"github.com/byebyebymyai/oauth2-api/ent/oauth2client"
+	"github.com/byebyebymyai/oauth2-api/ent/predicate"
+	"github.com/google/uuid"
+)
+
+// ClientSecretQuery is the builder for querying ClientSecret entities.
+type ClientSecretQuery struct {
+	config
+	ctx        *QueryContext
+	order      []clientsecret.OrderOption
+	inters     []Interceptor
+	predicates []predicate.ClientSecret
+	withClient *Oauth2ClientQuery
+	withFKs    bool
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ClientSecretQuery builder.
+func (csq *ClientSecretQuery) Where(ps ...predicate.ClientSecret) *ClientSecretQuery {
+	csq.predicates = append(csq.predicates, ps...)
+	return csq
+}
+
+// Limit the number of records to be returned by this query.
+func (csq *ClientSecretQuery) Limit(limit int) *ClientSecretQuery {
+	csq.ctx.Limit = &limit
+	return csq
+}
+
+// Offset to start from.
+func (csq *ClientSecretQuery) Offset(offset int) *ClientSecretQuery {
+	csq.ctx.Offset = &offset
+	return csq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (csq *ClientSecretQuery) Unique(unique bool) *ClientSecretQuery {
+	csq.ctx.Unique = &unique
+	return csq
+}
+
+// Order specifies how the records should be ordered.
+func (csq *ClientSecretQuery) Order(o ...clientsecret.OrderOption) *ClientSecretQuery {
+	csq.order = append(csq.order, o...)
+	return csq
+}
+
+// QueryClient chains the current query on the "client" edge.
+func (csq *ClientSecretQuery) QueryClient() *Oauth2ClientQuery {
+	query := (&Oauth2ClientClient{config: csq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := csq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := csq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(clientsecret.Table, clientsecret.FieldID, selector),
+			sqlgraph.To(oauth2client.Table, oauth2client.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, clientsecret.ClientTable, clientsecret.ClientColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(csq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first ClientSecret entity from the query.
+// Returns a *NotFoundError when no ClientSecret was found.
+func (csq *ClientSecretQuery) First(ctx context.Context) (*ClientSecret, error) {
+	nodes, err := csq.Limit(1).All(setContextOp(ctx, csq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{clientsecret.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (csq *ClientSecretQuery) FirstX(ctx context.Context) *ClientSecret {
+	node, err := csq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first ClientSecret ID from the query.
+// Returns a *NotFoundError when no ClientSecret ID was found.
+func (csq *ClientSecretQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = csq.Limit(1).IDs(setContextOp(ctx, csq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{clientsecret.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (csq *ClientSecretQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := csq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single ClientSecret entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one ClientSecret entity is found.
+// Returns a *NotFoundError when no ClientSecret entities are found.
+func (csq *ClientSecretQuery) Only(ctx context.Context) (*ClientSecret, error) {
+	nodes, err := csq.Limit(2).All(setContextOp(ctx, csq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{clientsecret.Label}
+	default:
+		return nil, &NotSingularError{clientsecret.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (csq *ClientSecretQuery) OnlyX(ctx context.Context) *ClientSecret {
+	node, err := csq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only ClientSecret ID in the query.
+// Returns a *NotSingularError when more than one ClientSecret ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (csq *ClientSecretQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = csq.Limit(2).IDs(setContextOp(ctx, csq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{clientsecret.Label}
+	default:
+		err = &NotSingularError{clientsecret.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (csq *ClientSecretQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := csq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of ClientSecrets.
+func (csq *ClientSecretQuery) All(ctx context.Context) ([]*ClientSecret, error) {
+	ctx = setContextOp(ctx, csq.ctx, ent.OpQueryAll)
+	if err := csq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*ClientSecret, *ClientSecretQuery]()
+	return withInterceptors[[]*ClientSecret](ctx, csq, qr, csq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (csq *ClientSecretQuery) AllX(ctx context.Context) []*ClientSecret {
+	nodes, err := csq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of ClientSecret IDs.
+func (csq *ClientSecretQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if csq.ctx.Unique == nil && csq.path != nil {
+		csq.Unique(true)
+	}
+	ctx = setContextOp(ctx, csq.ctx, ent.OpQueryIDs)
+	if err = csq.Select(clientsecret.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (csq *ClientSecretQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := csq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (csq *ClientSecretQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, csq.ctx, ent.OpQueryCount)
+	if err := csq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, csq, querierCount[*ClientSecretQuery](), csq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (csq *ClientSecretQuery) CountX(ctx context.Context) int {
+	count, err := csq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (csq *ClientSecretQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, csq.ctx, ent.OpQueryExist)
+	switch _, err := csq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (csq *ClientSecretQuery) ExistX(ctx context.Context) bool {
+	exist, err := csq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ClientSecretQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (csq *ClientSecretQuery) Clone() *ClientSecretQuery {
+	if csq == nil {
+		return nil
+	}
+	return &ClientSecretQuery{
+		config:     csq.config,
+		ctx:        csq.ctx.Clone(),
+		order:      append([]clientsecret.OrderOption{}, csq.order...),
+		inters:     append([]Interceptor{}, csq.inters...),
+		predicates: append([]predicate.ClientSecret{}, csq.predicates...),
+		withClient: csq.withClient.Clone(),
+		// clone intermediate query.
+		sql:  csq.sql.Clone(),
+		path: csq.path,
+	}
+}
+
+// WithClient tells the query-builder to eager-load the nodes that are connected to
+// the "client" edge. The optional arguments are used to configure the query builder of the edge.
+func (csq *ClientSecretQuery) WithClient(opts ...func(*Oauth2ClientQuery)) *ClientSecretQuery {
+	query := (&Oauth2ClientClient{config: csq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	csq.withClient = query
+	return csq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Hash string `json:"hash,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.ClientSecret.Query().
+//		GroupBy(clientsecret.FieldHash).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (csq *ClientSecretQuery) GroupBy(field string, fields ...string) *ClientSecretGroupBy {
+	csq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &ClientSecretGroupBy{build: csq}
+	grbuild.flds = &csq.ctx.Fields
+	grbuild.label = clientsecret.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Hash string `json:"hash,omitempty"`
+//	}
+//
+//	client.ClientSecret.Query().
+//		Select(clientsecret.FieldHash).
+//		Scan(ctx, &v)
+func (csq *ClientSecretQuery) Select(fields ...string) *ClientSecretSelect {
+	csq.ctx.Fields = append(csq.ctx.Fields, fields...)
+	sbuild := &ClientSecretSelect{ClientSecretQuery: csq}
+	sbuild.label = clientsecret.Label
+	sbuild.flds, sbuild.scan = &csq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a ClientSecretSelect configured with the given aggregations.
+func (csq *ClientSecretQuery) Aggregate(fns ...AggregateFunc) *ClientSecretSelect {
+	return csq.Select().Aggregate(fns...)
+}
+
+func (csq *ClientSecretQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range csq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, csq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range csq.ctx.Fields {
+		if !clientsecret.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if csq.path != nil {
+		prev, err := csq.path(ctx)
+		if err != nil {
+			return err
+		}
+		csq.sql = prev
+	}
+	return nil
+}
+
+func (csq *ClientSecretQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*ClientSecret, error) {
+	var (
+		nodes       = []*ClientSecret{}
+		withFKs     = csq.withFKs
+		_spec       = csq.querySpec()
+		loadedTypes = [1]bool{
+			csq.withClient != nil,
+		}
+	)
+	if csq.withClient != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, clientsecret.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*ClientSecret).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &ClientSecret{config: csq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, csq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := csq.withClient; query != nil {
+		if err := csq.loadClient(ctx, query, nodes, nil,
+			func(n *ClientSecret, e *Oauth2Client) { n.Edges.Client = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (csq *ClientSecretQuery) loadClient(ctx context.Context, query *Oauth2ClientQuery, nodes []*ClientSecret, init func(*ClientSecret), assign func(*ClientSecret, *Oauth2Client)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*ClientSecret)
+	for i := range nodes {
+		if nodes[i].oauth2client_secrets == nil {
+			continue
+		}
+		fk := *nodes[i].oauth2client_secrets
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(oauth2client.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "oauth2client_secrets" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (csq *ClientSecretQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := csq.querySpec()
+	_spec.Node.Columns = csq.ctx.Fields
+	if len(csq.ctx.Fields) > 0 {
+		_spec.Unique = csq.ctx.Unique != nil && *csq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, csq.driver, _spec)
+}
+
+func (csq *ClientSecretQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(clientsecret.Table, clientsecret.Columns, sqlgraph.NewFieldSpec(clientsecret.FieldID, field.TypeUUID))
+	_spec.From = csq.sql
+	if unique := csq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if csq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := csq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, clientsecret.FieldID)
+		for i := range fields {
+			if fields[i] != clientsecret.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := csq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := csq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := csq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := csq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (csq *ClientSecretQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(csq.driver.Dialect())
+	t1 := builder.Table(clientsecret.Table)
+	columns := csq.ctx.Fields
+	if len(columns) == 0 {
+		columns = clientsecret.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if csq.sql != nil {
+		selector = csq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if csq.ctx.Unique != nil && *csq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range csq.predicates {
+		p(selector)
+	}
+	for _, p := range csq.order {
+		p(selector)
+	}
+	if offset := csq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := csq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ClientSecretGroupBy is the group-by builder for ClientSecret entities.
+type ClientSecretGroupBy struct {
+	selector
+	build *ClientSecretQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (csgb *ClientSecretGroupBy) Aggregate(fns ...AggregateFunc) *ClientSecretGroupBy {
+	csgb.fns = append(csgb.fns, fns...)
+	return csgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (csgb *ClientSecretGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, csgb.build.ctx, ent.OpQueryGroupBy)
+	if err := csgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ClientSecretQuery, *ClientSecretGroupBy](ctx, csgb.build, csgb, csgb.build.inters, v)
+}
+
+func (csgb *ClientSecretGroupBy) sqlScan(ctx context.Context, root *ClientSecretQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(csgb.fns))
+	for _, fn := range csgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*csgb.flds)+len(csgb.fns))
+		for _, f := range *csgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*csgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := csgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// ClientSecretSelect is the builder for selecting fields of ClientSecret entities.
+type ClientSecretSelect struct {
+	*ClientSecretQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (css *ClientSecretSelect) Aggregate(fns ...AggregateFunc) *ClientSecretSelect {
+	css.fns = append(css.fns, fns...)
+	return css
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (css *ClientSecretSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, css.ctx, ent.OpQuerySelect)
+	if err := css.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ClientSecretQuery, *ClientSecretSelect](ctx, css.ClientSecretQuery, css, css.inters, v)
+}
+
+func (css *ClientSecretSelect) sqlScan(ctx context.Context, root *ClientSecretQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(css.fns))
+	for _, fn := range css.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*css.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := css.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
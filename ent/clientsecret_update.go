@@ -0,0 +1,424 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/byebyebymyai/oauth2-api/ent/clientsecret"
+	"github.com/byebyebymyai/oauth2-api/ent/oauth2client"
+	"github.com/byebyebymyai/oauth2-api/ent/predicate"
+	"github.com/google/uuid"
+)
+
+// ClientSecretUpdate is the builder for updating ClientSecret entities.
+type ClientSecretUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ClientSecretMutation
+}
+
+// Where appends a list predicates to the ClientSecretUpdate builder.
+func (csu *ClientSecretUpdate) Where(ps ...predicate.ClientSecret) *ClientSecretUpdate {
+	csu.mutation.Where(ps...)
+	return csu
+}
+
+// SetHash sets the "hash" field.
+func (csu *ClientSecretUpdate) SetHash(s string) *ClientSecretUpdate {
+	csu.mutation.SetHash(s)
+	return csu
+}
+
+// SetNillableHash sets the "hash" field if the given value is not nil.
+func (csu *ClientSecretUpdate) SetNillableHash(s *string) *ClientSecretUpdate {
+	if s != nil {
+		csu.SetHash(*s)
+	}
+	return csu
+}
+
+// SetCiphertext sets the "ciphertext" field.
+func (csu *ClientSecretUpdate) SetCiphertext(b []byte) *ClientSecretUpdate {
+	csu.mutation.SetCiphertext(b)
+	return csu
+}
+
+// ClearCiphertext clears the value of the "ciphertext" field.
+func (csu *ClientSecretUpdate) ClearCiphertext() *ClientSecretUpdate {
+	csu.mutation.ClearCiphertext()
+	return csu
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (csu *ClientSecretUpdate) SetExpiresAt(t time.Time) *ClientSecretUpdate {
+	csu.mutation.SetExpiresAt(t)
+	return csu
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (csu *ClientSecretUpdate) SetNillableExpiresAt(t *time.Time) *ClientSecretUpdate {
+	if t != nil {
+		csu.SetExpiresAt(*t)
+	}
+	return csu
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (csu *ClientSecretUpdate) ClearExpiresAt() *ClientSecretUpdate {
+	csu.mutation.ClearExpiresAt()
+	return csu
+}
+
+// SetClientID sets the "client" edge to the Oauth2Client entity by ID.
+func (csu *ClientSecretUpdate) SetClientID(id uuid.UUID) *ClientSecretUpdate {
+	csu.mutation.SetClientID(id)
+	return csu
+}
+
+// SetClient sets the "client" edge to the Oauth2Client entity.
+func (csu *ClientSecretUpdate) SetClient(o *Oauth2Client) *ClientSecretUpdate {
+	return csu.SetClientID(o.ID)
+}
+
+// Mutation returns the ClientSecretMutation object of the builder.
+func (csu *ClientSecretUpdate) Mutation() *ClientSecretMutation {
+	return csu.mutation
+}
+
+// ClearClient clears the "client" edge to the Oauth2Client entity.
+func (csu *ClientSecretUpdate) ClearClient() *ClientSecretUpdate {
+	csu.mutation.ClearClient()
+	return csu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (csu *ClientSecretUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, csu.sqlSave, csu.mutation, csu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (csu *ClientSecretUpdate) SaveX(ctx context.Context) int {
+	affected, err := csu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (csu *ClientSecretUpdate) Exec(ctx context.Context) error {
+	_, err := csu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (csu *ClientSecretUpdate) ExecX(ctx context.Context) {
+	if err := csu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (csu *ClientSecretUpdate) check() error {
+	if v, ok := csu.mutation.Hash(); ok {
+		if err := clientsecret.HashValidator(v); err != nil {
+			return &ValidationError{Name: "hash", err: fmt.Errorf(`ent: validator failed for field "ClientSecret.hash": %w`, err)}
+		}
+	}
+	if csu.mutation.ClientCleared() && len(csu.mutation.ClientIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ClientSecret.client"`)
+	}
+	return nil
+}
+
+func (csu *ClientSecretUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := csu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(clientsecret.Table, clientsecret.Columns, sqlgraph.NewFieldSpec(clientsecret.FieldID, field.TypeUUID))
+	if ps := csu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := csu.mutation.Hash(); ok {
+		_spec.SetField(clientsecret.FieldHash, field.TypeString, value)
+	}
+	if value, ok := csu.mutation.Ciphertext(); ok {
+		_spec.SetField(clientsecret.FieldCiphertext, field.TypeBytes, value)
+	}
+	if csu.mutation.CiphertextCleared() {
+		_spec.ClearField(clientsecret.FieldCiphertext, field.TypeBytes)
+	}
+	if value, ok := csu.mutation.ExpiresAt(); ok {
+		_spec.SetField(clientsecret.FieldExpiresAt, field.TypeTime, value)
+	}
+	if csu.mutation.ExpiresAtCleared() {
+		_spec.ClearField(clientsecret.FieldExpiresAt, field.TypeTime)
+	}
+	if csu.mutation.ClientCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   clientsecret.ClientTable,
+			Columns: []string{clientsecret.ClientColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(oauth2client.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := csu.mutation.ClientIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   clientsecret.ClientTable,
+			Columns: []string{clientsecret.ClientColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(oauth2client.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, csu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{clientsecret.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	csu.mutation.done = true
+	return n, nil
+}
+
+// ClientSecretUpdateOne is the builder for updating a single ClientSecret entity.
+type ClientSecretUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ClientSecretMutation
+}
+
+// SetHash sets the "hash" field.
+func (csuo *ClientSecretUpdateOne) SetHash(s string) *ClientSecretUpdateOne {
+	csuo.mutation.SetHash(s)
+	return csuo
+}
+
+// SetNillableHash sets the "hash" field if the given value is not nil.
+func (csuo *ClientSecretUpdateOne) SetNillableHash(s *string) *ClientSecretUpdateOne {
+	if s != nil {
+		csuo.SetHash(*s)
+	}
+	return csuo
+}
+
+// SetCiphertext sets the "ciphertext" field.
+func (csuo *ClientSecretUpdateOne) SetCiphertext(b []byte) *ClientSecretUpdateOne {
+	csuo.mutation.SetCiphertext(b)
+	return csuo
+}
+
+// ClearCiphertext clears the value of the "ciphertext" field.
+func (csuo *ClientSecretUpdateOne) ClearCiphertext() *ClientSecretUpdateOne {
+	csuo.mutation.ClearCiphertext()
+	return csuo
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (csuo *ClientSecretUpdateOne) SetExpiresAt(t time.Time) *ClientSecretUpdateOne {
+	csuo.mutation.SetExpiresAt(t)
+	return csuo
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (csuo *ClientSecretUpdateOne) SetNillableExpiresAt(t *time.Time) *ClientSecretUpdateOne {
+	if t != nil {
+		csuo.SetExpiresAt(*t)
+	}
+	return csuo
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (csuo *ClientSecretUpdateOne) ClearExpiresAt() *ClientSecretUpdateOne {
+	csuo.mutation.ClearExpiresAt()
+	return csuo
+}
+
+// SetClientID sets the "client" edge to the Oauth2Client entity by ID.
+func (csuo *ClientSecretUpdateOne) SetClientID(id uuid.UUID) *ClientSecretUpdateOne {
+	csuo.mutation.SetClientID(id)
+	return csuo
+}
+
+// SetClient sets the "client" edge to the Oauth2Client entity.
+func (csuo *ClientSecretUpdateOne) SetClient(o *Oauth2Client) *ClientSecretUpdateOne {
+	return csuo.SetClientID(o.ID)
+}
+
+// Mutation returns the ClientSecretMutation object of the builder.
+func (csuo *ClientSecretUpdateOne) Mutation() *ClientSecretMutation {
+	return csuo.mutation
+}
+
+// ClearClient clears the "client" edge to the Oauth2Client entity.
+func (csuo *ClientSecretUpdateOne) ClearClient() *ClientSecretUpdateOne {
+	csuo.mutation.ClearClient()
+	return csuo
+}
+
+// Where appends a list predicates to the ClientSecretUpdate builder.
+func (csuo *ClientSecretUpdateOne) Where(ps ...predicate.ClientSecret) *ClientSecretUpdateOne {
+	csuo.mutation.Where(ps...)
+	return csuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (csuo *ClientSecretUpdateOne) Select(field string, fields ...string) *ClientSecretUpdateOne {
+	csuo.fields = append([]string{field}, fields...)
+	return csuo
+}
+
+// Save executes the query and returns the updated ClientSecret entity.
+func (csuo *ClientSecretUpdateOne) Save(ctx context.Context) (*ClientSecret, error) {
+	return withHooks(ctx, csuo.sqlSave, csuo.mutation, csuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (csuo *ClientSecretUpdateOne) SaveX(ctx context.Context) *ClientSecret {
+	node, err := csuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (csuo *ClientSecretUpdateOne) Exec(ctx context.Context) error {
+	_, err := csuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (csuo *ClientSecretUpdateOne) ExecX(ctx context.Context) {
+	if err := csuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (csuo *ClientSecretUpdateOne) check() error {
+	if v, ok := csuo.mutation.Hash(); ok {
+		if err := clientsecret.HashValidator(v); err != nil {
+			return &ValidationError{Name: "hash", err: fmt.Errorf(`ent: validator failed for field "ClientSecret.hash": %w`, err)}
+		}
+	}
+	if csuo.mutation.ClientCleared() && len(csuo.mutation.ClientIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ClientSecret.client"`)
+	}
+	return nil
+}
+
+func (csuo *ClientSecretUpdateOne) sqlSave(ctx context.Context) (_node *ClientSecret, err error) {
+	if err := csuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(clientsecret.Table, clientsecret.Columns, sqlgraph.NewFieldSpec(clientsecret.FieldID, field.TypeUUID))
+	id, ok := csuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ClientSecret.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := csuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, clientsecret.FieldID)
+		for _, f := range fields {
+			if !clientsecret.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != clientsecret.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := csuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := csuo.mutation.Hash(); ok {
+		_spec.SetField(clientsecret.FieldHash, field.TypeString, value)
+	}
+	if value, ok := csuo.mutation.Ciphertext(); ok {
+		_spec.SetField(clientsecret.FieldCiphertext, field.TypeBytes, value)
+	}
+	if csuo.mutation.CiphertextCleared() {
+		_spec.ClearField(clientsecret.FieldCiphertext, field.TypeBytes)
+	}
+	if value, ok := csuo.mutation.ExpiresAt(); ok {
+		_spec.SetField(clientsecret.FieldExpiresAt, field.TypeTime, value)
+	}
+	if csuo.mutation.ExpiresAtCleared() {
+		_spec.ClearField(clientsecret.FieldExpiresAt, field.TypeTime)
+	}
+	if csuo.mutation.ClientCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   clientsecret.ClientTable,
+			Columns: []string{clientsecret.ClientColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(oauth2client.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := csuo.mutation.ClientIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   clientsecret.ClientTable,
+			Columns: []string{clientsecret.ClientColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(oauth2client.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &ClientSecret{config: csuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, csuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{clientsecret.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	csuo.mutation.done = true
+	return _node, nil
+}
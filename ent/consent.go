@@ -0,0 +1,185 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/byebyebymyai/oauth2-api/ent/consent"
+	"github.com/byebyebymyai/oauth2-api/ent/oauth2client"
+	"github.com/google/uuid"
+)
+
+// Consent is the model entity for the Consent schema.
+type Consent struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID uuid.UUID `json:"user_id,omitempty"`
+	// Scopes holds the value of the "scopes" field.
+	Scopes []string `json:"scopes,omitempty"`
+	// GrantedAt holds the value of the "granted_at" field.
+	GrantedAt time.Time `json:"granted_at,omitempty"`
+	// ExpiresAt holds the value of the "expires_at" field.
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ConsentQuery when eager-loading is set.
+	Edges                 ConsentEdges `json:"edges"`
+	oauth2client_consents *uuid.UUID
+	selectValues          sql.SelectValues
+}
+
+// ConsentEdges holds the relations/edges for other nodes in the graph.
+type ConsentEdges struct {
+	// Client holds the value of the client edge.
+	Client *Oauth2Client `json:"client,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// ClientOrErr returns the Client value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ConsentEdges) ClientOrErr() (*Oauth2Client, error) {
+	if e.Client != nil {
+		return e.Client, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: oauth2client.Label}
+	}
+	return nil, &NotLoadedError{edge: "client"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Consent) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case consent.FieldScopes:
+			values[i] = new([]byte)
+		case consent.FieldGrantedAt, consent.FieldExpiresAt:
+			values[i] = new(sql.NullTime)
+		case consent.FieldID, consent.FieldUserID:
+			values[i] = new(uuid.UUID)
+		case consent.ForeignKeys[0]: // oauth2client_consents
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Consent fields.
+func (c *Consent) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case consent.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				c.ID = *value
+			}
+		case consent.FieldUserID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value != nil {
+				c.UserID = *value
+			}
+		case consent.FieldScopes:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field scopes", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &c.Scopes); err != nil {
+					return fmt.Errorf("unmarshal field scopes: %w", err)
+				}
+			}
+		case consent.FieldGrantedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field granted_at", values[i])
+			} else if value.Valid {
+				c.GrantedAt = value.Time
+			}
+		case consent.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				c.ExpiresAt = new(time.Time)
+				*c.ExpiresAt = value.Time
+			}
+		case consent.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field oauth2client_consents", values[i])
+			} else if value.Valid {
+				c.oauth2client_consents = new(uuid.UUID)
+				*c.oauth2client_consents = *value.S.(*uuid.UUID)
+			}
+		default:
+			c.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Consent.
+// This includes values selected through modifiers, order, etc.
+func (c *Consent) Value(name string) (ent.Value, error) {
+	return c.selectValues.Get(name)
+}
+
+// QueryClient queries the "client" edge of the Consent entity.
+func (c *Consent) QueryClient() *Oauth2ClientQuery {
+	return NewConsentClient(c.config).QueryClient(c)
+}
+
+// Update returns a builder for updating this Consent.
+// Note that you need to call Consent.Unwrap() before calling this method if this Consent
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (c *Consent) Update() *ConsentUpdateOne {
+	return NewConsentClient(c.config).UpdateOne(c)
+}
+
+// Unwrap unwraps the Consent entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (c *Consent) Unwrap() *Consent {
+	_tx, ok := c.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Consent is not a transactional entity")
+	}
+	c.config.driver = _tx.drv
+	return c
+}
+
+// String implements the fmt.Stringer.
+func (c *Consent) String() string {
+	var builder strings.Builder
+	builder.WriteString("Consent(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", c.ID))
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", c.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("scopes=")
+	builder.WriteString(fmt.Sprintf("%v", c.Scopes))
+	builder.WriteString(", ")
+	builder.WriteString("granted_at=")
+	builder.WriteString(c.GrantedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := c.ExpiresAt; v != nil {
+		builder.WriteString("expires_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Consents is a parsable slice of Consent.
+type Consents []*Consent
@@ -0,0 +1,299 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/byebyebymyai/oauth2-api/ent/consent"
+	"github.com/byebyebymyai/oauth2-api/ent/oauth2client"
+	"github.com/google/uuid"
+)
+
+// ConsentCreate is the builder for creating a Consent entity.
+type ConsentCreate struct {
+	config
+	mutation *ConsentMutation
+	hooks    []Hook
+}
+
+// SetUserID sets the "user_id" field.
+func (cc *ConsentCreate) SetUserID(u uuid.UUID) *ConsentCreate {
+	cc.mutation.SetUserID(u)
+	return cc
+}
+
+// SetScopes sets the "scopes" field.
+func (cc *ConsentCreate) SetScopes(s []string) *ConsentCreate {
+	cc.mutation.SetScopes(s)
+	return cc
+}
+
+// SetGrantedAt sets the "granted_at" field.
+func (cc *ConsentCreate) SetGrantedAt(t time.Time) *ConsentCreate {
+	cc.mutation.SetGrantedAt(t)
+	return cc
+}
+
+// SetNillableGrantedAt sets the "granted_at" field if the given value is not nil.
+func (cc *ConsentCreate) SetNillableGrantedAt(t *time.Time) *ConsentCreate {
+	if t != nil {
+		cc.SetGrantedAt(*t)
+	}
+	return cc
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (cc *ConsentCreate) SetExpiresAt(t time.Time) *ConsentCreate {
+	cc.mutation.SetExpiresAt(t)
+	return cc
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (cc *ConsentCreate) SetNillableExpiresAt(t *time.Time) *ConsentCreate {
+	if t != nil {
+		cc.SetExpiresAt(*t)
+	}
+	return cc
+}
+
+// SetID sets the "id" field.
+func (cc *ConsentCreate) SetID(u uuid.UUID) *ConsentCreate {
+	cc.mutation.SetID(u)
+	return cc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (cc *ConsentCreate) SetNillableID(u *uuid.UUID) *ConsentCreate {
+	if u != nil {
+		cc.SetID(*u)
+	}
+	return cc
+}
+
+// SetClientID sets the "client" edge to the Oauth2Client entity by ID.
+func (cc *ConsentCreate) SetClientID(id uuid.UUID) *ConsentCreate {
+	cc.mutation.SetClientID(id)
+	return cc
+}
+
+// SetClient sets the "client" edge to the Oauth2Client entity.
+func (cc *ConsentCreate) SetClient(o *Oauth2Client) *ConsentCreate {
+	return cc.SetClientID(o.ID)
+}
+
+// Mutation returns the ConsentMutation object of the builder.
+func (cc *ConsentCreate) Mutation() *ConsentMutation {
+	return cc.mutation
+}
+
+// Save creates the Consent in the database.
+func (cc *ConsentCreate) Save(ctx context.Context) (*Consent, error) {
+	cc.defaults()
+	return withHooks(ctx, cc.sqlSave, cc.mutation, cc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (cc *ConsentCreate) SaveX(ctx context.Context) *Consent {
+	v, err := cc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (cc *ConsentCreate) Exec(ctx context.Context) error {
+	_, err := cc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cc *ConsentCreate) ExecX(ctx context.Context) {
+	if err := cc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (cc *ConsentCreate) defaults() {
+	if _, ok := cc.mutation.GrantedAt(); !ok {
+		v := consent.DefaultGrantedAt()
+		cc.mutation.SetGrantedAt(v)
+	}
+	if _, ok := cc.mutation.ID(); !ok {
+		v := consent.DefaultID()
+		cc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cc *ConsentCreate) check() error {
+	if _, ok := cc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "Consent.user_id"`)}
+	}
+	if _, ok := cc.mutation.GrantedAt(); !ok {
+		return &ValidationError{Name: "granted_at", err: errors.New(`ent: missing required field "Consent.granted_at"`)}
+	}
+	if len(cc.mutation.ClientIDs()) == 0 {
+		return &ValidationError{Name: "client", err: errors.New(`ent: missing required edge "Consent.client"`)}
+	}
+	return nil
+}
+
+func (cc *ConsentCreate) sqlSave(ctx context.Context) (*Consent, error) {
+	if err := cc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := cc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, cc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	cc.mutation.id = &_node.ID
+	cc.mutation.done = true
+	return _node, nil
+}
+
+func (cc *ConsentCreate) createSpec() (*Consent, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Consent{config: cc.config}
+		_spec = sqlgraph.NewCreateSpec(consent.Table, sqlgraph.NewFieldSpec(consent.FieldID, field.TypeUUID))
+	)
+	if id, ok := cc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := cc.mutation.UserID(); ok {
+		_spec.SetField(consent.FieldUserID, field.TypeUUID, value)
+		_node.UserID = value
+	}
+	if value, ok := cc.mutation.Scopes(); ok {
+		_spec.SetField(consent.FieldScopes, field.TypeJSON, value)
+		_node.Scopes = value
+	}
+	if value, ok := cc.mutation.GrantedAt(); ok {
+		_spec.SetField(consent.FieldGrantedAt, field.TypeTime, value)
+		_node.GrantedAt = value
+	}
+	if value, ok := cc.mutation.ExpiresAt(); ok {
+		_spec.SetField(consent.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = &value
+	}
+	if nodes := cc.mutation.ClientIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   consent.ClientTable,
+			Columns: []string{consent.ClientColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(oauth2client.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.oauth2client_consents = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ConsentCreateBulk is the builder for creating many Consent entities in bulk.
+type ConsentCreateBulk struct {
+	config
+	err      error
+	builders []*ConsentCreate
+}
+
+// Save creates the Consent entities in the database.
+func (ccb *ConsentCreateBulk) Save(ctx context.Context) ([]*Consent, error) {
+	if ccb.err != nil {
+		return nil, ccb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(ccb.builders))
+	nodes := make([]*Consent, len(ccb.builders))
+	mutators := make([]Mutator, len(ccb.builders))
+	for i := range ccb.builders {
+		func(i int, root context.Context) {
+			builder := ccb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ConsentMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, ccb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, ccb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, ccb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ccb *ConsentCreateBulk) SaveX(ctx context.Context) []*Consent {
+	v, err := ccb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ccb *ConsentCreateBulk) Exec(ctx context.Context) error {
+	_, err := ccb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ccb *ConsentCreateBulk) ExecX(ctx context.Context) {
+	if err := ccb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
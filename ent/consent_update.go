@@ -0,0 +1,471 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/byebyebymyai/oauth2-api/ent/consent"
+	"github.com/byebyebymyai/oauth2-api/ent/oauth2client"
+	"github.com/byebyebymyai/oauth2-api/ent/predicate"
+	"github.com/google/uuid"
+)
+
+// ConsentUpdate is the builder for updating Consent entities.
+type ConsentUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ConsentMutation
+}
+
+// Where appends a list predicates to the ConsentUpdate builder.
+func (cu *ConsentUpdate) Where(ps ...predicate.Consent) *ConsentUpdate {
+	cu.mutation.Where(ps...)
+	return cu
+}
+
+// SetUserID sets the "user_id" field.
+func (cu *ConsentUpdate) SetUserID(u uuid.UUID) *ConsentUpdate {
+	cu.mutation.SetUserID(u)
+	return cu
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (cu *ConsentUpdate) SetNillableUserID(u *uuid.UUID) *ConsentUpdate {
+	if u != nil {
+		cu.SetUserID(*u)
+	}
+	return cu
+}
+
+// SetScopes sets the "scopes" field.
+func (cu *ConsentUpdate) SetScopes(s []string) *ConsentUpdate {
+	cu.mutation.SetScopes(s)
+	return cu
+}
+
+// AppendScopes appends s to the "scopes" field.
+func (cu *ConsentUpdate) AppendScopes(s []string) *ConsentUpdate {
+	cu.mutation.AppendScopes(s)
+	return cu
+}
+
+// ClearScopes clears the value of the "scopes" field.
+func (cu *ConsentUpdate) ClearScopes() *ConsentUpdate {
+	cu.mutation.ClearScopes()
+	return cu
+}
+
+// SetGrantedAt sets the "granted_at" field.
+func (cu *ConsentUpdate) SetGrantedAt(t time.Time) *ConsentUpdate {
+	cu.mutation.SetGrantedAt(t)
+	return cu
+}
+
+// SetNillableGrantedAt sets the "granted_at" field if the given value is not nil.
+func (cu *ConsentUpdate) SetNillableGrantedAt(t *time.Time) *ConsentUpdate {
+	if t != nil {
+		cu.SetGrantedAt(*t)
+	}
+	return cu
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (cu *ConsentUpdate) SetExpiresAt(t time.Time) *ConsentUpdate {
+	cu.mutation.SetExpiresAt(t)
+	return cu
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (cu *ConsentUpdate) SetNillableExpiresAt(t *time.Time) *ConsentUpdate {
+	if t != nil {
+		cu.SetExpiresAt(*t)
+	}
+	return cu
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (cu *ConsentUpdate) ClearExpiresAt() *ConsentUpdate {
+	cu.mutation.ClearExpiresAt()
+	return cu
+}
+
+// SetClientID sets the "client" edge to the Oauth2Client entity by ID.
+func (cu *ConsentUpdate) SetClientID(id uuid.UUID) *ConsentUpdate {
+	cu.mutation.SetClientID(id)
+	return cu
+}
+
+// SetClient sets the "client" edge to the Oauth2Client entity.
+func (cu *ConsentUpdate) SetClient(o *Oauth2Client) *ConsentUpdate {
+	return cu.SetClientID(o.ID)
+}
+
+// Mutation returns the ConsentMutation object of the builder.
+func (cu *ConsentUpdate) Mutation() *ConsentMutation {
+	return cu.mutation
+}
+
+// ClearClient clears the "client" edge to the Oauth2Client entity.
+func (cu *ConsentUpdate) ClearClient() *ConsentUpdate {
+	cu.mutation.ClearClient()
+	return cu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (cu *ConsentUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, cu.sqlSave, cu.mutation, cu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cu *ConsentUpdate) SaveX(ctx context.Context) int {
+	affected, err := cu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (cu *ConsentUpdate) Exec(ctx context.Context) error {
+	_, err := cu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cu *ConsentUpdate) ExecX(ctx context.Context) {
+	if err := cu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cu *ConsentUpdate) check() error {
+	if cu.mutation.ClientCleared() && len(cu.mutation.ClientIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Consent.client"`)
+	}
+	return nil
+}
+
+func (cu *ConsentUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := cu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(consent.Table, consent.Columns, sqlgraph.NewFieldSpec(consent.FieldID, field.TypeUUID))
+	if ps := cu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cu.mutation.UserID(); ok {
+		_spec.SetField(consent.FieldUserID, field.TypeUUID, value)
+	}
+	if value, ok := cu.mutation.Scopes(); ok {
+		_spec.SetField(consent.FieldScopes, field.TypeJSON, value)
+	}
+	if value, ok := cu.mutation.AppendedScopes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, consent.FieldScopes, value)
+		})
+	}
+	if cu.mutation.ScopesCleared() {
+		_spec.ClearField(consent.FieldScopes, field.TypeJSON)
+	}
+	if value, ok := cu.mutation.GrantedAt(); ok {
+		_spec.SetField(consent.FieldGrantedAt, field.TypeTime, value)
+	}
+	if value, ok := cu.mutation.ExpiresAt(); ok {
+		_spec.SetField(consent.FieldExpiresAt, field.TypeTime, value)
+	}
+	if cu.mutation.ExpiresAtCleared() {
+		_spec.ClearField(consent.FieldExpiresAt, field.TypeTime)
+	}
+	if cu.mutation.ClientCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   consent.ClientTable,
+			Columns: []string{consent.ClientColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(oauth2client.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.ClientIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   consent.ClientTable,
+			Columns: []string{consent.ClientColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(oauth2client.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, cu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{consent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	cu.mutation.done = true
+	return n, nil
+}
+
+// ConsentUpdateOne is the builder for updating a single Consent entity.
+type ConsentUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ConsentMutation
+}
+
+// SetUserID sets the "user_id" field.
+func (cuo *ConsentUpdateOne) SetUserID(u uuid.UUID) *ConsentUpdateOne {
+	cuo.mutation.SetUserID(u)
+	return cuo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (cuo *ConsentUpdateOne) SetNillableUserID(u *uuid.UUID) *ConsentUpdateOne {
+	if u != nil {
+		cuo.SetUserID(*u)
+	}
+	return cuo
+}
+
+// SetScopes sets the "scopes" field.
+func (cuo *ConsentUpdateOne) SetScopes(s []string) *ConsentUpdateOne {
+	cuo.mutation.SetScopes(s)
+	return cuo
+}
+
+// AppendScopes appends s to the "scopes" field.
+func (cuo *ConsentUpdateOne) AppendScopes(s []string) *ConsentUpdateOne {
+	cuo.mutation.AppendScopes(s)
+	return cuo
+}
+
+// ClearScopes clears the value of the "scopes" field.
+func (cuo *ConsentUpdateOne) ClearScopes() *ConsentUpdateOne {
+	cuo.mutation.ClearScopes()
+	return cuo
+}
+
+// SetGrantedAt sets the "granted_at" field.
+func (cuo *ConsentUpdateOne) SetGrantedAt(t time.Time) *ConsentUpdateOne {
+	cuo.mutation.SetGrantedAt(t)
+	return cuo
+}
+
+// SetNillableGrantedAt sets the "granted_at" field if the given value is not nil.
+func (cuo *ConsentUpdateOne) SetNillableGrantedAt(t *time.Time) *ConsentUpdateOne {
+	if t != nil {
+		cuo.SetGrantedAt(*t)
+	}
+	return cuo
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (cuo *ConsentUpdateOne) SetExpiresAt(t time.Time) *ConsentUpdateOne {
+	cuo.mutation.SetExpiresAt(t)
+	return cuo
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (cuo *ConsentUpdateOne) SetNillableExpiresAt(t *time.Time) *ConsentUpdateOne {
+	if t != nil {
+		cuo.SetExpiresAt(*t)
+	}
+	return cuo
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (cuo *ConsentUpdateOne) ClearExpiresAt() *ConsentUpdateOne {
+	cuo.mutation.ClearExpiresAt()
+	return cuo
+}
+
+// SetClientID sets the "client" edge to the Oauth2Client entity by ID.
+func (cuo *ConsentUpdateOne) SetClientID(id uuid.UUID) *ConsentUpdateOne {
+	cuo.mutation.SetClientID(id)
+	return cuo
+}
+
+// SetClient sets the "client" edge to the Oauth2Client entity.
+func (cuo *ConsentUpdateOne) SetClient(o *Oauth2Client) *ConsentUpdateOne {
+	return cuo.SetClientID(o.ID)
+}
+
+// Mutation returns the ConsentMutation object of the builder.
+func (cuo *ConsentUpdateOne) Mutation() *ConsentMutation {
+	return cuo.mutation
+}
+
+// ClearClient clears the "client" edge to the Oauth2Client entity.
+func (cuo *ConsentUpdateOne) ClearClient() *ConsentUpdateOne {
+	cuo.mutation.ClearClient()
+	return cuo
+}
+
+// Where appends a list predicates to the ConsentUpdate builder.
+func (cuo *ConsentUpdateOne) Where(ps ...predicate.Consent) *ConsentUpdateOne {
+	cuo.mutation.Where(ps...)
+	return cuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (cuo *ConsentUpdateOne) Select(field string, fields ...string) *ConsentUpdateOne {
+	cuo.fields = append([]string{field}, fields...)
+	return cuo
+}
+
+// Save executes the query and returns the updated Consent entity.
+func (cuo *ConsentUpdateOne) Save(ctx context.Context) (*Consent, error) {
+	return withHooks(ctx, cuo.sqlSave, cuo.mutation, cuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cuo *ConsentUpdateOne) SaveX(ctx context.Context) *Consent {
+	node, err := cuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (cuo *ConsentUpdateOne) Exec(ctx context.Context) error {
+	_, err := cuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cuo *ConsentUpdateOne) ExecX(ctx context.Context) {
+	if err := cuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cuo *ConsentUpdateOne) check() error {
+	if cuo.mutation.ClientCleared() && len(cuo.mutation.ClientIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Consent.client"`)
+	}
+	return nil
+}
+
+func (cuo *ConsentUpdateOne) sqlSave(ctx context.Context) (_node *Consent, err error) {
+	if err := cuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(consent.Table, consent.Columns, sqlgraph.NewFieldSpec(consent.FieldID, field.TypeUUID))
+	id, ok := cuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Consent.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := cuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, consent.FieldID)
+		for _, f := range fields {
+			if !consent.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != consent.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := cuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cuo.mutation.UserID(); ok {
+		_spec.SetField(consent.FieldUserID, field.TypeUUID, value)
+	}
+	if value, ok := cuo.mutation.Scopes(); ok {
+		_spec.SetField(consent.FieldScopes, field.TypeJSON, value)
+	}
+	if value, ok := cuo.mutation.AppendedScopes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, consent.FieldScopes, value)
+		})
+	}
+	if cuo.mutation.ScopesCleared() {
+		_spec.ClearField(consent.FieldScopes, field.TypeJSON)
+	}
+	if value, ok := cuo.mutation.GrantedAt(); ok {
+		_spec.SetField(consent.FieldGrantedAt, field.TypeTime, value)
+	}
+	if value, ok := cuo.mutation.ExpiresAt(); ok {
+		_spec.SetField(consent.FieldExpiresAt, field.TypeTime, value)
+	}
+	if cuo.mutation.ExpiresAtCleared() {
+		_spec.ClearField(consent.FieldExpiresAt, field.TypeTime)
+	}
+	if cuo.mutation.ClientCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   consent.ClientTable,
+			Columns: []string{consent.ClientColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(oauth2client.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.ClientIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   consent.ClientTable,
+			Columns: []string{consent.ClientColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(oauth2client.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Consent{config: cuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, cuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{consent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	cuo.mutation.done = true
+	return _node, nil
+}
@@ -12,7 +12,11 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/byebyebymyai/oauth2-api/ent/clientsecret"
+	"github.com/byebyebymyai/oauth2-api/ent/consent"
 	"github.com/byebyebymyai/oauth2-api/ent/oauth2client"
+	"github.com/byebyebymyai/oauth2-api/ent/scope"
+	"github.com/byebyebymyai/oauth2-api/ent/scopepermission"
 )
 
 // ent aliases to avoid import conflicts in user's code.
@@ -73,7 +77,11 @@ var (
 func checkColumn(table, column string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			oauth2client.Table: oauth2client.ValidColumn,
+			clientsecret.Table:    clientsecret.ValidColumn,
+			consent.Table:         consent.ValidColumn,
+			oauth2client.Table:    oauth2client.ValidColumn,
+			scope.Table:           scope.ValidColumn,
+			scopepermission.Table: scopepermission.ValidColumn,
 		})
 	})
 	return columnCheck(table, column)
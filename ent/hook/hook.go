@@ -9,6 +9,30 @@ import (
 	"github.com/byebyebymyai/oauth2-api/ent"
 )
 
+// The ClientSecretFunc type is an adapter to allow the use of ordinary
+// function as ClientSecret mutator.
+type ClientSecretFunc func(context.Context, *ent.ClientSecretMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ClientSecretFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ClientSecretMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ClientSecretMutation", m)
+}
+
+// The ConsentFunc type is an adapter to allow the use of ordinary
+// function as Consent mutator.
+type ConsentFunc func(context.Context, *ent.ConsentMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ConsentFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ConsentMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ConsentMutation", m)
+}
+
 // The Oauth2ClientFunc type is an adapter to allow the use of ordinary
 // function as Oauth2Client mutator.
 type Oauth2ClientFunc func(context.Context, *ent.Oauth2ClientMutation) (ent.Value, error)
@@ -21,6 +45,30 @@ func (f Oauth2ClientFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.Oauth2ClientMutation", m)
 }
 
+// The ScopeFunc type is an adapter to allow the use of ordinary
+// function as Scope mutator.
+type ScopeFunc func(context.Context, *ent.ScopeMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ScopeFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ScopeMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ScopeMutation", m)
+}
+
+// The ScopePermissionFunc type is an adapter to allow the use of ordinary
+// function as ScopePermission mutator.
+type ScopePermissionFunc func(context.Context, *ent.ScopePermissionMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ScopePermissionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ScopePermissionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ScopePermissionMutation", m)
+}
+
 // Condition is a hook condition function.
 type Condition func(context.Context, ent.Mutation) bool
 
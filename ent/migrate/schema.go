@@ -8,11 +8,85 @@ import (
 )
 
 var (
+	// ClientSecretsColumns holds the columns for the "client_secrets" table.
+	ClientSecretsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "hash", Type: field.TypeString},
+		{Name: "ciphertext", Type: field.TypeBytes, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "expires_at", Type: field.TypeTime, Nullable: true},
+		{Name: "oauth2client_secrets", Type: field.TypeUUID},
+	}
+	// ClientSecretsTable holds the schema information for the "client_secrets" table.
+	ClientSecretsTable = &schema.Table{
+		Name:       "client_secrets",
+		Columns:    ClientSecretsColumns,
+		PrimaryKey: []*schema.Column{ClientSecretsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "client_secrets_oauth2clients_secrets",
+				Columns:    []*schema.Column{ClientSecretsColumns[5]},
+				RefColumns: []*schema.Column{Oauth2clientsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
+	// ConsentsColumns holds the columns for the "consents" table.
+	ConsentsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "user_id", Type: field.TypeUUID},
+		{Name: "scopes", Type: field.TypeJSON, Nullable: true},
+		{Name: "granted_at", Type: field.TypeTime},
+		{Name: "expires_at", Type: field.TypeTime, Nullable: true},
+		{Name: "oauth2client_consents", Type: field.TypeUUID},
+	}
+	// ConsentsTable holds the schema information for the "consents" table.
+	ConsentsTable = &schema.Table{
+		Name:       "consents",
+		Columns:    ConsentsColumns,
+		PrimaryKey: []*schema.Column{ConsentsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "consents_oauth2clients_consents",
+				Columns:    []*schema.Column{ConsentsColumns[5]},
+				RefColumns: []*schema.Column{Oauth2clientsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "consent_user_id_oauth2client_consents",
+				Unique:  true,
+				Columns: []*schema.Column{ConsentsColumns[1], ConsentsColumns[5]},
+			},
+		},
+	}
 	// Oauth2clientsColumns holds the columns for the "oauth2clients" table.
 	Oauth2clientsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
-		{Name: "secret", Type: field.TypeString},
+		{Name: "secret", Type: field.TypeString, Nullable: true},
 		{Name: "domain", Type: field.TypeString},
+		{Name: "require_pkce", Type: field.TypeBool, Default: false},
+		{Name: "exchange_audiences", Type: field.TypeJSON, Nullable: true},
+		{Name: "exchange_scopes", Type: field.TypeJSON, Nullable: true},
+		{Name: "jwt_bearer_issuers", Type: field.TypeJSON, Nullable: true},
+		{Name: "token_endpoint_auth_method", Type: field.TypeString, Nullable: true},
+		{Name: "jwks", Type: field.TypeJSON, Nullable: true},
+		{Name: "jwks_uri", Type: field.TypeString, Nullable: true},
+		{Name: "tls_client_auth_subject_dn", Type: field.TypeString, Nullable: true},
+		{Name: "dpop_bound_access_tokens", Type: field.TypeBool, Default: false},
+		{Name: "require_pushed_authorization_requests", Type: field.TypeBool, Default: false},
+		{Name: "request_uris", Type: field.TypeJSON, Nullable: true},
+		{Name: "client_name", Type: field.TypeString, Nullable: true},
+		{Name: "redirect_uris", Type: field.TypeJSON, Nullable: true},
+		{Name: "grant_types", Type: field.TypeJSON, Nullable: true},
+		{Name: "response_types", Type: field.TypeJSON, Nullable: true},
+		{Name: "registration_access_token_hash", Type: field.TypeString, Nullable: true},
+		{Name: "first_party", Type: field.TypeBool, Default: false},
+		{Name: "logo_uri", Type: field.TypeString, Nullable: true},
+		{Name: "post_logout_redirect_uris", Type: field.TypeJSON, Nullable: true},
+		{Name: "allowed_scopes", Type: field.TypeJSON, Nullable: true},
+		{Name: "token_claims", Type: field.TypeJSON, Nullable: true},
 	}
 	// Oauth2clientsTable holds the schema information for the "oauth2clients" table.
 	Oauth2clientsTable = &schema.Table{
@@ -20,11 +94,61 @@ var (
 		Columns:    Oauth2clientsColumns,
 		PrimaryKey: []*schema.Column{Oauth2clientsColumns[0]},
 	}
+	// ScopesColumns holds the columns for the "scopes" table.
+	ScopesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "name", Type: field.TypeString, Unique: true},
+		{Name: "description", Type: field.TypeJSON, Nullable: true},
+		{Name: "is_default", Type: field.TypeBool, Default: false},
+		{Name: "requires_consent", Type: field.TypeBool, Default: true},
+	}
+	// ScopesTable holds the schema information for the "scopes" table.
+	ScopesTable = &schema.Table{
+		Name:       "scopes",
+		Columns:    ScopesColumns,
+		PrimaryKey: []*schema.Column{ScopesColumns[0]},
+	}
+	// ScopePermissionsColumns holds the columns for the "scope_permissions" table.
+	ScopePermissionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "method", Type: field.TypeString},
+		{Name: "host", Type: field.TypeString},
+		{Name: "path", Type: field.TypeString},
+		{Name: "scope_permissions", Type: field.TypeUUID},
+	}
+	// ScopePermissionsTable holds the schema information for the "scope_permissions" table.
+	ScopePermissionsTable = &schema.Table{
+		Name:       "scope_permissions",
+		Columns:    ScopePermissionsColumns,
+		PrimaryKey: []*schema.Column{ScopePermissionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "scope_permissions_scopes_permissions",
+				Columns:    []*schema.Column{ScopePermissionsColumns[4]},
+				RefColumns: []*schema.Column{ScopesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "scopepermission_method_host_path_scope_permissions",
+				Unique:  true,
+				Columns: []*schema.Column{ScopePermissionsColumns[1], ScopePermissionsColumns[2], ScopePermissionsColumns[3], ScopePermissionsColumns[4]},
+			},
+		},
+	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		ClientSecretsTable,
+		ConsentsTable,
 		Oauth2clientsTable,
+		ScopesTable,
+		ScopePermissionsTable,
 	}
 )
 
 func init() {
+	ClientSecretsTable.ForeignKeys[0].RefTable = Oauth2clientsTable
+	ConsentsTable.ForeignKeys[0].RefTable = Oauth2clientsTable
+	ScopePermissionsTable.ForeignKeys[0].RefTable = ScopesTable
 }
@@ -4,14 +4,20 @@ package ent
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"sync"
+	"time"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
+	"github.com/byebyebymyai/oauth2-api/ent/clientsecret"
+	"github.com/byebyebymyai/oauth2-api/ent/consent"
 	"github.com/byebyebymyai/oauth2-api/ent/oauth2client"
 	"github.com/byebyebymyai/oauth2-api/ent/predicate"
+	"github.com/byebyebymyai/oauth2-api/ent/scope"
+	"github.com/byebyebymyai/oauth2-api/ent/scopepermission"
 	"github.com/google/uuid"
 )
 
@@ -24,34 +30,4082 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeOauth2Client = "Oauth2Client"
+	TypeClientSecret    = "ClientSecret"
+	TypeConsent         = "Consent"
+	TypeOauth2Client    = "Oauth2Client"
+	TypeScope           = "Scope"
+	TypeScopePermission = "ScopePermission"
 )
 
+// ClientSecretMutation represents an operation that mutates the ClientSecret nodes in the graph.
+type ClientSecretMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	hash          *string
+	ciphertext    *[]byte
+	created_at    *time.Time
+	expires_at    *time.Time
+	clearedFields map[string]struct{}
+	client        *uuid.UUID
+	clearedclient bool
+	done          bool
+	oldValue      func(context.Context) (*ClientSecret, error)
+	predicates    []predicate.ClientSecret
+}
+
+var _ ent.Mutation = (*ClientSecretMutation)(nil)
+
+// clientsecretOption allows management of the mutation configuration using functional options.
+type clientsecretOption func(*ClientSecretMutation)
+
+// newClientSecretMutation creates new mutation for the ClientSecret entity.
+func newClientSecretMutation(c config, op Op, opts ...clientsecretOption) *ClientSecretMutation {
+	m := &ClientSecretMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeClientSecret,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withClientSecretID sets the ID field of the mutation.
+func withClientSecretID(id uuid.UUID) clientsecretOption {
+	return func(m *ClientSecretMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ClientSecret
+		)
+		m.oldValue = func(ctx context.Context) (*ClientSecret, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ClientSecret.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withClientSecret sets the old ClientSecret of the mutation.
+func withClientSecret(node *ClientSecret) clientsecretOption {
+	return func(m *ClientSecretMutation) {
+		m.oldValue = func(context.Context) (*ClientSecret, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ClientSecretMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ClientSecretMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of ClientSecret entities.
+func (m *ClientSecretMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ClientSecretMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ClientSecretMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ClientSecret.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetHash sets the "hash" field.
+func (m *ClientSecretMutation) SetHash(s string) {
+	m.hash = &s
+}
+
+// Hash returns the value of the "hash" field in the mutation.
+func (m *ClientSecretMutation) Hash() (r string, exists bool) {
+	v := m.hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHash returns the old "hash" field's value of the ClientSecret entity.
+// If the ClientSecret object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ClientSecretMutation) OldHash(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHash: %w", err)
+	}
+	return oldValue.Hash, nil
+}
+
+// ResetHash resets all changes to the "hash" field.
+func (m *ClientSecretMutation) ResetHash() {
+	m.hash = nil
+}
+
+// SetCiphertext sets the "ciphertext" field.
+func (m *ClientSecretMutation) SetCiphertext(b []byte) {
+	m.ciphertext = &b
+}
+
+// Ciphertext returns the value of the "ciphertext" field in the mutation.
+func (m *ClientSecretMutation) Ciphertext() (r []byte, exists bool) {
+	v := m.ciphertext
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCiphertext returns the old "ciphertext" field's value of the ClientSecret entity.
+// If the ClientSecret object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ClientSecretMutation) OldCiphertext(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCiphertext is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCiphertext requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCiphertext: %w", err)
+	}
+	return oldValue.Ciphertext, nil
+}
+
+// ClearCiphertext clears the value of the "ciphertext" field.
+func (m *ClientSecretMutation) ClearCiphertext() {
+	m.ciphertext = nil
+	m.clearedFields[clientsecret.FieldCiphertext] = struct{}{}
+}
+
+// CiphertextCleared returns if the "ciphertext" field was cleared in this mutation.
+func (m *ClientSecretMutation) CiphertextCleared() bool {
+	_, ok := m.clearedFields[clientsecret.FieldCiphertext]
+	return ok
+}
+
+// ResetCiphertext resets all changes to the "ciphertext" field.
+func (m *ClientSecretMutation) ResetCiphertext() {
+	m.ciphertext = nil
+	delete(m.clearedFields, clientsecret.FieldCiphertext)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *ClientSecretMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ClientSecretMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the ClientSecret entity.
+// If the ClientSecret object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ClientSecretMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ClientSecretMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (m *ClientSecretMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
+}
+
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *ClientSecretMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpiresAt returns the old "expires_at" field's value of the ClientSecret entity.
+// If the ClientSecret object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ClientSecretMutation) OldExpiresAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
+	}
+	return oldValue.ExpiresAt, nil
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (m *ClientSecretMutation) ClearExpiresAt() {
+	m.expires_at = nil
+	m.clearedFields[clientsecret.FieldExpiresAt] = struct{}{}
+}
+
+// ExpiresAtCleared returns if the "expires_at" field was cleared in this mutation.
+func (m *ClientSecretMutation) ExpiresAtCleared() bool {
+	_, ok := m.clearedFields[clientsecret.FieldExpiresAt]
+	return ok
+}
+
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *ClientSecretMutation) ResetExpiresAt() {
+	m.expires_at = nil
+	delete(m.clearedFields, clientsecret.FieldExpiresAt)
+}
+
+// SetClientID sets the "client" edge to the Oauth2Client entity by id.
+func (m *ClientSecretMutation) SetClientID(id uuid.UUID) {
+	m.client = &id
+}
+
+// ClearClient clears the "client" edge to the Oauth2Client entity.
+func (m *ClientSecretMutation) ClearClient() {
+	m.clearedclient = true
+}
+
+// ClientCleared reports if the "client" edge to the Oauth2Client entity was cleared.
+func (m *ClientSecretMutation) ClientCleared() bool {
+	return m.clearedclient
+}
+
+// ClientID returns the "client" edge ID in the mutation.
+func (m *ClientSecretMutation) ClientID() (id uuid.UUID, exists bool) {
+	if m.client != nil {
+		return *m.client, true
+	}
+	return
+}
+
+// ClientIDs returns the "client" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ClientID instead. It exists only for internal usage by the builders.
+func (m *ClientSecretMutation) ClientIDs() (ids []uuid.UUID) {
+	if id := m.client; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetClient resets all changes to the "client" edge.
+func (m *ClientSecretMutation) ResetClient() {
+	m.client = nil
+	m.clearedclient = false
+}
+
+// Where appends a list predicates to the ClientSecretMutation builder.
+func (m *ClientSecretMutation) Where(ps ...predicate.ClientSecret) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ClientSecretMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ClientSecretMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ClientSecret, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ClientSecretMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ClientSecretMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ClientSecret).
+func (m *ClientSecretMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ClientSecretMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.hash != nil {
+		fields = append(fields, clientsecret.FieldHash)
+	}
+	if m.ciphertext != nil {
+		fields = append(fields, clientsecret.FieldCiphertext)
+	}
+	if m.created_at != nil {
+		fields = append(fields, clientsecret.FieldCreatedAt)
+	}
+	if m.expires_at != nil {
+		fields = append(fields, clientsecret.FieldExpiresAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ClientSecretMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case clientsecret.FieldHash:
+		return m.Hash()
+	case clientsecret.FieldCiphertext:
+		return m.Ciphertext()
+	case clientsecret.FieldCreatedAt:
+		return m.CreatedAt()
+	case clientsecret.FieldExpiresAt:
+		return m.ExpiresAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ClientSecretMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case clientsecret.FieldHash:
+		return m.OldHash(ctx)
+	case clientsecret.FieldCiphertext:
+		return m.OldCiphertext(ctx)
+	case clientsecret.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case clientsecret.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown ClientSecret field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ClientSecretMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case clientsecret.FieldHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHash(v)
+		return nil
+	case clientsecret.FieldCiphertext:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCiphertext(v)
+		return nil
+	case clientsecret.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case clientsecret.FieldExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiresAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ClientSecret field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ClientSecretMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ClientSecretMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ClientSecretMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown ClientSecret numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ClientSecretMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(clientsecret.FieldCiphertext) {
+		fields = append(fields, clientsecret.FieldCiphertext)
+	}
+	if m.FieldCleared(clientsecret.FieldExpiresAt) {
+		fields = append(fields, clientsecret.FieldExpiresAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ClientSecretMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ClientSecretMutation) ClearField(name string) error {
+	switch name {
+	case clientsecret.FieldCiphertext:
+		m.ClearCiphertext()
+		return nil
+	case clientsecret.FieldExpiresAt:
+		m.ClearExpiresAt()
+		return nil
+	}
+	return fmt.Errorf("unknown ClientSecret nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ClientSecretMutation) ResetField(name string) error {
+	switch name {
+	case clientsecret.FieldHash:
+		m.ResetHash()
+		return nil
+	case clientsecret.FieldCiphertext:
+		m.ResetCiphertext()
+		return nil
+	case clientsecret.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case clientsecret.FieldExpiresAt:
+		m.ResetExpiresAt()
+		return nil
+	}
+	return fmt.Errorf("unknown ClientSecret field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ClientSecretMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.client != nil {
+		edges = append(edges, clientsecret.EdgeClient)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ClientSecretMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case clientsecret.EdgeClient:
+		if id := m.client; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ClientSecretMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ClientSecretMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ClientSecretMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedclient {
+		edges = append(edges, clientsecret.EdgeClient)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ClientSecretMutation) EdgeCleared(name string) bool {
+	switch name {
+	case clientsecret.EdgeClient:
+		return m.clearedclient
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ClientSecretMutation) ClearEdge(name string) error {
+	switch name {
+	case clientsecret.EdgeClient:
+		m.ClearClient()
+		return nil
+	}
+	return fmt.Errorf("unknown ClientSecret unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ClientSecretMutation) ResetEdge(name string) error {
+	switch name {
+	case clientsecret.EdgeClient:
+		m.ResetClient()
+		return nil
+	}
+	return fmt.Errorf("unknown ClientSecret edge %s", name)
+}
+
+// ConsentMutation represents an operation that mutates the Consent nodes in the graph.
+type ConsentMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	user_id       *uuid.UUID
+	scopes        *[]string
+	appendscopes  []string
+	granted_at    *time.Time
+	expires_at    *time.Time
+	clearedFields map[string]struct{}
+	client        *uuid.UUID
+	clearedclient bool
+	done          bool
+	oldValue      func(context.Context) (*Consent, error)
+	predicates    []predicate.Consent
+}
+
+var _ ent.Mutation = (*ConsentMutation)(nil)
+
+// consentOption allows management of the mutation configuration using functional options.
+type consentOption func(*ConsentMutation)
+
+// newConsentMutation creates new mutation for the Consent entity.
+func newConsentMutation(c config, op Op, opts ...consentOption) *ConsentMutation {
+	m := &ConsentMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeConsent,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withConsentID sets the ID field of the mutation.
+func withConsentID(id uuid.UUID) consentOption {
+	return func(m *ConsentMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Consent
+		)
+		m.oldValue = func(ctx context.Context) (*Consent, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Consent.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withConsent sets the old Consent of the mutation.
+func withConsent(node *Consent) consentOption {
+	return func(m *ConsentMutation) {
+		m.oldValue = func(context.Context) (*Consent, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ConsentMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ConsentMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Consent entities.
+func (m *ConsentMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ConsentMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ConsentMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Consent.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetUserID sets the "user_id" field.
+func (m *ConsentMutation) SetUserID(u uuid.UUID) {
+	m.user_id = &u
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *ConsentMutation) UserID() (r uuid.UUID, exists bool) {
+	v := m.user_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the Consent entity.
+// If the Consent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConsentMutation) OldUserID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *ConsentMutation) ResetUserID() {
+	m.user_id = nil
+}
+
+// SetScopes sets the "scopes" field.
+func (m *ConsentMutation) SetScopes(s []string) {
+	m.scopes = &s
+	m.appendscopes = nil
+}
+
+// Scopes returns the value of the "scopes" field in the mutation.
+func (m *ConsentMutation) Scopes() (r []string, exists bool) {
+	v := m.scopes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldScopes returns the old "scopes" field's value of the Consent entity.
+// If the Consent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConsentMutation) OldScopes(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldScopes is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldScopes requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldScopes: %w", err)
+	}
+	return oldValue.Scopes, nil
+}
+
+// AppendScopes adds s to the "scopes" field.
+func (m *ConsentMutation) AppendScopes(s []string) {
+	m.appendscopes = append(m.appendscopes, s...)
+}
+
+// AppendedScopes returns the list of values that were appended to the "scopes" field in this mutation.
+func (m *ConsentMutation) AppendedScopes() ([]string, bool) {
+	if len(m.appendscopes) == 0 {
+		return nil, false
+	}
+	return m.appendscopes, true
+}
+
+// ClearScopes clears the value of the "scopes" field.
+func (m *ConsentMutation) ClearScopes() {
+	m.scopes = nil
+	m.appendscopes = nil
+	m.clearedFields[consent.FieldScopes] = struct{}{}
+}
+
+// ScopesCleared returns if the "scopes" field was cleared in this mutation.
+func (m *ConsentMutation) ScopesCleared() bool {
+	_, ok := m.clearedFields[consent.FieldScopes]
+	return ok
+}
+
+// ResetScopes resets all changes to the "scopes" field.
+func (m *ConsentMutation) ResetScopes() {
+	m.scopes = nil
+	m.appendscopes = nil
+	delete(m.clearedFields, consent.FieldScopes)
+}
+
+// SetGrantedAt sets the "granted_at" field.
+func (m *ConsentMutation) SetGrantedAt(t time.Time) {
+	m.granted_at = &t
+}
+
+// GrantedAt returns the value of the "granted_at" field in the mutation.
+func (m *ConsentMutation) GrantedAt() (r time.Time, exists bool) {
+	v := m.granted_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGrantedAt returns the old "granted_at" field's value of the Consent entity.
+// If the Consent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConsentMutation) OldGrantedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGrantedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGrantedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGrantedAt: %w", err)
+	}
+	return oldValue.GrantedAt, nil
+}
+
+// ResetGrantedAt resets all changes to the "granted_at" field.
+func (m *ConsentMutation) ResetGrantedAt() {
+	m.granted_at = nil
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (m *ConsentMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
+}
+
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *ConsentMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpiresAt returns the old "expires_at" field's value of the Consent entity.
+// If the Consent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConsentMutation) OldExpiresAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
+	}
+	return oldValue.ExpiresAt, nil
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (m *ConsentMutation) ClearExpiresAt() {
+	m.expires_at = nil
+	m.clearedFields[consent.FieldExpiresAt] = struct{}{}
+}
+
+// ExpiresAtCleared returns if the "expires_at" field was cleared in this mutation.
+func (m *ConsentMutation) ExpiresAtCleared() bool {
+	_, ok := m.clearedFields[consent.FieldExpiresAt]
+	return ok
+}
+
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *ConsentMutation) ResetExpiresAt() {
+	m.expires_at = nil
+	delete(m.clearedFields, consent.FieldExpiresAt)
+}
+
+// SetClientID sets the "client" edge to the Oauth2Client entity by id.
+func (m *ConsentMutation) SetClientID(id uuid.UUID) {
+	m.client = &id
+}
+
+// ClearClient clears the "client" edge to the Oauth2Client entity.
+func (m *ConsentMutation) ClearClient() {
+	m.clearedclient = true
+}
+
+// ClientCleared reports if the "client" edge to the Oauth2Client entity was cleared.
+func (m *ConsentMutation) ClientCleared() bool {
+	return m.clearedclient
+}
+
+// ClientID returns the "client" edge ID in the mutation.
+func (m *ConsentMutation) ClientID() (id uuid.UUID, exists bool) {
+	if m.client != nil {
+		return *m.client, true
+	}
+	return
+}
+
+// ClientIDs returns the "client" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ClientID instead. It exists only for internal usage by the builders.
+func (m *ConsentMutation) ClientIDs() (ids []uuid.UUID) {
+	if id := m.client; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetClient resets all changes to the "client" edge.
+func (m *ConsentMutation) ResetClient() {
+	m.client = nil
+	m.clearedclient = false
+}
+
+// Where appends a list predicates to the ConsentMutation builder.
+func (m *ConsentMutation) Where(ps ...predicate.Consent) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ConsentMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ConsentMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Consent, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ConsentMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ConsentMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Consent).
+func (m *ConsentMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ConsentMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.user_id != nil {
+		fields = append(fields, consent.FieldUserID)
+	}
+	if m.scopes != nil {
+		fields = append(fields, consent.FieldScopes)
+	}
+	if m.granted_at != nil {
+		fields = append(fields, consent.FieldGrantedAt)
+	}
+	if m.expires_at != nil {
+		fields = append(fields, consent.FieldExpiresAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ConsentMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case consent.FieldUserID:
+		return m.UserID()
+	case consent.FieldScopes:
+		return m.Scopes()
+	case consent.FieldGrantedAt:
+		return m.GrantedAt()
+	case consent.FieldExpiresAt:
+		return m.ExpiresAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ConsentMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case consent.FieldUserID:
+		return m.OldUserID(ctx)
+	case consent.FieldScopes:
+		return m.OldScopes(ctx)
+	case consent.FieldGrantedAt:
+		return m.OldGrantedAt(ctx)
+	case consent.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Consent field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ConsentMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case consent.FieldUserID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case consent.FieldScopes:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetScopes(v)
+		return nil
+	case consent.FieldGrantedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGrantedAt(v)
+		return nil
+	case consent.FieldExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiresAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Consent field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ConsentMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ConsentMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ConsentMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Consent numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ConsentMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(consent.FieldScopes) {
+		fields = append(fields, consent.FieldScopes)
+	}
+	if m.FieldCleared(consent.FieldExpiresAt) {
+		fields = append(fields, consent.FieldExpiresAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ConsentMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ConsentMutation) ClearField(name string) error {
+	switch name {
+	case consent.FieldScopes:
+		m.ClearScopes()
+		return nil
+	case consent.FieldExpiresAt:
+		m.ClearExpiresAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Consent nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ConsentMutation) ResetField(name string) error {
+	switch name {
+	case consent.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case consent.FieldScopes:
+		m.ResetScopes()
+		return nil
+	case consent.FieldGrantedAt:
+		m.ResetGrantedAt()
+		return nil
+	case consent.FieldExpiresAt:
+		m.ResetExpiresAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Consent field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ConsentMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.client != nil {
+		edges = append(edges, consent.EdgeClient)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ConsentMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case consent.EdgeClient:
+		if id := m.client; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ConsentMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ConsentMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ConsentMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedclient {
+		edges = append(edges, consent.EdgeClient)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ConsentMutation) EdgeCleared(name string) bool {
+	switch name {
+	case consent.EdgeClient:
+		return m.clearedclient
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ConsentMutation) ClearEdge(name string) error {
+	switch name {
+	case consent.EdgeClient:
+		m.ClearClient()
+		return nil
+	}
+	return fmt.Errorf("unknown Consent unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ConsentMutation) ResetEdge(name string) error {
+	switch name {
+	case consent.EdgeClient:
+		m.ResetClient()
+		return nil
+	}
+	return fmt.Errorf("unknown Consent edge %s", name)
+}
+
 // Oauth2ClientMutation represents an operation that mutates the Oauth2Client nodes in the graph.
 type Oauth2ClientMutation struct {
+	config
+	op                                    Op
+	typ                                   string
+	id                                    *uuid.UUID
+	secret                                *string
+	domain                                *string
+	require_pkce                          *bool
+	exchange_audiences                    *[]string
+	appendexchange_audiences              []string
+	exchange_scopes                       *[]string
+	appendexchange_scopes                 []string
+	jwt_bearer_issuers                    *map[string]json.RawMessage
+	token_endpoint_auth_method            *string
+	jwks                                  *json.RawMessage
+	appendjwks                            json.RawMessage
+	jwks_uri                              *string
+	tls_client_auth_subject_dn            *string
+	dpop_bound_access_tokens              *bool
+	require_pushed_authorization_requests *bool
+	request_uris                          *[]string
+	appendrequest_uris                    []string
+	client_name                           *string
+	redirect_uris                         *[]string
+	appendredirect_uris                   []string
+	grant_types                           *[]string
+	appendgrant_types                     []string
+	response_types                        *[]string
+	appendresponse_types                  []string
+	registration_access_token_hash        *string
+	first_party                           *bool
+	logo_uri                              *string
+	post_logout_redirect_uris             *[]string
+	appendpost_logout_redirect_uris       []string
+	allowed_scopes                        *[]string
+	appendallowed_scopes                  []string
+	token_claims                          *[]string
+	appendtoken_claims                    []string
+	clearedFields                         map[string]struct{}
+	consents                              map[uuid.UUID]struct{}
+	removedconsents                       map[uuid.UUID]struct{}
+	clearedconsents                       bool
+	secrets                               map[uuid.UUID]struct{}
+	removedsecrets                        map[uuid.UUID]struct{}
+	clearedsecrets                        bool
+	done                                  bool
+	oldValue                              func(context.Context) (*Oauth2Client, error)
+	predicates                            []predicate.Oauth2Client
+}
+
+var _ ent.Mutation = (*Oauth2ClientMutation)(nil)
+
+// oauth2clientOption allows management of the mutation configuration using functional options.
+type oauth2clientOption func(*Oauth2ClientMutation)
+
+// newOauth2ClientMutation creates new mutation for the Oauth2Client entity.
+func newOauth2ClientMutation(c config, op Op, opts ...oauth2clientOption) *Oauth2ClientMutation {
+	m := &Oauth2ClientMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeOauth2Client,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withOauth2ClientID sets the ID field of the mutation.
+func withOauth2ClientID(id uuid.UUID) oauth2clientOption {
+	return func(m *Oauth2ClientMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Oauth2Client
+		)
+		m.oldValue = func(ctx context.Context) (*Oauth2Client, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Oauth2Client.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withOauth2Client sets the old Oauth2Client of the mutation.
+func withOauth2Client(node *Oauth2Client) oauth2clientOption {
+	return func(m *Oauth2ClientMutation) {
+		m.oldValue = func(context.Context) (*Oauth2Client, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m Oauth2ClientMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m Oauth2ClientMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Oauth2Client entities.
+func (m *Oauth2ClientMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *Oauth2ClientMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *Oauth2ClientMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Oauth2Client.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetSecret sets the "secret" field.
+func (m *Oauth2ClientMutation) SetSecret(s string) {
+	m.secret = &s
+}
+
+// Secret returns the value of the "secret" field in the mutation.
+func (m *Oauth2ClientMutation) Secret() (r string, exists bool) {
+	v := m.secret
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSecret returns the old "secret" field's value of the Oauth2Client entity.
+// If the Oauth2Client object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *Oauth2ClientMutation) OldSecret(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSecret is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSecret requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSecret: %w", err)
+	}
+	return oldValue.Secret, nil
+}
+
+// ClearSecret clears the value of the "secret" field.
+func (m *Oauth2ClientMutation) ClearSecret() {
+	m.secret = nil
+	m.clearedFields[oauth2client.FieldSecret] = struct{}{}
+}
+
+// SecretCleared returns if the "secret" field was cleared in this mutation.
+func (m *Oauth2ClientMutation) SecretCleared() bool {
+	_, ok := m.clearedFields[oauth2client.FieldSecret]
+	return ok
+}
+
+// ResetSecret resets all changes to the "secret" field.
+func (m *Oauth2ClientMutation) ResetSecret() {
+	m.secret = nil
+	delete(m.clearedFields, oauth2client.FieldSecret)
+}
+
+// SetDomain sets the "domain" field.
+func (m *Oauth2ClientMutation) SetDomain(s string) {
+	m.domain = &s
+}
+
+// Domain returns the value of the "domain" field in the mutation.
+func (m *Oauth2ClientMutation) Domain() (r string, exists bool) {
+	v := m.domain
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDomain returns the old "domain" field's value of the Oauth2Client entity.
+// If the Oauth2Client object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *Oauth2ClientMutation) OldDomain(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDomain is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDomain requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDomain: %w", err)
+	}
+	return oldValue.Domain, nil
+}
+
+// ResetDomain resets all changes to the "domain" field.
+func (m *Oauth2ClientMutation) ResetDomain() {
+	m.domain = nil
+}
+
+// SetRequirePkce sets the "require_pkce" field.
+func (m *Oauth2ClientMutation) SetRequirePkce(b bool) {
+	m.require_pkce = &b
+}
+
+// RequirePkce returns the value of the "require_pkce" field in the mutation.
+func (m *Oauth2ClientMutation) RequirePkce() (r bool, exists bool) {
+	v := m.require_pkce
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRequirePkce returns the old "require_pkce" field's value of the Oauth2Client entity.
+// If the Oauth2Client object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *Oauth2ClientMutation) OldRequirePkce(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRequirePkce is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRequirePkce requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRequirePkce: %w", err)
+	}
+	return oldValue.RequirePkce, nil
+}
+
+// ResetRequirePkce resets all changes to the "require_pkce" field.
+func (m *Oauth2ClientMutation) ResetRequirePkce() {
+	m.require_pkce = nil
+}
+
+// SetExchangeAudiences sets the "exchange_audiences" field.
+func (m *Oauth2ClientMutation) SetExchangeAudiences(s []string) {
+	m.exchange_audiences = &s
+	m.appendexchange_audiences = nil
+}
+
+// ExchangeAudiences returns the value of the "exchange_audiences" field in the mutation.
+func (m *Oauth2ClientMutation) ExchangeAudiences() (r []string, exists bool) {
+	v := m.exchange_audiences
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExchangeAudiences returns the old "exchange_audiences" field's value of the Oauth2Client entity.
+// If the Oauth2Client object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *Oauth2ClientMutation) OldExchangeAudiences(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExchangeAudiences is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExchangeAudiences requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExchangeAudiences: %w", err)
+	}
+	return oldValue.ExchangeAudiences, nil
+}
+
+// AppendExchangeAudiences adds s to the "exchange_audiences" field.
+func (m *Oauth2ClientMutation) AppendExchangeAudiences(s []string) {
+	m.appendexchange_audiences = append(m.appendexchange_audiences, s...)
+}
+
+// AppendedExchangeAudiences returns the list of values that were appended to the "exchange_audiences" field in this mutation.
+func (m *Oauth2ClientMutation) AppendedExchangeAudiences() ([]string, bool) {
+	if len(m.appendexchange_audiences) == 0 {
+		return nil, false
+	}
+	return m.appendexchange_audiences, true
+}
+
+// ClearExchangeAudiences clears the value of the "exchange_audiences" field.
+func (m *Oauth2ClientMutation) ClearExchangeAudiences() {
+	m.exchange_audiences = nil
+	m.appendexchange_audiences = nil
+	m.clearedFields[oauth2client.FieldExchangeAudiences] = struct{}{}
+}
+
+// ExchangeAudiencesCleared returns if the "exchange_audiences" field was cleared in this mutation.
+func (m *Oauth2ClientMutation) ExchangeAudiencesCleared() bool {
+	_, ok := m.clearedFields[oauth2client.FieldExchangeAudiences]
+	return ok
+}
+
+// ResetExchangeAudiences resets all changes to the "exchange_audiences" field.
+func (m *Oauth2ClientMutation) ResetExchangeAudiences() {
+	m.exchange_audiences = nil
+	m.appendexchange_audiences = nil
+	delete(m.clearedFields, oauth2client.FieldExchangeAudiences)
+}
+
+// SetExchangeScopes sets the "exchange_scopes" field.
+func (m *Oauth2ClientMutation) SetExchangeScopes(s []string) {
+	m.exchange_scopes = &s
+	m.appendexchange_scopes = nil
+}
+
+// ExchangeScopes returns the value of the "exchange_scopes" field in the mutation.
+func (m *Oauth2ClientMutation) ExchangeScopes() (r []string, exists bool) {
+	v := m.exchange_scopes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExchangeScopes returns the old "exchange_scopes" field's value of the Oauth2Client entity.
+// If the Oauth2Client object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *Oauth2ClientMutation) OldExchangeScopes(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExchangeScopes is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExchangeScopes requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExchangeScopes: %w", err)
+	}
+	return oldValue.ExchangeScopes, nil
+}
+
+// AppendExchangeScopes adds s to the "exchange_scopes" field.
+func (m *Oauth2ClientMutation) AppendExchangeScopes(s []string) {
+	m.appendexchange_scopes = append(m.appendexchange_scopes, s...)
+}
+
+// AppendedExchangeScopes returns the list of values that were appended to the "exchange_scopes" field in this mutation.
+func (m *Oauth2ClientMutation) AppendedExchangeScopes() ([]string, bool) {
+	if len(m.appendexchange_scopes) == 0 {
+		return nil, false
+	}
+	return m.appendexchange_scopes, true
+}
+
+// ClearExchangeScopes clears the value of the "exchange_scopes" field.
+func (m *Oauth2ClientMutation) ClearExchangeScopes() {
+	m.exchange_scopes = nil
+	m.appendexchange_scopes = nil
+	m.clearedFields[oauth2client.FieldExchangeScopes] = struct{}{}
+}
+
+// ExchangeScopesCleared returns if the "exchange_scopes" field was cleared in this mutation.
+func (m *Oauth2ClientMutation) ExchangeScopesCleared() bool {
+	_, ok := m.clearedFields[oauth2client.FieldExchangeScopes]
+	return ok
+}
+
+// ResetExchangeScopes resets all changes to the "exchange_scopes" field.
+func (m *Oauth2ClientMutation) ResetExchangeScopes() {
+	m.exchange_scopes = nil
+	m.appendexchange_scopes = nil
+	delete(m.clearedFields, oauth2client.FieldExchangeScopes)
+}
+
+// SetJwtBearerIssuers sets the "jwt_bearer_issuers" field.
+func (m *Oauth2ClientMutation) SetJwtBearerIssuers(mm map[string]json.RawMessage) {
+	m.jwt_bearer_issuers = &mm
+}
+
+// JwtBearerIssuers returns the value of the "jwt_bearer_issuers" field in the mutation.
+func (m *Oauth2ClientMutation) JwtBearerIssuers() (r map[string]json.RawMessage, exists bool) {
+	v := m.jwt_bearer_issuers
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldJwtBearerIssuers returns the old "jwt_bearer_issuers" field's value of the Oauth2Client entity.
+// If the Oauth2Client object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *Oauth2ClientMutation) OldJwtBearerIssuers(ctx context.Context) (v map[string]json.RawMessage, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldJwtBearerIssuers is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldJwtBearerIssuers requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldJwtBearerIssuers: %w", err)
+	}
+	return oldValue.JwtBearerIssuers, nil
+}
+
+// ClearJwtBearerIssuers clears the value of the "jwt_bearer_issuers" field.
+func (m *Oauth2ClientMutation) ClearJwtBearerIssuers() {
+	m.jwt_bearer_issuers = nil
+	m.clearedFields[oauth2client.FieldJwtBearerIssuers] = struct{}{}
+}
+
+// JwtBearerIssuersCleared returns if the "jwt_bearer_issuers" field was cleared in this mutation.
+func (m *Oauth2ClientMutation) JwtBearerIssuersCleared() bool {
+	_, ok := m.clearedFields[oauth2client.FieldJwtBearerIssuers]
+	return ok
+}
+
+// ResetJwtBearerIssuers resets all changes to the "jwt_bearer_issuers" field.
+func (m *Oauth2ClientMutation) ResetJwtBearerIssuers() {
+	m.jwt_bearer_issuers = nil
+	delete(m.clearedFields, oauth2client.FieldJwtBearerIssuers)
+}
+
+// SetTokenEndpointAuthMethod sets the "token_endpoint_auth_method" field.
+func (m *Oauth2ClientMutation) SetTokenEndpointAuthMethod(s string) {
+	m.token_endpoint_auth_method = &s
+}
+
+// TokenEndpointAuthMethod returns the value of the "token_endpoint_auth_method" field in the mutation.
+func (m *Oauth2ClientMutation) TokenEndpointAuthMethod() (r string, exists bool) {
+	v := m.token_endpoint_auth_method
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTokenEndpointAuthMethod returns the old "token_endpoint_auth_method" field's value of the Oauth2Client entity.
+// If the Oauth2Client object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *Oauth2ClientMutation) OldTokenEndpointAuthMethod(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTokenEndpointAuthMethod is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTokenEndpointAuthMethod requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTokenEndpointAuthMethod: %w", err)
+	}
+	return oldValue.TokenEndpointAuthMethod, nil
+}
+
+// ClearTokenEndpointAuthMethod clears the value of the "token_endpoint_auth_method" field.
+func (m *Oauth2ClientMutation) ClearTokenEndpointAuthMethod() {
+	m.token_endpoint_auth_method = nil
+	m.clearedFields[oauth2client.FieldTokenEndpointAuthMethod] = struct{}{}
+}
+
+// TokenEndpointAuthMethodCleared returns if the "token_endpoint_auth_method" field was cleared in this mutation.
+func (m *Oauth2ClientMutation) TokenEndpointAuthMethodCleared() bool {
+	_, ok := m.clearedFields[oauth2client.FieldTokenEndpointAuthMethod]
+	return ok
+}
+
+// ResetTokenEndpointAuthMethod resets all changes to the "token_endpoint_auth_method" field.
+func (m *Oauth2ClientMutation) ResetTokenEndpointAuthMethod() {
+	m.token_endpoint_auth_method = nil
+	delete(m.clearedFields, oauth2client.FieldTokenEndpointAuthMethod)
+}
+
+// SetJwks sets the "jwks" field.
+func (m *Oauth2ClientMutation) SetJwks(jm json.RawMessage) {
+	m.jwks = &jm
+	m.appendjwks = nil
+}
+
+// Jwks returns the value of the "jwks" field in the mutation.
+func (m *Oauth2ClientMutation) Jwks() (r json.RawMessage, exists bool) {
+	v := m.jwks
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldJwks returns the old "jwks" field's value of the Oauth2Client entity.
+// If the Oauth2Client object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *Oauth2ClientMutation) OldJwks(ctx context.Context) (v json.RawMessage, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldJwks is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldJwks requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldJwks: %w", err)
+	}
+	return oldValue.Jwks, nil
+}
+
+// AppendJwks adds jm to the "jwks" field.
+func (m *Oauth2ClientMutation) AppendJwks(jm json.RawMessage) {
+	m.appendjwks = append(m.appendjwks, jm...)
+}
+
+// AppendedJwks returns the list of values that were appended to the "jwks" field in this mutation.
+func (m *Oauth2ClientMutation) AppendedJwks() (json.RawMessage, bool) {
+	if len(m.appendjwks) == 0 {
+		return nil, false
+	}
+	return m.appendjwks, true
+}
+
+// ClearJwks clears the value of the "jwks" field.
+func (m *Oauth2ClientMutation) ClearJwks() {
+	m.jwks = nil
+	m.appendjwks = nil
+	m.clearedFields[oauth2client.FieldJwks] = struct{}{}
+}
+
+// JwksCleared returns if the "jwks" field was cleared in this mutation.
+func (m *Oauth2ClientMutation) JwksCleared() bool {
+	_, ok := m.clearedFields[oauth2client.FieldJwks]
+	return ok
+}
+
+// ResetJwks resets all changes to the "jwks" field.
+func (m *Oauth2ClientMutation) ResetJwks() {
+	m.jwks = nil
+	m.appendjwks = nil
+	delete(m.clearedFields, oauth2client.FieldJwks)
+}
+
+// SetJwksURI sets the "jwks_uri" field.
+func (m *Oauth2ClientMutation) SetJwksURI(s string) {
+	m.jwks_uri = &s
+}
+
+// JwksURI returns the value of the "jwks_uri" field in the mutation.
+func (m *Oauth2ClientMutation) JwksURI() (r string, exists bool) {
+	v := m.jwks_uri
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldJwksURI returns the old "jwks_uri" field's value of the Oauth2Client entity.
+// If the Oauth2Client object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *Oauth2ClientMutation) OldJwksURI(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldJwksURI is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldJwksURI requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldJwksURI: %w", err)
+	}
+	return oldValue.JwksURI, nil
+}
+
+// ClearJwksURI clears the value of the "jwks_uri" field.
+func (m *Oauth2ClientMutation) ClearJwksURI() {
+	m.jwks_uri = nil
+	m.clearedFields[oauth2client.FieldJwksURI] = struct{}{}
+}
+
+// JwksURICleared returns if the "jwks_uri" field was cleared in this mutation.
+func (m *Oauth2ClientMutation) JwksURICleared() bool {
+	_, ok := m.clearedFields[oauth2client.FieldJwksURI]
+	return ok
+}
+
+// ResetJwksURI resets all changes to the "jwks_uri" field.
+func (m *Oauth2ClientMutation) ResetJwksURI() {
+	m.jwks_uri = nil
+	delete(m.clearedFields, oauth2client.FieldJwksURI)
+}
+
+// SetTLSClientAuthSubjectDn sets the "tls_client_auth_subject_dn" field.
+func (m *Oauth2ClientMutation) SetTLSClientAuthSubjectDn(s string) {
+	m.tls_client_auth_subject_dn = &s
+}
+
+// TLSClientAuthSubjectDn returns the value of the "tls_client_auth_subject_dn" field in the mutation.
+func (m *Oauth2ClientMutation) TLSClientAuthSubjectDn() (r string, exists bool) {
+	v := m.tls_client_auth_subject_dn
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTLSClientAuthSubjectDn returns the old "tls_client_auth_subject_dn" field's value of the Oauth2Client entity.
+// If the Oauth2Client object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *Oauth2ClientMutation) OldTLSClientAuthSubjectDn(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTLSClientAuthSubjectDn is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTLSClientAuthSubjectDn requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTLSClientAuthSubjectDn: %w", err)
+	}
+	return oldValue.TLSClientAuthSubjectDn, nil
+}
+
+// ClearTLSClientAuthSubjectDn clears the value of the "tls_client_auth_subject_dn" field.
+func (m *Oauth2ClientMutation) ClearTLSClientAuthSubjectDn() {
+	m.tls_client_auth_subject_dn = nil
+	m.clearedFields[oauth2client.FieldTLSClientAuthSubjectDn] = struct{}{}
+}
+
+// TLSClientAuthSubjectDnCleared returns if the "tls_client_auth_subject_dn" field was cleared in this mutation.
+func (m *Oauth2ClientMutation) TLSClientAuthSubjectDnCleared() bool {
+	_, ok := m.clearedFields[oauth2client.FieldTLSClientAuthSubjectDn]
+	return ok
+}
+
+// ResetTLSClientAuthSubjectDn resets all changes to the "tls_client_auth_subject_dn" field.
+func (m *Oauth2ClientMutation) ResetTLSClientAuthSubjectDn() {
+	m.tls_client_auth_subject_dn = nil
+	delete(m.clearedFields, oauth2client.FieldTLSClientAuthSubjectDn)
+}
+
+// SetDpopBoundAccessTokens sets the "dpop_bound_access_tokens" field.
+func (m *Oauth2ClientMutation) SetDpopBoundAccessTokens(b bool) {
+	m.dpop_bound_access_tokens = &b
+}
+
+// DpopBoundAccessTokens returns the value of the "dpop_bound_access_tokens" field in the mutation.
+func (m *Oauth2ClientMutation) DpopBoundAccessTokens() (r bool, exists bool) {
+	v := m.dpop_bound_access_tokens
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDpopBoundAccessTokens returns the old "dpop_bound_access_tokens" field's value of the Oauth2Client entity.
+// If the Oauth2Client object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *Oauth2ClientMutation) OldDpopBoundAccessTokens(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDpopBoundAccessTokens is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDpopBoundAccessTokens requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDpopBoundAccessTokens: %w", err)
+	}
+	return oldValue.DpopBoundAccessTokens, nil
+}
+
+// ResetDpopBoundAccessTokens resets all changes to the "dpop_bound_access_tokens" field.
+func (m *Oauth2ClientMutation) ResetDpopBoundAccessTokens() {
+	m.dpop_bound_access_tokens = nil
+}
+
+// SetRequirePushedAuthorizationRequests sets the "require_pushed_authorization_requests" field.
+func (m *Oauth2ClientMutation) SetRequirePushedAuthorizationRequests(b bool) {
+	m.require_pushed_authorization_requests = &b
+}
+
+// RequirePushedAuthorizationRequests returns the value of the "require_pushed_authorization_requests" field in the mutation.
+func (m *Oauth2ClientMutation) RequirePushedAuthorizationRequests() (r bool, exists bool) {
+	v := m.require_pushed_authorization_requests
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRequirePushedAuthorizationRequests returns the old "require_pushed_authorization_requests" field's value of the Oauth2Client entity.
+// If the Oauth2Client object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *Oauth2ClientMutation) OldRequirePushedAuthorizationRequests(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRequirePushedAuthorizationRequests is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRequirePushedAuthorizationRequests requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRequirePushedAuthorizationRequests: %w", err)
+	}
+	return oldValue.RequirePushedAuthorizationRequests, nil
+}
+
+// ResetRequirePushedAuthorizationRequests resets all changes to the "require_pushed_authorization_requests" field.
+func (m *Oauth2ClientMutation) ResetRequirePushedAuthorizationRequests() {
+	m.require_pushed_authorization_requests = nil
+}
+
+// SetRequestUris sets the "request_uris" field.
+func (m *Oauth2ClientMutation) SetRequestUris(s []string) {
+	m.request_uris = &s
+	m.appendrequest_uris = nil
+}
+
+// RequestUris returns the value of the "request_uris" field in the mutation.
+func (m *Oauth2ClientMutation) RequestUris() (r []string, exists bool) {
+	v := m.request_uris
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRequestUris returns the old "request_uris" field's value of the Oauth2Client entity.
+// If the Oauth2Client object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *Oauth2ClientMutation) OldRequestUris(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRequestUris is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRequestUris requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRequestUris: %w", err)
+	}
+	return oldValue.RequestUris, nil
+}
+
+// AppendRequestUris adds s to the "request_uris" field.
+func (m *Oauth2ClientMutation) AppendRequestUris(s []string) {
+	m.appendrequest_uris = append(m.appendrequest_uris, s...)
+}
+
+// AppendedRequestUris returns the list of values that were appended to the "request_uris" field in this mutation.
+func (m *Oauth2ClientMutation) AppendedRequestUris() ([]string, bool) {
+	if len(m.appendrequest_uris) == 0 {
+		return nil, false
+	}
+	return m.appendrequest_uris, true
+}
+
+// ClearRequestUris clears the value of the "request_uris" field.
+func (m *Oauth2ClientMutation) ClearRequestUris() {
+	m.request_uris = nil
+	m.appendrequest_uris = nil
+	m.clearedFields[oauth2client.FieldRequestUris] = struct{}{}
+}
+
+// RequestUrisCleared returns if the "request_uris" field was cleared in this mutation.
+func (m *Oauth2ClientMutation) RequestUrisCleared() bool {
+	_, ok := m.clearedFields[oauth2client.FieldRequestUris]
+	return ok
+}
+
+// ResetRequestUris resets all changes to the "request_uris" field.
+func (m *Oauth2ClientMutation) ResetRequestUris() {
+	m.request_uris = nil
+	m.appendrequest_uris = nil
+	delete(m.clearedFields, oauth2client.FieldRequestUris)
+}
+
+// SetClientName sets the "client_name" field.
+func (m *Oauth2ClientMutation) SetClientName(s string) {
+	m.client_name = &s
+}
+
+// ClientName returns the value of the "client_name" field in the mutation.
+func (m *Oauth2ClientMutation) ClientName() (r string, exists bool) {
+	v := m.client_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldClientName returns the old "client_name" field's value of the Oauth2Client entity.
+// If the Oauth2Client object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *Oauth2ClientMutation) OldClientName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldClientName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldClientName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldClientName: %w", err)
+	}
+	return oldValue.ClientName, nil
+}
+
+// ClearClientName clears the value of the "client_name" field.
+func (m *Oauth2ClientMutation) ClearClientName() {
+	m.client_name = nil
+	m.clearedFields[oauth2client.FieldClientName] = struct{}{}
+}
+
+// ClientNameCleared returns if the "client_name" field was cleared in this mutation.
+func (m *Oauth2ClientMutation) ClientNameCleared() bool {
+	_, ok := m.clearedFields[oauth2client.FieldClientName]
+	return ok
+}
+
+// ResetClientName resets all changes to the "client_name" field.
+func (m *Oauth2ClientMutation) ResetClientName() {
+	m.client_name = nil
+	delete(m.clearedFields, oauth2client.FieldClientName)
+}
+
+// SetRedirectUris sets the "redirect_uris" field.
+func (m *Oauth2ClientMutation) SetRedirectUris(s []string) {
+	m.redirect_uris = &s
+	m.appendredirect_uris = nil
+}
+
+// RedirectUris returns the value of the "redirect_uris" field in the mutation.
+func (m *Oauth2ClientMutation) RedirectUris() (r []string, exists bool) {
+	v := m.redirect_uris
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRedirectUris returns the old "redirect_uris" field's value of the Oauth2Client entity.
+// If the Oauth2Client object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *Oauth2ClientMutation) OldRedirectUris(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRedirectUris is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRedirectUris requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRedirectUris: %w", err)
+	}
+	return oldValue.RedirectUris, nil
+}
+
+// AppendRedirectUris adds s to the "redirect_uris" field.
+func (m *Oauth2ClientMutation) AppendRedirectUris(s []string) {
+	m.appendredirect_uris = append(m.appendredirect_uris, s...)
+}
+
+// AppendedRedirectUris returns the list of values that were appended to the "redirect_uris" field in this mutation.
+func (m *Oauth2ClientMutation) AppendedRedirectUris() ([]string, bool) {
+	if len(m.appendredirect_uris) == 0 {
+		return nil, false
+	}
+	return m.appendredirect_uris, true
+}
+
+// ClearRedirectUris clears the value of the "redirect_uris" field.
+func (m *Oauth2ClientMutation) ClearRedirectUris() {
+	m.redirect_uris = nil
+	m.appendredirect_uris = nil
+	m.clearedFields[oauth2client.FieldRedirectUris] = struct{}{}
+}
+
+// RedirectUrisCleared returns if the "redirect_uris" field was cleared in this mutation.
+func (m *Oauth2ClientMutation) RedirectUrisCleared() bool {
+	_, ok := m.clearedFields[oauth2client.FieldRedirectUris]
+	return ok
+}
+
+// ResetRedirectUris resets all changes to the "redirect_uris" field.
+func (m *Oauth2ClientMutation) ResetRedirectUris() {
+	m.redirect_uris = nil
+	m.appendredirect_uris = nil
+	delete(m.clearedFields, oauth2client.FieldRedirectUris)
+}
+
+// SetGrantTypes sets the "grant_types" field.
+func (m *Oauth2ClientMutation) SetGrantTypes(s []string) {
+	m.grant_types = &s
+	m.appendgrant_types = nil
+}
+
+// GrantTypes returns the value of the "grant_types" field in the mutation.
+func (m *Oauth2ClientMutation) GrantTypes() (r []string, exists bool) {
+	v := m.grant_types
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGrantTypes returns the old "grant_types" field's value of the Oauth2Client entity.
+// If the Oauth2Client object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *Oauth2ClientMutation) OldGrantTypes(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGrantTypes is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGrantTypes requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGrantTypes: %w", err)
+	}
+	return oldValue.GrantTypes, nil
+}
+
+// AppendGrantTypes adds s to the "grant_types" field.
+func (m *Oauth2ClientMutation) AppendGrantTypes(s []string) {
+	m.appendgrant_types = append(m.appendgrant_types, s...)
+}
+
+// AppendedGrantTypes returns the list of values that were appended to the "grant_types" field in this mutation.
+func (m *Oauth2ClientMutation) AppendedGrantTypes() ([]string, bool) {
+	if len(m.appendgrant_types) == 0 {
+		return nil, false
+	}
+	return m.appendgrant_types, true
+}
+
+// ClearGrantTypes clears the value of the "grant_types" field.
+func (m *Oauth2ClientMutation) ClearGrantTypes() {
+	m.grant_types = nil
+	m.appendgrant_types = nil
+	m.clearedFields[oauth2client.FieldGrantTypes] = struct{}{}
+}
+
+// GrantTypesCleared returns if the "grant_types" field was cleared in this mutation.
+func (m *Oauth2ClientMutation) GrantTypesCleared() bool {
+	_, ok := m.clearedFields[oauth2client.FieldGrantTypes]
+	return ok
+}
+
+// ResetGrantTypes resets all changes to the "grant_types" field.
+func (m *Oauth2ClientMutation) ResetGrantTypes() {
+	m.grant_types = nil
+	m.appendgrant_types = nil
+	delete(m.clearedFields, oauth2client.FieldGrantTypes)
+}
+
+// SetResponseTypes sets the "response_types" field.
+func (m *Oauth2ClientMutation) SetResponseTypes(s []string) {
+	m.response_types = &s
+	m.appendresponse_types = nil
+}
+
+// ResponseTypes returns the value of the "response_types" field in the mutation.
+func (m *Oauth2ClientMutation) ResponseTypes() (r []string, exists bool) {
+	v := m.response_types
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldResponseTypes returns the old "response_types" field's value of the Oauth2Client entity.
+// If the Oauth2Client object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *Oauth2ClientMutation) OldResponseTypes(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldResponseTypes is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldResponseTypes requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldResponseTypes: %w", err)
+	}
+	return oldValue.ResponseTypes, nil
+}
+
+// AppendResponseTypes adds s to the "response_types" field.
+func (m *Oauth2ClientMutation) AppendResponseTypes(s []string) {
+	m.appendresponse_types = append(m.appendresponse_types, s...)
+}
+
+// AppendedResponseTypes returns the list of values that were appended to the "response_types" field in this mutation.
+func (m *Oauth2ClientMutation) AppendedResponseTypes() ([]string, bool) {
+	if len(m.appendresponse_types) == 0 {
+		return nil, false
+	}
+	return m.appendresponse_types, true
+}
+
+// ClearResponseTypes clears the value of the "response_types" field.
+func (m *Oauth2ClientMutation) ClearResponseTypes() {
+	m.response_types = nil
+	m.appendresponse_types = nil
+	m.clearedFields[oauth2client.FieldResponseTypes] = struct{}{}
+}
+
+// ResponseTypesCleared returns if the "response_types" field was cleared in this mutation.
+func (m *Oauth2ClientMutation) ResponseTypesCleared() bool {
+	_, ok := m.clearedFields[oauth2client.FieldResponseTypes]
+	return ok
+}
+
+// ResetResponseTypes resets all changes to the "response_types" field.
+func (m *Oauth2ClientMutation) ResetResponseTypes() {
+	m.response_types = nil
+	m.appendresponse_types = nil
+	delete(m.clearedFields, oauth2client.FieldResponseTypes)
+}
+
+// SetRegistrationAccessTokenHash sets the "registration_access_token_hash" field.
+func (m *Oauth2ClientMutation) SetRegistrationAccessTokenHash(s string) {
+	m.registration_access_token_hash = &s
+}
+
+// RegistrationAccessTokenHash returns the value of the "registration_access_token_hash" field in the mutation.
+func (m *Oauth2ClientMutation) RegistrationAccessTokenHash() (r string, exists bool) {
+	v := m.registration_access_token_hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRegistrationAccessTokenHash returns the old "registration_access_token_hash" field's value of the Oauth2Client entity.
+// If the Oauth2Client object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *Oauth2ClientMutation) OldRegistrationAccessTokenHash(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRegistrationAccessTokenHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRegistrationAccessTokenHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRegistrationAccessTokenHash: %w", err)
+	}
+	return oldValue.RegistrationAccessTokenHash, nil
+}
+
+// ClearRegistrationAccessTokenHash clears the value of the "registration_access_token_hash" field.
+func (m *Oauth2ClientMutation) ClearRegistrationAccessTokenHash() {
+	m.registration_access_token_hash = nil
+	m.clearedFields[oauth2client.FieldRegistrationAccessTokenHash] = struct{}{}
+}
+
+// RegistrationAccessTokenHashCleared returns if the "registration_access_token_hash" field was cleared in this mutation.
+func (m *Oauth2ClientMutation) RegistrationAccessTokenHashCleared() bool {
+	_, ok := m.clearedFields[oauth2client.FieldRegistrationAccessTokenHash]
+	return ok
+}
+
+// ResetRegistrationAccessTokenHash resets all changes to the "registration_access_token_hash" field.
+func (m *Oauth2ClientMutation) ResetRegistrationAccessTokenHash() {
+	m.registration_access_token_hash = nil
+	delete(m.clearedFields, oauth2client.FieldRegistrationAccessTokenHash)
+}
+
+// SetFirstParty sets the "first_party" field.
+func (m *Oauth2ClientMutation) SetFirstParty(b bool) {
+	m.first_party = &b
+}
+
+// FirstParty returns the value of the "first_party" field in the mutation.
+func (m *Oauth2ClientMutation) FirstParty() (r bool, exists bool) {
+	v := m.first_party
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFirstParty returns the old "first_party" field's value of the Oauth2Client entity.
+// If the Oauth2Client object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *Oauth2ClientMutation) OldFirstParty(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFirstParty is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFirstParty requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFirstParty: %w", err)
+	}
+	return oldValue.FirstParty, nil
+}
+
+// ResetFirstParty resets all changes to the "first_party" field.
+func (m *Oauth2ClientMutation) ResetFirstParty() {
+	m.first_party = nil
+}
+
+// SetLogoURI sets the "logo_uri" field.
+func (m *Oauth2ClientMutation) SetLogoURI(s string) {
+	m.logo_uri = &s
+}
+
+// LogoURI returns the value of the "logo_uri" field in the mutation.
+func (m *Oauth2ClientMutation) LogoURI() (r string, exists bool) {
+	v := m.logo_uri
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLogoURI returns the old "logo_uri" field's value of the Oauth2Client entity.
+// If the Oauth2Client object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *Oauth2ClientMutation) OldLogoURI(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLogoURI is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLogoURI requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLogoURI: %w", err)
+	}
+	return oldValue.LogoURI, nil
+}
+
+// ClearLogoURI clears the value of the "logo_uri" field.
+func (m *Oauth2ClientMutation) ClearLogoURI() {
+	m.logo_uri = nil
+	m.clearedFields[oauth2client.FieldLogoURI] = struct{}{}
+}
+
+// LogoURICleared returns if the "logo_uri" field was cleared in this mutation.
+func (m *Oauth2ClientMutation) LogoURICleared() bool {
+	_, ok := m.clearedFields[oauth2client.FieldLogoURI]
+	return ok
+}
+
+// ResetLogoURI resets all changes to the "logo_uri" field.
+func (m *Oauth2ClientMutation) ResetLogoURI() {
+	m.logo_uri = nil
+	delete(m.clearedFields, oauth2client.FieldLogoURI)
+}
+
+// SetPostLogoutRedirectUris sets the "post_logout_redirect_uris" field.
+func (m *Oauth2ClientMutation) SetPostLogoutRedirectUris(s []string) {
+	m.post_logout_redirect_uris = &s
+	m.appendpost_logout_redirect_uris = nil
+}
+
+// PostLogoutRedirectUris returns the value of the "post_logout_redirect_uris" field in the mutation.
+func (m *Oauth2ClientMutation) PostLogoutRedirectUris() (r []string, exists bool) {
+	v := m.post_logout_redirect_uris
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPostLogoutRedirectUris returns the old "post_logout_redirect_uris" field's value of the Oauth2Client entity.
+// If the Oauth2Client object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *Oauth2ClientMutation) OldPostLogoutRedirectUris(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPostLogoutRedirectUris is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPostLogoutRedirectUris requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPostLogoutRedirectUris: %w", err)
+	}
+	return oldValue.PostLogoutRedirectUris, nil
+}
+
+// AppendPostLogoutRedirectUris adds s to the "post_logout_redirect_uris" field.
+func (m *Oauth2ClientMutation) AppendPostLogoutRedirectUris(s []string) {
+	m.appendpost_logout_redirect_uris = append(m.appendpost_logout_redirect_uris, s...)
+}
+
+// AppendedPostLogoutRedirectUris returns the list of values that were appended to the "post_logout_redirect_uris" field in this mutation.
+func (m *Oauth2ClientMutation) AppendedPostLogoutRedirectUris() ([]string, bool) {
+	if len(m.appendpost_logout_redirect_uris) == 0 {
+		return nil, false
+	}
+	return m.appendpost_logout_redirect_uris, true
+}
+
+// ClearPostLogoutRedirectUris clears the value of the "post_logout_redirect_uris" field.
+func (m *Oauth2ClientMutation) ClearPostLogoutRedirectUris() {
+	m.post_logout_redirect_uris = nil
+	m.appendpost_logout_redirect_uris = nil
+	m.clearedFields[oauth2client.FieldPostLogoutRedirectUris] = struct{}{}
+}
+
+// PostLogoutRedirectUrisCleared returns if the "post_logout_redirect_uris" field was cleared in this mutation.
+func (m *Oauth2ClientMutation) PostLogoutRedirectUrisCleared() bool {
+	_, ok := m.clearedFields[oauth2client.FieldPostLogoutRedirectUris]
+	return ok
+}
+
+// ResetPostLogoutRedirectUris resets all changes to the "post_logout_redirect_uris" field.
+func (m *Oauth2ClientMutation) ResetPostLogoutRedirectUris() {
+	m.post_logout_redirect_uris = nil
+	m.appendpost_logout_redirect_uris = nil
+	delete(m.clearedFields, oauth2client.FieldPostLogoutRedirectUris)
+}
+
+// SetAllowedScopes sets the "allowed_scopes" field.
+func (m *Oauth2ClientMutation) SetAllowedScopes(s []string) {
+	m.allowed_scopes = &s
+	m.appendallowed_scopes = nil
+}
+
+// AllowedScopes returns the value of the "allowed_scopes" field in the mutation.
+func (m *Oauth2ClientMutation) AllowedScopes() (r []string, exists bool) {
+	v := m.allowed_scopes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAllowedScopes returns the old "allowed_scopes" field's value of the Oauth2Client entity.
+// If the Oauth2Client object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *Oauth2ClientMutation) OldAllowedScopes(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAllowedScopes is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAllowedScopes requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAllowedScopes: %w", err)
+	}
+	return oldValue.AllowedScopes, nil
+}
+
+// AppendAllowedScopes adds s to the "allowed_scopes" field.
+func (m *Oauth2ClientMutation) AppendAllowedScopes(s []string) {
+	m.appendallowed_scopes = append(m.appendallowed_scopes, s...)
+}
+
+// AppendedAllowedScopes returns the list of values that were appended to the "allowed_scopes" field in this mutation.
+func (m *Oauth2ClientMutation) AppendedAllowedScopes() ([]string, bool) {
+	if len(m.appendallowed_scopes) == 0 {
+		return nil, false
+	}
+	return m.appendallowed_scopes, true
+}
+
+// ClearAllowedScopes clears the value of the "allowed_scopes" field.
+func (m *Oauth2ClientMutation) ClearAllowedScopes() {
+	m.allowed_scopes = nil
+	m.appendallowed_scopes = nil
+	m.clearedFields[oauth2client.FieldAllowedScopes] = struct{}{}
+}
+
+// AllowedScopesCleared returns if the "allowed_scopes" field was cleared in this mutation.
+func (m *Oauth2ClientMutation) AllowedScopesCleared() bool {
+	_, ok := m.clearedFields[oauth2client.FieldAllowedScopes]
+	return ok
+}
+
+// ResetAllowedScopes resets all changes to the "allowed_scopes" field.
+func (m *Oauth2ClientMutation) ResetAllowedScopes() {
+	m.allowed_scopes = nil
+	m.appendallowed_scopes = nil
+	delete(m.clearedFields, oauth2client.FieldAllowedScopes)
+}
+
+// SetTokenClaims sets the "token_claims" field.
+func (m *Oauth2ClientMutation) SetTokenClaims(s []string) {
+	m.token_claims = &s
+	m.appendtoken_claims = nil
+}
+
+// TokenClaims returns the value of the "token_claims" field in the mutation.
+func (m *Oauth2ClientMutation) TokenClaims() (r []string, exists bool) {
+	v := m.token_claims
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTokenClaims returns the old "token_claims" field's value of the Oauth2Client entity.
+// If the Oauth2Client object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *Oauth2ClientMutation) OldTokenClaims(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTokenClaims is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTokenClaims requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTokenClaims: %w", err)
+	}
+	return oldValue.TokenClaims, nil
+}
+
+// AppendTokenClaims adds s to the "token_claims" field.
+func (m *Oauth2ClientMutation) AppendTokenClaims(s []string) {
+	m.appendtoken_claims = append(m.appendtoken_claims, s...)
+}
+
+// AppendedTokenClaims returns the list of values that were appended to the "token_claims" field in this mutation.
+func (m *Oauth2ClientMutation) AppendedTokenClaims() ([]string, bool) {
+	if len(m.appendtoken_claims) == 0 {
+		return nil, false
+	}
+	return m.appendtoken_claims, true
+}
+
+// ClearTokenClaims clears the value of the "token_claims" field.
+func (m *Oauth2ClientMutation) ClearTokenClaims() {
+	m.token_claims = nil
+	m.appendtoken_claims = nil
+	m.clearedFields[oauth2client.FieldTokenClaims] = struct{}{}
+}
+
+// TokenClaimsCleared returns if the "token_claims" field was cleared in this mutation.
+func (m *Oauth2ClientMutation) TokenClaimsCleared() bool {
+	_, ok := m.clearedFields[oauth2client.FieldTokenClaims]
+	return ok
+}
+
+// ResetTokenClaims resets all changes to the "token_claims" field.
+func (m *Oauth2ClientMutation) ResetTokenClaims() {
+	m.token_claims = nil
+	m.appendtoken_claims = nil
+	delete(m.clearedFields, oauth2client.FieldTokenClaims)
+}
+
+// AddConsentIDs adds the "consents" edge to the Consent entity by ids.
+func (m *Oauth2ClientMutation) AddConsentIDs(ids ...uuid.UUID) {
+	if m.consents == nil {
+		m.consents = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.consents[ids[i]] = struct{}{}
+	}
+}
+
+// ClearConsents clears the "consents" edge to the Consent entity.
+func (m *Oauth2ClientMutation) ClearConsents() {
+	m.clearedconsents = true
+}
+
+// ConsentsCleared reports if the "consents" edge to the Consent entity was cleared.
+func (m *Oauth2ClientMutation) ConsentsCleared() bool {
+	return m.clearedconsents
+}
+
+// RemoveConsentIDs removes the "consents" edge to the Consent entity by IDs.
+func (m *Oauth2ClientMutation) RemoveConsentIDs(ids ...uuid.UUID) {
+	if m.removedconsents == nil {
+		m.removedconsents = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.consents, ids[i])
+		m.removedconsents[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedConsents returns the removed IDs of the "consents" edge to the Consent entity.
+func (m *Oauth2ClientMutation) RemovedConsentsIDs() (ids []uuid.UUID) {
+	for id := range m.removedconsents {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ConsentsIDs returns the "consents" edge IDs in the mutation.
+func (m *Oauth2ClientMutation) ConsentsIDs() (ids []uuid.UUID) {
+	for id := range m.consents {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetConsents resets all changes to the "consents" edge.
+func (m *Oauth2ClientMutation) ResetConsents() {
+	m.consents = nil
+	m.clearedconsents = false
+	m.removedconsents = nil
+}
+
+// AddSecretIDs adds the "secrets" edge to the ClientSecret entity by ids.
+func (m *Oauth2ClientMutation) AddSecretIDs(ids ...uuid.UUID) {
+	if m.secrets == nil {
+		m.secrets = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.secrets[ids[i]] = struct{}{}
+	}
+}
+
+// ClearSecrets clears the "secrets" edge to the ClientSecret entity.
+func (m *Oauth2ClientMutation) ClearSecrets() {
+	m.clearedsecrets = true
+}
+
+// SecretsCleared reports if the "secrets" edge to the ClientSecret entity was cleared.
+func (m *Oauth2ClientMutation) SecretsCleared() bool {
+	return m.clearedsecrets
+}
+
+// RemoveSecretIDs removes the "secrets" edge to the ClientSecret entity by IDs.
+func (m *Oauth2ClientMutation) RemoveSecretIDs(ids ...uuid.UUID) {
+	if m.removedsecrets == nil {
+		m.removedsecrets = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.secrets, ids[i])
+		m.removedsecrets[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedSecrets returns the removed IDs of the "secrets" edge to the ClientSecret entity.
+func (m *Oauth2ClientMutation) RemovedSecretsIDs() (ids []uuid.UUID) {
+	for id := range m.removedsecrets {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// SecretsIDs returns the "secrets" edge IDs in the mutation.
+func (m *Oauth2ClientMutation) SecretsIDs() (ids []uuid.UUID) {
+	for id := range m.secrets {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetSecrets resets all changes to the "secrets" edge.
+func (m *Oauth2ClientMutation) ResetSecrets() {
+	m.secrets = nil
+	m.clearedsecrets = false
+	m.removedsecrets = nil
+}
+
+// Where appends a list predicates to the Oauth2ClientMutation builder.
+func (m *Oauth2ClientMutation) Where(ps ...predicate.Oauth2Client) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the Oauth2ClientMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *Oauth2ClientMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Oauth2Client, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *Oauth2ClientMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *Oauth2ClientMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Oauth2Client).
+func (m *Oauth2ClientMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *Oauth2ClientMutation) Fields() []string {
+	fields := make([]string, 0, 23)
+	if m.secret != nil {
+		fields = append(fields, oauth2client.FieldSecret)
+	}
+	if m.domain != nil {
+		fields = append(fields, oauth2client.FieldDomain)
+	}
+	if m.require_pkce != nil {
+		fields = append(fields, oauth2client.FieldRequirePkce)
+	}
+	if m.exchange_audiences != nil {
+		fields = append(fields, oauth2client.FieldExchangeAudiences)
+	}
+	if m.exchange_scopes != nil {
+		fields = append(fields, oauth2client.FieldExchangeScopes)
+	}
+	if m.jwt_bearer_issuers != nil {
+		fields = append(fields, oauth2client.FieldJwtBearerIssuers)
+	}
+	if m.token_endpoint_auth_method != nil {
+		fields = append(fields, oauth2client.FieldTokenEndpointAuthMethod)
+	}
+	if m.jwks != nil {
+		fields = append(fields, oauth2client.FieldJwks)
+	}
+	if m.jwks_uri != nil {
+		fields = append(fields, oauth2client.FieldJwksURI)
+	}
+	if m.tls_client_auth_subject_dn != nil {
+		fields = append(fields, oauth2client.FieldTLSClientAuthSubjectDn)
+	}
+	if m.dpop_bound_access_tokens != nil {
+		fields = append(fields, oauth2client.FieldDpopBoundAccessTokens)
+	}
+	if m.require_pushed_authorization_requests != nil {
+		fields = append(fields, oauth2client.FieldRequirePushedAuthorizationRequests)
+	}
+	if m.request_uris != nil {
+		fields = append(fields, oauth2client.FieldRequestUris)
+	}
+	if m.client_name != nil {
+		fields = append(fields, oauth2client.FieldClientName)
+	}
+	if m.redirect_uris != nil {
+		fields = append(fields, oauth2client.FieldRedirectUris)
+	}
+	if m.grant_types != nil {
+		fields = append(fields, oauth2client.FieldGrantTypes)
+	}
+	if m.response_types != nil {
+		fields = append(fields, oauth2client.FieldResponseTypes)
+	}
+	if m.registration_access_token_hash != nil {
+		fields = append(fields, oauth2client.FieldRegistrationAccessTokenHash)
+	}
+	if m.first_party != nil {
+		fields = append(fields, oauth2client.FieldFirstParty)
+	}
+	if m.logo_uri != nil {
+		fields = append(fields, oauth2client.FieldLogoURI)
+	}
+	if m.post_logout_redirect_uris != nil {
+		fields = append(fields, oauth2client.FieldPostLogoutRedirectUris)
+	}
+	if m.allowed_scopes != nil {
+		fields = append(fields, oauth2client.FieldAllowedScopes)
+	}
+	if m.token_claims != nil {
+		fields = append(fields, oauth2client.FieldTokenClaims)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *Oauth2ClientMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case oauth2client.FieldSecret:
+		return m.Secret()
+	case oauth2client.FieldDomain:
+		return m.Domain()
+	case oauth2client.FieldRequirePkce:
+		return m.RequirePkce()
+	case oauth2client.FieldExchangeAudiences:
+		return m.ExchangeAudiences()
+	case oauth2client.FieldExchangeScopes:
+		return m.ExchangeScopes()
+	case oauth2client.FieldJwtBearerIssuers:
+		return m.JwtBearerIssuers()
+	case oauth2client.FieldTokenEndpointAuthMethod:
+		return m.TokenEndpointAuthMethod()
+	case oauth2client.FieldJwks:
+		return m.Jwks()
+	case oauth2client.FieldJwksURI:
+		return m.JwksURI()
+	case oauth2client.FieldTLSClientAuthSubjectDn:
+		return m.TLSClientAuthSubjectDn()
+	case oauth2client.FieldDpopBoundAccessTokens:
+		return m.DpopBoundAccessTokens()
+	case oauth2client.FieldRequirePushedAuthorizationRequests:
+		return m.RequirePushedAuthorizationRequests()
+	case oauth2client.FieldRequestUris:
+		return m.RequestUris()
+	case oauth2client.FieldClientName:
+		return m.ClientName()
+	case oauth2client.FieldRedirectUris:
+		return m.RedirectUris()
+	case oauth2client.FieldGrantTypes:
+		return m.GrantTypes()
+	case oauth2client.FieldResponseTypes:
+		return m.ResponseTypes()
+	case oauth2client.FieldRegistrationAccessTokenHash:
+		return m.RegistrationAccessTokenHash()
+	case oauth2client.FieldFirstParty:
+		return m.FirstParty()
+	case oauth2client.FieldLogoURI:
+		return m.LogoURI()
+	case oauth2client.FieldPostLogoutRedirectUris:
+		return m.PostLogoutRedirectUris()
+	case oauth2client.FieldAllowedScopes:
+		return m.AllowedScopes()
+	case oauth2client.FieldTokenClaims:
+		return m.TokenClaims()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *Oauth2ClientMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case oauth2client.FieldSecret:
+		return m.OldSecret(ctx)
+	case oauth2client.FieldDomain:
+		return m.OldDomain(ctx)
+	case oauth2client.FieldRequirePkce:
+		return m.OldRequirePkce(ctx)
+	case oauth2client.FieldExchangeAudiences:
+		return m.OldExchangeAudiences(ctx)
+	case oauth2client.FieldExchangeScopes:
+		return m.OldExchangeScopes(ctx)
+	case oauth2client.FieldJwtBearerIssuers:
+		return m.OldJwtBearerIssuers(ctx)
+	case oauth2client.FieldTokenEndpointAuthMethod:
+		return m.OldTokenEndpointAuthMethod(ctx)
+	case oauth2client.FieldJwks:
+		return m.OldJwks(ctx)
+	case oauth2client.FieldJwksURI:
+		return m.OldJwksURI(ctx)
+	case oauth2client.FieldTLSClientAuthSubjectDn:
+		return m.OldTLSClientAuthSubjectDn(ctx)
+	case oauth2client.FieldDpopBoundAccessTokens:
+		return m.OldDpopBoundAccessTokens(ctx)
+	case oauth2client.FieldRequirePushedAuthorizationRequests:
+		return m.OldRequirePushedAuthorizationRequests(ctx)
+	case oauth2client.FieldRequestUris:
+		return m.OldRequestUris(ctx)
+	case oauth2client.FieldClientName:
+		return m.OldClientName(ctx)
+	case oauth2client.FieldRedirectUris:
+		return m.OldRedirectUris(ctx)
+	case oauth2client.FieldGrantTypes:
+		return m.OldGrantTypes(ctx)
+	case oauth2client.FieldResponseTypes:
+		return m.OldResponseTypes(ctx)
+	case oauth2client.FieldRegistrationAccessTokenHash:
+		return m.OldRegistrationAccessTokenHash(ctx)
+	case oauth2client.FieldFirstParty:
+		return m.OldFirstParty(ctx)
+	case oauth2client.FieldLogoURI:
+		return m.OldLogoURI(ctx)
+	case oauth2client.FieldPostLogoutRedirectUris:
+		return m.OldPostLogoutRedirectUris(ctx)
+	case oauth2client.FieldAllowedScopes:
+		return m.OldAllowedScopes(ctx)
+	case oauth2client.FieldTokenClaims:
+		return m.OldTokenClaims(ctx)
+	}
+	return nil, fmt.Errorf("unknown Oauth2Client field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *Oauth2ClientMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case oauth2client.FieldSecret:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSecret(v)
+		return nil
+	case oauth2client.FieldDomain:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDomain(v)
+		return nil
+	case oauth2client.FieldRequirePkce:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRequirePkce(v)
+		return nil
+	case oauth2client.FieldExchangeAudiences:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExchangeAudiences(v)
+		return nil
+	case oauth2client.FieldExchangeScopes:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExchangeScopes(v)
+		return nil
+	case oauth2client.FieldJwtBearerIssuers:
+		v, ok := value.(map[string]json.RawMessage)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetJwtBearerIssuers(v)
+		return nil
+	case oauth2client.FieldTokenEndpointAuthMethod:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTokenEndpointAuthMethod(v)
+		return nil
+	case oauth2client.FieldJwks:
+		v, ok := value.(json.RawMessage)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetJwks(v)
+		return nil
+	case oauth2client.FieldJwksURI:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetJwksURI(v)
+		return nil
+	case oauth2client.FieldTLSClientAuthSubjectDn:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTLSClientAuthSubjectDn(v)
+		return nil
+	case oauth2client.FieldDpopBoundAccessTokens:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDpopBoundAccessTokens(v)
+		return nil
+	case oauth2client.FieldRequirePushedAuthorizationRequests:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRequirePushedAuthorizationRequests(v)
+		return nil
+	case oauth2client.FieldRequestUris:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRequestUris(v)
+		return nil
+	case oauth2client.FieldClientName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClientName(v)
+		return nil
+	case oauth2client.FieldRedirectUris:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRedirectUris(v)
+		return nil
+	case oauth2client.FieldGrantTypes:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGrantTypes(v)
+		return nil
+	case oauth2client.FieldResponseTypes:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetResponseTypes(v)
+		return nil
+	case oauth2client.FieldRegistrationAccessTokenHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRegistrationAccessTokenHash(v)
+		return nil
+	case oauth2client.FieldFirstParty:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFirstParty(v)
+		return nil
+	case oauth2client.FieldLogoURI:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLogoURI(v)
+		return nil
+	case oauth2client.FieldPostLogoutRedirectUris:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPostLogoutRedirectUris(v)
+		return nil
+	case oauth2client.FieldAllowedScopes:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAllowedScopes(v)
+		return nil
+	case oauth2client.FieldTokenClaims:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTokenClaims(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Oauth2Client field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *Oauth2ClientMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *Oauth2ClientMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *Oauth2ClientMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Oauth2Client numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *Oauth2ClientMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(oauth2client.FieldSecret) {
+		fields = append(fields, oauth2client.FieldSecret)
+	}
+	if m.FieldCleared(oauth2client.FieldExchangeAudiences) {
+		fields = append(fields, oauth2client.FieldExchangeAudiences)
+	}
+	if m.FieldCleared(oauth2client.FieldExchangeScopes) {
+		fields = append(fields, oauth2client.FieldExchangeScopes)
+	}
+	if m.FieldCleared(oauth2client.FieldJwtBearerIssuers) {
+		fields = append(fields, oauth2client.FieldJwtBearerIssuers)
+	}
+	if m.FieldCleared(oauth2client.FieldTokenEndpointAuthMethod) {
+		fields = append(fields, oauth2client.FieldTokenEndpointAuthMethod)
+	}
+	if m.FieldCleared(oauth2client.FieldJwks) {
+		fields = append(fields, oauth2client.FieldJwks)
+	}
+	if m.FieldCleared(oauth2client.FieldJwksURI) {
+		fields = append(fields, oauth2client.FieldJwksURI)
+	}
+	if m.FieldCleared(oauth2client.FieldTLSClientAuthSubjectDn) {
+		fields = append(fields, oauth2client.FieldTLSClientAuthSubjectDn)
+	}
+	if m.FieldCleared(oauth2client.FieldRequestUris) {
+		fields = append(fields, oauth2client.FieldRequestUris)
+	}
+	if m.FieldCleared(oauth2client.FieldClientName) {
+		fields = append(fields, oauth2client.FieldClientName)
+	}
+	if m.FieldCleared(oauth2client.FieldRedirectUris) {
+		fields = append(fields, oauth2client.FieldRedirectUris)
+	}
+	if m.FieldCleared(oauth2client.FieldGrantTypes) {
+		fields = append(fields, oauth2client.FieldGrantTypes)
+	}
+	if m.FieldCleared(oauth2client.FieldResponseTypes) {
+		fields = append(fields, oauth2client.FieldResponseTypes)
+	}
+	if m.FieldCleared(oauth2client.FieldRegistrationAccessTokenHash) {
+		fields = append(fields, oauth2client.FieldRegistrationAccessTokenHash)
+	}
+	if m.FieldCleared(oauth2client.FieldLogoURI) {
+		fields = append(fields, oauth2client.FieldLogoURI)
+	}
+	if m.FieldCleared(oauth2client.FieldPostLogoutRedirectUris) {
+		fields = append(fields, oauth2client.FieldPostLogoutRedirectUris)
+	}
+	if m.FieldCleared(oauth2client.FieldAllowedScopes) {
+		fields = append(fields, oauth2client.FieldAllowedScopes)
+	}
+	if m.FieldCleared(oauth2client.FieldTokenClaims) {
+		fields = append(fields, oauth2client.FieldTokenClaims)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *Oauth2ClientMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *Oauth2ClientMutation) ClearField(name string) error {
+	switch name {
+	case oauth2client.FieldSecret:
+		m.ClearSecret()
+		return nil
+	case oauth2client.FieldExchangeAudiences:
+		m.ClearExchangeAudiences()
+		return nil
+	case oauth2client.FieldExchangeScopes:
+		m.ClearExchangeScopes()
+		return nil
+	case oauth2client.FieldJwtBearerIssuers:
+		m.ClearJwtBearerIssuers()
+		return nil
+	case oauth2client.FieldTokenEndpointAuthMethod:
+		m.ClearTokenEndpointAuthMethod()
+		return nil
+	case oauth2client.FieldJwks:
+		m.ClearJwks()
+		return nil
+	case oauth2client.FieldJwksURI:
+		m.ClearJwksURI()
+		return nil
+	case oauth2client.FieldTLSClientAuthSubjectDn:
+		m.ClearTLSClientAuthSubjectDn()
+		return nil
+	case oauth2client.FieldRequestUris:
+		m.ClearRequestUris()
+		return nil
+	case oauth2client.FieldClientName:
+		m.ClearClientName()
+		return nil
+	case oauth2client.FieldRedirectUris:
+		m.ClearRedirectUris()
+		return nil
+	case oauth2client.FieldGrantTypes:
+		m.ClearGrantTypes()
+		return nil
+	case oauth2client.FieldResponseTypes:
+		m.ClearResponseTypes()
+		return nil
+	case oauth2client.FieldRegistrationAccessTokenHash:
+		m.ClearRegistrationAccessTokenHash()
+		return nil
+	case oauth2client.FieldLogoURI:
+		m.ClearLogoURI()
+		return nil
+	case oauth2client.FieldPostLogoutRedirectUris:
+		m.ClearPostLogoutRedirectUris()
+		return nil
+	case oauth2client.FieldAllowedScopes:
+		m.ClearAllowedScopes()
+		return nil
+	case oauth2client.FieldTokenClaims:
+		m.ClearTokenClaims()
+		return nil
+	}
+	return fmt.Errorf("unknown Oauth2Client nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *Oauth2ClientMutation) ResetField(name string) error {
+	switch name {
+	case oauth2client.FieldSecret:
+		m.ResetSecret()
+		return nil
+	case oauth2client.FieldDomain:
+		m.ResetDomain()
+		return nil
+	case oauth2client.FieldRequirePkce:
+		m.ResetRequirePkce()
+		return nil
+	case oauth2client.FieldExchangeAudiences:
+		m.ResetExchangeAudiences()
+		return nil
+	case oauth2client.FieldExchangeScopes:
+		m.ResetExchangeScopes()
+		return nil
+	case oauth2client.FieldJwtBearerIssuers:
+		m.ResetJwtBearerIssuers()
+		return nil
+	case oauth2client.FieldTokenEndpointAuthMethod:
+		m.ResetTokenEndpointAuthMethod()
+		return nil
+	case oauth2client.FieldJwks:
+		m.ResetJwks()
+		return nil
+	case oauth2client.FieldJwksURI:
+		m.ResetJwksURI()
+		return nil
+	case oauth2client.FieldTLSClientAuthSubjectDn:
+		m.ResetTLSClientAuthSubjectDn()
+		return nil
+	case oauth2client.FieldDpopBoundAccessTokens:
+		m.ResetDpopBoundAccessTokens()
+		return nil
+	case oauth2client.FieldRequirePushedAuthorizationRequests:
+		m.ResetRequirePushedAuthorizationRequests()
+		return nil
+	case oauth2client.FieldRequestUris:
+		m.ResetRequestUris()
+		return nil
+	case oauth2client.FieldClientName:
+		m.ResetClientName()
+		return nil
+	case oauth2client.FieldRedirectUris:
+		m.ResetRedirectUris()
+		return nil
+	case oauth2client.FieldGrantTypes:
+		m.ResetGrantTypes()
+		return nil
+	case oauth2client.FieldResponseTypes:
+		m.ResetResponseTypes()
+		return nil
+	case oauth2client.FieldRegistrationAccessTokenHash:
+		m.ResetRegistrationAccessTokenHash()
+		return nil
+	case oauth2client.FieldFirstParty:
+		m.ResetFirstParty()
+		return nil
+	case oauth2client.FieldLogoURI:
+		m.ResetLogoURI()
+		return nil
+	case oauth2client.FieldPostLogoutRedirectUris:
+		m.ResetPostLogoutRedirectUris()
+		return nil
+	case oauth2client.FieldAllowedScopes:
+		m.ResetAllowedScopes()
+		return nil
+	case oauth2client.FieldTokenClaims:
+		m.ResetTokenClaims()
+		return nil
+	}
+	return fmt.Errorf("unknown Oauth2Client field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *Oauth2ClientMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.consents != nil {
+		edges = append(edges, oauth2client.EdgeConsents)
+	}
+	if m.secrets != nil {
+		edges = append(edges, oauth2client.EdgeSecrets)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *Oauth2ClientMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case oauth2client.EdgeConsents:
+		ids := make([]ent.Value, 0, len(m.consents))
+		for id := range m.consents {
+			ids = append(ids, id)
+		}
+		return ids
+	case oauth2client.EdgeSecrets:
+		ids := make([]ent.Value, 0, len(m.secrets))
+		for id := range m.secrets {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *Oauth2ClientMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removedconsents != nil {
+		edges = append(edges, oauth2client.EdgeConsents)
+	}
+	if m.removedsecrets != nil {
+		edges = append(edges, oauth2client.EdgeSecrets)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *Oauth2ClientMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case oauth2client.EdgeConsents:
+		ids := make([]ent.Value, 0, len(m.removedconsents))
+		for id := range m.removedconsents {
+			ids = append(ids, id)
+		}
+		return ids
+	case oauth2client.EdgeSecrets:
+		ids := make([]ent.Value, 0, len(m.removedsecrets))
+		for id := range m.removedsecrets {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *Oauth2ClientMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedconsents {
+		edges = append(edges, oauth2client.EdgeConsents)
+	}
+	if m.clearedsecrets {
+		edges = append(edges, oauth2client.EdgeSecrets)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *Oauth2ClientMutation) EdgeCleared(name string) bool {
+	switch name {
+	case oauth2client.EdgeConsents:
+		return m.clearedconsents
+	case oauth2client.EdgeSecrets:
+		return m.clearedsecrets
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *Oauth2ClientMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Oauth2Client unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *Oauth2ClientMutation) ResetEdge(name string) error {
+	switch name {
+	case oauth2client.EdgeConsents:
+		m.ResetConsents()
+		return nil
+	case oauth2client.EdgeSecrets:
+		m.ResetSecrets()
+		return nil
+	}
+	return fmt.Errorf("unknown Oauth2Client edge %s", name)
+}
+
+// ScopeMutation represents an operation that mutates the Scope nodes in the graph.
+type ScopeMutation struct {
+	config
+	op                 Op
+	typ                string
+	id                 *uuid.UUID
+	name               *string
+	description        *map[string]string
+	is_default         *bool
+	requires_consent   *bool
+	clearedFields      map[string]struct{}
+	permissions        map[uuid.UUID]struct{}
+	removedpermissions map[uuid.UUID]struct{}
+	clearedpermissions bool
+	done               bool
+	oldValue           func(context.Context) (*Scope, error)
+	predicates         []predicate.Scope
+}
+
+var _ ent.Mutation = (*ScopeMutation)(nil)
+
+// scopeOption allows management of the mutation configuration using functional options.
+type scopeOption func(*ScopeMutation)
+
+// newScopeMutation creates new mutation for the Scope entity.
+func newScopeMutation(c config, op Op, opts ...scopeOption) *ScopeMutation {
+	m := &ScopeMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeScope,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withScopeID sets the ID field of the mutation.
+func withScopeID(id uuid.UUID) scopeOption {
+	return func(m *ScopeMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Scope
+		)
+		m.oldValue = func(ctx context.Context) (*Scope, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Scope.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withScope sets the old Scope of the mutation.
+func withScope(node *Scope) scopeOption {
+	return func(m *ScopeMutation) {
+		m.oldValue = func(context.Context) (*Scope, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ScopeMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ScopeMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Scope entities.
+func (m *ScopeMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ScopeMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ScopeMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Scope.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *ScopeMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *ScopeMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Scope entity.
+// If the Scope object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScopeMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *ScopeMutation) ResetName() {
+	m.name = nil
+}
+
+// SetDescription sets the "description" field.
+func (m *ScopeMutation) SetDescription(value map[string]string) {
+	m.description = &value
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *ScopeMutation) Description() (r map[string]string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the Scope entity.
+// If the Scope object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScopeMutation) OldDescription(ctx context.Context) (v map[string]string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ClearDescription clears the value of the "description" field.
+func (m *ScopeMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[scope.FieldDescription] = struct{}{}
+}
+
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *ScopeMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[scope.FieldDescription]
+	return ok
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *ScopeMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, scope.FieldDescription)
+}
+
+// SetIsDefault sets the "is_default" field.
+func (m *ScopeMutation) SetIsDefault(b bool) {
+	m.is_default = &b
+}
+
+// IsDefault returns the value of the "is_default" field in the mutation.
+func (m *ScopeMutation) IsDefault() (r bool, exists bool) {
+	v := m.is_default
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsDefault returns the old "is_default" field's value of the Scope entity.
+// If the Scope object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScopeMutation) OldIsDefault(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsDefault is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsDefault requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsDefault: %w", err)
+	}
+	return oldValue.IsDefault, nil
+}
+
+// ResetIsDefault resets all changes to the "is_default" field.
+func (m *ScopeMutation) ResetIsDefault() {
+	m.is_default = nil
+}
+
+// SetRequiresConsent sets the "requires_consent" field.
+func (m *ScopeMutation) SetRequiresConsent(b bool) {
+	m.requires_consent = &b
+}
+
+// RequiresConsent returns the value of the "requires_consent" field in the mutation.
+func (m *ScopeMutation) RequiresConsent() (r bool, exists bool) {
+	v := m.requires_consent
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRequiresConsent returns the old "requires_consent" field's value of the Scope entity.
+// If the Scope object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScopeMutation) OldRequiresConsent(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRequiresConsent is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRequiresConsent requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRequiresConsent: %w", err)
+	}
+	return oldValue.RequiresConsent, nil
+}
+
+// ResetRequiresConsent resets all changes to the "requires_consent" field.
+func (m *ScopeMutation) ResetRequiresConsent() {
+	m.requires_consent = nil
+}
+
+// AddPermissionIDs adds the "permissions" edge to the ScopePermission entity by ids.
+func (m *ScopeMutation) AddPermissionIDs(ids ...uuid.UUID) {
+	if m.permissions == nil {
+		m.permissions = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.permissions[ids[i]] = struct{}{}
+	}
+}
+
+// ClearPermissions clears the "permissions" edge to the ScopePermission entity.
+func (m *ScopeMutation) ClearPermissions() {
+	m.clearedpermissions = true
+}
+
+// PermissionsCleared reports if the "permissions" edge to the ScopePermission entity was cleared.
+func (m *ScopeMutation) PermissionsCleared() bool {
+	return m.clearedpermissions
+}
+
+// RemovePermissionIDs removes the "permissions" edge to the ScopePermission entity by IDs.
+func (m *ScopeMutation) RemovePermissionIDs(ids ...uuid.UUID) {
+	if m.removedpermissions == nil {
+		m.removedpermissions = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.permissions, ids[i])
+		m.removedpermissions[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedPermissions returns the removed IDs of the "permissions" edge to the ScopePermission entity.
+func (m *ScopeMutation) RemovedPermissionsIDs() (ids []uuid.UUID) {
+	for id := range m.removedpermissions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// PermissionsIDs returns the "permissions" edge IDs in the mutation.
+func (m *ScopeMutation) PermissionsIDs() (ids []uuid.UUID) {
+	for id := range m.permissions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetPermissions resets all changes to the "permissions" edge.
+func (m *ScopeMutation) ResetPermissions() {
+	m.permissions = nil
+	m.clearedpermissions = false
+	m.removedpermissions = nil
+}
+
+// Where appends a list predicates to the ScopeMutation builder.
+func (m *ScopeMutation) Where(ps ...predicate.Scope) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ScopeMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ScopeMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Scope, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ScopeMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ScopeMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Scope).
+func (m *ScopeMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ScopeMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.name != nil {
+		fields = append(fields, scope.FieldName)
+	}
+	if m.description != nil {
+		fields = append(fields, scope.FieldDescription)
+	}
+	if m.is_default != nil {
+		fields = append(fields, scope.FieldIsDefault)
+	}
+	if m.requires_consent != nil {
+		fields = append(fields, scope.FieldRequiresConsent)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ScopeMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case scope.FieldName:
+		return m.Name()
+	case scope.FieldDescription:
+		return m.Description()
+	case scope.FieldIsDefault:
+		return m.IsDefault()
+	case scope.FieldRequiresConsent:
+		return m.RequiresConsent()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ScopeMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case scope.FieldName:
+		return m.OldName(ctx)
+	case scope.FieldDescription:
+		return m.OldDescription(ctx)
+	case scope.FieldIsDefault:
+		return m.OldIsDefault(ctx)
+	case scope.FieldRequiresConsent:
+		return m.OldRequiresConsent(ctx)
+	}
+	return nil, fmt.Errorf("unknown Scope field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ScopeMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case scope.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case scope.FieldDescription:
+		v, ok := value.(map[string]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case scope.FieldIsDefault:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsDefault(v)
+		return nil
+	case scope.FieldRequiresConsent:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRequiresConsent(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Scope field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ScopeMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ScopeMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ScopeMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Scope numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ScopeMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(scope.FieldDescription) {
+		fields = append(fields, scope.FieldDescription)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ScopeMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ScopeMutation) ClearField(name string) error {
+	switch name {
+	case scope.FieldDescription:
+		m.ClearDescription()
+		return nil
+	}
+	return fmt.Errorf("unknown Scope nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ScopeMutation) ResetField(name string) error {
+	switch name {
+	case scope.FieldName:
+		m.ResetName()
+		return nil
+	case scope.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case scope.FieldIsDefault:
+		m.ResetIsDefault()
+		return nil
+	case scope.FieldRequiresConsent:
+		m.ResetRequiresConsent()
+		return nil
+	}
+	return fmt.Errorf("unknown Scope field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ScopeMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.permissions != nil {
+		edges = append(edges, scope.EdgePermissions)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ScopeMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case scope.EdgePermissions:
+		ids := make([]ent.Value, 0, len(m.permissions))
+		for id := range m.permissions {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ScopeMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.removedpermissions != nil {
+		edges = append(edges, scope.EdgePermissions)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ScopeMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case scope.EdgePermissions:
+		ids := make([]ent.Value, 0, len(m.removedpermissions))
+		for id := range m.removedpermissions {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ScopeMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedpermissions {
+		edges = append(edges, scope.EdgePermissions)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ScopeMutation) EdgeCleared(name string) bool {
+	switch name {
+	case scope.EdgePermissions:
+		return m.clearedpermissions
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ScopeMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Scope unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ScopeMutation) ResetEdge(name string) error {
+	switch name {
+	case scope.EdgePermissions:
+		m.ResetPermissions()
+		return nil
+	}
+	return fmt.Errorf("unknown Scope edge %s", name)
+}
+
+// ScopePermissionMutation represents an operation that mutates the ScopePermission nodes in the graph.
+type ScopePermissionMutation struct {
 	config
 	op            Op
 	typ           string
 	id            *uuid.UUID
-	secret        *string
-	domain        *string
+	method        *string
+	host          *string
+	_path         *string
 	clearedFields map[string]struct{}
+	scope         *uuid.UUID
+	clearedscope  bool
 	done          bool
-	oldValue      func(context.Context) (*Oauth2Client, error)
-	predicates    []predicate.Oauth2Client
+	oldValue      func(context.Context) (*ScopePermission, error)
+	predicates    []predicate.ScopePermission
 }
 
-var _ ent.Mutation = (*Oauth2ClientMutation)(nil)
+var _ ent.Mutation = (*ScopePermissionMutation)(nil)
 
-// oauth2clientOption allows management of the mutation configuration using functional options.
-type oauth2clientOption func(*Oauth2ClientMutation)
+// scopepermissionOption allows management of the mutation configuration using functional options.
+type scopepermissionOption func(*ScopePermissionMutation)
 
-// newOauth2ClientMutation creates new mutation for the Oauth2Client entity.
-func newOauth2ClientMutation(c config, op Op, opts ...oauth2clientOption) *Oauth2ClientMutation {
-	m := &Oauth2ClientMutation{
+// newScopePermissionMutation creates new mutation for the ScopePermission entity.
+func newScopePermissionMutation(c config, op Op, opts ...scopepermissionOption) *ScopePermissionMutation {
+	m := &ScopePermissionMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeOauth2Client,
+		typ:           TypeScopePermission,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -60,20 +4114,20 @@ func newOauth2ClientMutation(c config, op Op, opts ...oauth2clientOption) *Oauth
 	return m
 }
 
-// withOauth2ClientID sets the ID field of the mutation.
-func withOauth2ClientID(id uuid.UUID) oauth2clientOption {
-	return func(m *Oauth2ClientMutation) {
+// withScopePermissionID sets the ID field of the mutation.
+func withScopePermissionID(id uuid.UUID) scopepermissionOption {
+	return func(m *ScopePermissionMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Oauth2Client
+			value *ScopePermission
 		)
-		m.oldValue = func(ctx context.Context) (*Oauth2Client, error) {
+		m.oldValue = func(ctx context.Context) (*ScopePermission, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Oauth2Client.Get(ctx, id)
+					value, err = m.Client().ScopePermission.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -82,10 +4136,10 @@ func withOauth2ClientID(id uuid.UUID) oauth2clientOption {
 	}
 }
 
-// withOauth2Client sets the old Oauth2Client of the mutation.
-func withOauth2Client(node *Oauth2Client) oauth2clientOption {
-	return func(m *Oauth2ClientMutation) {
-		m.oldValue = func(context.Context) (*Oauth2Client, error) {
+// withScopePermission sets the old ScopePermission of the mutation.
+func withScopePermission(node *ScopePermission) scopepermissionOption {
+	return func(m *ScopePermissionMutation) {
+		m.oldValue = func(context.Context) (*ScopePermission, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -94,7 +4148,7 @@ func withOauth2Client(node *Oauth2Client) oauth2clientOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m Oauth2ClientMutation) Client() *Client {
+func (m ScopePermissionMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -102,7 +4156,7 @@ func (m Oauth2ClientMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m Oauth2ClientMutation) Tx() (*Tx, error) {
+func (m ScopePermissionMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -112,14 +4166,14 @@ func (m Oauth2ClientMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Oauth2Client entities.
-func (m *Oauth2ClientMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of ScopePermission entities.
+func (m *ScopePermissionMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *Oauth2ClientMutation) ID() (id uuid.UUID, exists bool) {
+func (m *ScopePermissionMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -130,7 +4184,7 @@ func (m *Oauth2ClientMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *Oauth2ClientMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *ScopePermissionMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -139,93 +4193,168 @@ func (m *Oauth2ClientMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Oauth2Client.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().ScopePermission.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetSecret sets the "secret" field.
-func (m *Oauth2ClientMutation) SetSecret(s string) {
-	m.secret = &s
+// SetMethod sets the "method" field.
+func (m *ScopePermissionMutation) SetMethod(s string) {
+	m.method = &s
 }
 
-// Secret returns the value of the "secret" field in the mutation.
-func (m *Oauth2ClientMutation) Secret() (r string, exists bool) {
-	v := m.secret
+// Method returns the value of the "method" field in the mutation.
+func (m *ScopePermissionMutation) Method() (r string, exists bool) {
+	v := m.method
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSecret returns the old "secret" field's value of the Oauth2Client entity.
-// If the Oauth2Client object wasn't provided to the builder, the object is fetched from the database.
+// OldMethod returns the old "method" field's value of the ScopePermission entity.
+// If the ScopePermission object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *Oauth2ClientMutation) OldSecret(ctx context.Context) (v string, err error) {
+func (m *ScopePermissionMutation) OldMethod(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSecret is only allowed on UpdateOne operations")
+		return v, errors.New("OldMethod is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSecret requires an ID field in the mutation")
+		return v, errors.New("OldMethod requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSecret: %w", err)
+		return v, fmt.Errorf("querying old value for OldMethod: %w", err)
 	}
-	return oldValue.Secret, nil
+	return oldValue.Method, nil
 }
 
-// ResetSecret resets all changes to the "secret" field.
-func (m *Oauth2ClientMutation) ResetSecret() {
-	m.secret = nil
+// ResetMethod resets all changes to the "method" field.
+func (m *ScopePermissionMutation) ResetMethod() {
+	m.method = nil
 }
 
-// SetDomain sets the "domain" field.
-func (m *Oauth2ClientMutation) SetDomain(s string) {
-	m.domain = &s
+// SetHost sets the "host" field.
+func (m *ScopePermissionMutation) SetHost(s string) {
+	m.host = &s
 }
 
-// Domain returns the value of the "domain" field in the mutation.
-func (m *Oauth2ClientMutation) Domain() (r string, exists bool) {
-	v := m.domain
+// Host returns the value of the "host" field in the mutation.
+func (m *ScopePermissionMutation) Host() (r string, exists bool) {
+	v := m.host
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDomain returns the old "domain" field's value of the Oauth2Client entity.
-// If the Oauth2Client object wasn't provided to the builder, the object is fetched from the database.
+// OldHost returns the old "host" field's value of the ScopePermission entity.
+// If the ScopePermission object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *Oauth2ClientMutation) OldDomain(ctx context.Context) (v string, err error) {
+func (m *ScopePermissionMutation) OldHost(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDomain is only allowed on UpdateOne operations")
+		return v, errors.New("OldHost is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDomain requires an ID field in the mutation")
+		return v, errors.New("OldHost requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDomain: %w", err)
+		return v, fmt.Errorf("querying old value for OldHost: %w", err)
 	}
-	return oldValue.Domain, nil
+	return oldValue.Host, nil
 }
 
-// ResetDomain resets all changes to the "domain" field.
-func (m *Oauth2ClientMutation) ResetDomain() {
-	m.domain = nil
+// ResetHost resets all changes to the "host" field.
+func (m *ScopePermissionMutation) ResetHost() {
+	m.host = nil
 }
 
-// Where appends a list predicates to the Oauth2ClientMutation builder.
-func (m *Oauth2ClientMutation) Where(ps ...predicate.Oauth2Client) {
+// SetPath sets the "path" field.
+func (m *ScopePermissionMutation) SetPath(s string) {
+	m._path = &s
+}
+
+// Path returns the value of the "path" field in the mutation.
+func (m *ScopePermissionMutation) Path() (r string, exists bool) {
+	v := m._path
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPath returns the old "path" field's value of the ScopePermission entity.
+// If the ScopePermission object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScopePermissionMutation) OldPath(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPath is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPath requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPath: %w", err)
+	}
+	return oldValue.Path, nil
+}
+
+// ResetPath resets all changes to the "path" field.
+func (m *ScopePermissionMutation) ResetPath() {
+	m._path = nil
+}
+
+// SetScopeID sets the "scope" edge to the Scope entity by id.
+func (m *ScopePermissionMutation) SetScopeID(id uuid.UUID) {
+	m.scope = &id
+}
+
+// ClearScope clears the "scope" edge to the Scope entity.
+func (m *ScopePermissionMutation) ClearScope() {
+	m.clearedscope = true
+}
+
+// ScopeCleared reports if the "scope" edge to the Scope entity was cleared.
+func (m *ScopePermissionMutation) ScopeCleared() bool {
+	return m.clearedscope
+}
+
+// ScopeID returns the "scope" edge ID in the mutation.
+func (m *ScopePermissionMutation) ScopeID() (id uuid.UUID, exists bool) {
+	if m.scope != nil {
+		return *m.scope, true
+	}
+	return
+}
+
+// ScopeIDs returns the "scope" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ScopeID instead. It exists only for internal usage by the builders.
+func (m *ScopePermissionMutation) ScopeIDs() (ids []uuid.UUID) {
+	if id := m.scope; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetScope resets all changes to the "scope" edge.
+func (m *ScopePermissionMutation) ResetScope() {
+	m.scope = nil
+	m.clearedscope = false
+}
+
+// Where appends a list predicates to the ScopePermissionMutation builder.
+func (m *ScopePermissionMutation) Where(ps ...predicate.ScopePermission) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the Oauth2ClientMutation builder. Using this method,
+// WhereP appends storage-level predicates to the ScopePermissionMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *Oauth2ClientMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Oauth2Client, len(ps))
+func (m *ScopePermissionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ScopePermission, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -233,30 +4362,33 @@ func (m *Oauth2ClientMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *Oauth2ClientMutation) Op() Op {
+func (m *ScopePermissionMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *Oauth2ClientMutation) SetOp(op Op) {
+func (m *ScopePermissionMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Oauth2Client).
-func (m *Oauth2ClientMutation) Type() string {
+// Type returns the node type of this mutation (ScopePermission).
+func (m *ScopePermissionMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *Oauth2ClientMutation) Fields() []string {
-	fields := make([]string, 0, 2)
-	if m.secret != nil {
-		fields = append(fields, oauth2client.FieldSecret)
+func (m *ScopePermissionMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.method != nil {
+		fields = append(fields, scopepermission.FieldMethod)
 	}
-	if m.domain != nil {
-		fields = append(fields, oauth2client.FieldDomain)
+	if m.host != nil {
+		fields = append(fields, scopepermission.FieldHost)
+	}
+	if m._path != nil {
+		fields = append(fields, scopepermission.FieldPath)
 	}
 	return fields
 }
@@ -264,12 +4396,14 @@ func (m *Oauth2ClientMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *Oauth2ClientMutation) Field(name string) (ent.Value, bool) {
+func (m *ScopePermissionMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case oauth2client.FieldSecret:
-		return m.Secret()
-	case oauth2client.FieldDomain:
-		return m.Domain()
+	case scopepermission.FieldMethod:
+		return m.Method()
+	case scopepermission.FieldHost:
+		return m.Host()
+	case scopepermission.FieldPath:
+		return m.Path()
 	}
 	return nil, false
 }
@@ -277,138 +4411,176 @@ func (m *Oauth2ClientMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *Oauth2ClientMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *ScopePermissionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case oauth2client.FieldSecret:
-		return m.OldSecret(ctx)
-	case oauth2client.FieldDomain:
-		return m.OldDomain(ctx)
+	case scopepermission.FieldMethod:
+		return m.OldMethod(ctx)
+	case scopepermission.FieldHost:
+		return m.OldHost(ctx)
+	case scopepermission.FieldPath:
+		return m.OldPath(ctx)
 	}
-	return nil, fmt.Errorf("unknown Oauth2Client field %s", name)
+	return nil, fmt.Errorf("unknown ScopePermission field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *Oauth2ClientMutation) SetField(name string, value ent.Value) error {
+func (m *ScopePermissionMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case oauth2client.FieldSecret:
+	case scopepermission.FieldMethod:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSecret(v)
+		m.SetMethod(v)
 		return nil
-	case oauth2client.FieldDomain:
+	case scopepermission.FieldHost:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDomain(v)
+		m.SetHost(v)
+		return nil
+	case scopepermission.FieldPath:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPath(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Oauth2Client field %s", name)
+	return fmt.Errorf("unknown ScopePermission field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *Oauth2ClientMutation) AddedFields() []string {
+func (m *ScopePermissionMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *Oauth2ClientMutation) AddedField(name string) (ent.Value, bool) {
+func (m *ScopePermissionMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *Oauth2ClientMutation) AddField(name string, value ent.Value) error {
+func (m *ScopePermissionMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown Oauth2Client numeric field %s", name)
+	return fmt.Errorf("unknown ScopePermission numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *Oauth2ClientMutation) ClearedFields() []string {
+func (m *ScopePermissionMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *Oauth2ClientMutation) FieldCleared(name string) bool {
+func (m *ScopePermissionMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *Oauth2ClientMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Oauth2Client nullable field %s", name)
+func (m *ScopePermissionMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown ScopePermission nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *Oauth2ClientMutation) ResetField(name string) error {
+func (m *ScopePermissionMutation) ResetField(name string) error {
 	switch name {
-	case oauth2client.FieldSecret:
-		m.ResetSecret()
+	case scopepermission.FieldMethod:
+		m.ResetMethod()
 		return nil
-	case oauth2client.FieldDomain:
-		m.ResetDomain()
+	case scopepermission.FieldHost:
+		m.ResetHost()
+		return nil
+	case scopepermission.FieldPath:
+		m.ResetPath()
 		return nil
 	}
-	return fmt.Errorf("unknown Oauth2Client field %s", name)
+	return fmt.Errorf("unknown ScopePermission field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *Oauth2ClientMutation) AddedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *ScopePermissionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.scope != nil {
+		edges = append(edges, scopepermission.EdgeScope)
+	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *Oauth2ClientMutation) AddedIDs(name string) []ent.Value {
+func (m *ScopePermissionMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case scopepermission.EdgeScope:
+		if id := m.scope; id != nil {
+			return []ent.Value{*id}
+		}
+	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *Oauth2ClientMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *ScopePermissionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *Oauth2ClientMutation) RemovedIDs(name string) []ent.Value {
+func (m *ScopePermissionMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *Oauth2ClientMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *ScopePermissionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedscope {
+		edges = append(edges, scopepermission.EdgeScope)
+	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *Oauth2ClientMutation) EdgeCleared(name string) bool {
+func (m *ScopePermissionMutation) EdgeCleared(name string) bool {
+	switch name {
+	case scopepermission.EdgeScope:
+		return m.clearedscope
+	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *Oauth2ClientMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown Oauth2Client unique edge %s", name)
+func (m *ScopePermissionMutation) ClearEdge(name string) error {
+	switch name {
+	case scopepermission.EdgeScope:
+		m.ClearScope()
+		return nil
+	}
+	return fmt.Errorf("unknown ScopePermission unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *Oauth2ClientMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown Oauth2Client edge %s", name)
+func (m *ScopePermissionMutation) ResetEdge(name string) error {
+	switch name {
+	case scopepermission.EdgeScope:
+		m.ResetScope()
+		return nil
+	}
+	return fmt.Errorf("unknown ScopePermission edge %s", name)
 }
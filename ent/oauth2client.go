@@ -3,6 +3,7 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 
@@ -18,18 +19,96 @@ type Oauth2Client struct {
 	// ID of the ent.
 	ID uuid.UUID `json:"id,omitempty"`
 	// Secret holds the value of the "secret" field.
-	Secret string `json:"secret,omitempty"`
+	Secret string `json:"-"`
 	// Domain holds the value of the "domain" field.
-	Domain       string `json:"domain,omitempty"`
+	Domain string `json:"domain,omitempty"`
+	// RequirePkce holds the value of the "require_pkce" field.
+	RequirePkce bool `json:"require_pkce,omitempty"`
+	// ExchangeAudiences holds the value of the "exchange_audiences" field.
+	ExchangeAudiences []string `json:"exchange_audiences,omitempty"`
+	// ExchangeScopes holds the value of the "exchange_scopes" field.
+	ExchangeScopes []string `json:"exchange_scopes,omitempty"`
+	// JwtBearerIssuers holds the value of the "jwt_bearer_issuers" field.
+	JwtBearerIssuers map[string]json.RawMessage `json:"jwt_bearer_issuers,omitempty"`
+	// TokenEndpointAuthMethod holds the value of the "token_endpoint_auth_method" field.
+	TokenEndpointAuthMethod string `json:"token_endpoint_auth_method,omitempty"`
+	// Jwks holds the value of the "jwks" field.
+	Jwks json.RawMessage `json:"jwks,omitempty"`
+	// JwksURI holds the value of the "jwks_uri" field.
+	JwksURI string `json:"jwks_uri,omitempty"`
+	// TLSClientAuthSubjectDn holds the value of the "tls_client_auth_subject_dn" field.
+	TLSClientAuthSubjectDn string `json:"tls_client_auth_subject_dn,omitempty"`
+	// DpopBoundAccessTokens holds the value of the "dpop_bound_access_tokens" field.
+	DpopBoundAccessTokens bool `json:"dpop_bound_access_tokens,omitempty"`
+	// RequirePushedAuthorizationRequests holds the value of the "require_pushed_authorization_requests" field.
+	RequirePushedAuthorizationRequests bool `json:"require_pushed_authorization_requests,omitempty"`
+	// RequestUris holds the value of the "request_uris" field.
+	RequestUris []string `json:"request_uris,omitempty"`
+	// ClientName holds the value of the "client_name" field.
+	ClientName string `json:"client_name,omitempty"`
+	// RedirectUris holds the value of the "redirect_uris" field.
+	RedirectUris []string `json:"redirect_uris,omitempty"`
+	// GrantTypes holds the value of the "grant_types" field.
+	GrantTypes []string `json:"grant_types,omitempty"`
+	// ResponseTypes holds the value of the "response_types" field.
+	ResponseTypes []string `json:"response_types,omitempty"`
+	// RegistrationAccessTokenHash holds the value of the "registration_access_token_hash" field.
+	RegistrationAccessTokenHash string `json:"-"`
+	// FirstParty holds the value of the "first_party" field.
+	FirstParty bool `json:"first_party,omitempty"`
+	// LogoURI holds the value of the "logo_uri" field.
+	LogoURI string `json:"logo_uri,omitempty"`
+	// PostLogoutRedirectUris holds the value of the "post_logout_redirect_uris" field.
+	PostLogoutRedirectUris []string `json:"post_logout_redirect_uris,omitempty"`
+	// AllowedScopes holds the value of the "allowed_scopes" field.
+	AllowedScopes []string `json:"allowed_scopes,omitempty"`
+	// TokenClaims holds the value of the "token_claims" field.
+	TokenClaims []string `json:"token_claims,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the Oauth2ClientQuery when eager-loading is set.
+	Edges        Oauth2ClientEdges `json:"edges"`
 	selectValues sql.SelectValues
 }
 
+// Oauth2ClientEdges holds the relations/edges for other nodes in the graph.
+type Oauth2ClientEdges struct {
+	// Consents holds the value of the consents edge.
+	Consents []*Consent `json:"consents,omitempty"`
+	// Secrets holds the value of the secrets edge.
+	Secrets []*ClientSecret `json:"secrets,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// ConsentsOrErr returns the Consents value or an error if the edge
+// was not loaded in eager-loading.
+func (e Oauth2ClientEdges) ConsentsOrErr() ([]*Consent, error) {
+	if e.loadedTypes[0] {
+		return e.Consents, nil
+	}
+	return nil, &NotLoadedError{edge: "consents"}
+}
+
+// SecretsOrErr returns the Secrets value or an error if the edge
+// was not loaded in eager-loading.
+func (e Oauth2ClientEdges) SecretsOrErr() ([]*ClientSecret, error) {
+	if e.loadedTypes[1] {
+		return e.Secrets, nil
+	}
+	return nil, &NotLoadedError{edge: "secrets"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Oauth2Client) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case oauth2client.FieldSecret, oauth2client.FieldDomain:
+		case oauth2client.FieldExchangeAudiences, oauth2client.FieldExchangeScopes, oauth2client.FieldJwtBearerIssuers, oauth2client.FieldJwks, oauth2client.FieldRequestUris, oauth2client.FieldRedirectUris, oauth2client.FieldGrantTypes, oauth2client.FieldResponseTypes, oauth2client.FieldPostLogoutRedirectUris, oauth2client.FieldAllowedScopes, oauth2client.FieldTokenClaims:
+			values[i] = new([]byte)
+		case oauth2client.FieldRequirePkce, oauth2client.FieldDpopBoundAccessTokens, oauth2client.FieldRequirePushedAuthorizationRequests, oauth2client.FieldFirstParty:
+			values[i] = new(sql.NullBool)
+		case oauth2client.FieldSecret, oauth2client.FieldDomain, oauth2client.FieldTokenEndpointAuthMethod, oauth2client.FieldJwksURI, oauth2client.FieldTLSClientAuthSubjectDn, oauth2client.FieldClientName, oauth2client.FieldRegistrationAccessTokenHash, oauth2client.FieldLogoURI:
 			values[i] = new(sql.NullString)
 		case oauth2client.FieldID:
 			values[i] = new(uuid.UUID)
@@ -66,6 +145,154 @@ func (o *Oauth2Client) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				o.Domain = value.String
 			}
+		case oauth2client.FieldRequirePkce:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field require_pkce", values[i])
+			} else if value.Valid {
+				o.RequirePkce = value.Bool
+			}
+		case oauth2client.FieldExchangeAudiences:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field exchange_audiences", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &o.ExchangeAudiences); err != nil {
+					return fmt.Errorf("unmarshal field exchange_audiences: %w", err)
+				}
+			}
+		case oauth2client.FieldExchangeScopes:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field exchange_scopes", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &o.ExchangeScopes); err != nil {
+					return fmt.Errorf("unmarshal field exchange_scopes: %w", err)
+				}
+			}
+		case oauth2client.FieldJwtBearerIssuers:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field jwt_bearer_issuers", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &o.JwtBearerIssuers); err != nil {
+					return fmt.Errorf("unmarshal field jwt_bearer_issuers: %w", err)
+				}
+			}
+		case oauth2client.FieldTokenEndpointAuthMethod:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field token_endpoint_auth_method", values[i])
+			} else if value.Valid {
+				o.TokenEndpointAuthMethod = value.String
+			}
+		case oauth2client.FieldJwks:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field jwks", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &o.Jwks); err != nil {
+					return fmt.Errorf("unmarshal field jwks: %w", err)
+				}
+			}
+		case oauth2client.FieldJwksURI:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field jwks_uri", values[i])
+			} else if value.Valid {
+				o.JwksURI = value.String
+			}
+		case oauth2client.FieldTLSClientAuthSubjectDn:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tls_client_auth_subject_dn", values[i])
+			} else if value.Valid {
+				o.TLSClientAuthSubjectDn = value.String
+			}
+		case oauth2client.FieldDpopBoundAccessTokens:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field dpop_bound_access_tokens", values[i])
+			} else if value.Valid {
+				o.DpopBoundAccessTokens = value.Bool
+			}
+		case oauth2client.FieldRequirePushedAuthorizationRequests:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field require_pushed_authorization_requests", values[i])
+			} else if value.Valid {
+				o.RequirePushedAuthorizationRequests = value.Bool
+			}
+		case oauth2client.FieldRequestUris:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field request_uris", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &o.RequestUris); err != nil {
+					return fmt.Errorf("unmarshal field request_uris: %w", err)
+				}
+			}
+		case oauth2client.FieldClientName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field client_name", values[i])
+			} else if value.Valid {
+				o.ClientName = value.String
+			}
+		case oauth2client.FieldRedirectUris:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field redirect_uris", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &o.RedirectUris); err != nil {
+					return fmt.Errorf("unmarshal field redirect_uris: %w", err)
+				}
+			}
+		case oauth2client.FieldGrantTypes:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field grant_types", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &o.GrantTypes); err != nil {
+					return fmt.Errorf("unmarshal field grant_types: %w", err)
+				}
+			}
+		case oauth2client.FieldResponseTypes:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field response_types", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &o.ResponseTypes); err != nil {
+					return fmt.Errorf("unmarshal field response_types: %w", err)
+				}
+			}
+		case oauth2client.FieldRegistrationAccessTokenHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field registration_access_token_hash", values[i])
+			} else if value.Valid {
+				o.RegistrationAccessTokenHash = value.String
+			}
+		case oauth2client.FieldFirstParty:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field first_party", values[i])
+			} else if value.Valid {
+				o.FirstParty = value.Bool
+			}
+		case oauth2client.FieldLogoURI:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field logo_uri", values[i])
+			} else if value.Valid {
+				o.LogoURI = value.String
+			}
+		case oauth2client.FieldPostLogoutRedirectUris:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field post_logout_redirect_uris", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &o.PostLogoutRedirectUris); err != nil {
+					return fmt.Errorf("unmarshal field post_logout_redirect_uris: %w", err)
+				}
+			}
+		case oauth2client.FieldAllowedScopes:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field allowed_scopes", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &o.AllowedScopes); err != nil {
+					return fmt.Errorf("unmarshal field allowed_scopes: %w", err)
+				}
+			}
+		case oauth2client.FieldTokenClaims:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field token_claims", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &o.TokenClaims); err != nil {
+					return fmt.Errorf("unmarshal field token_claims: %w", err)
+				}
+			}
 		default:
 			o.selectValues.Set(columns[i], values[i])
 		}
@@ -79,6 +306,16 @@ func (o *Oauth2Client) Value(name string) (ent.Value, error) {
 	return o.selectValues.Get(name)
 }
 
+// QueryConsents queries the "consents" edge of the Oauth2Client entity.
+func (o *Oauth2Client) QueryConsents() *ConsentQuery {
+	return NewOauth2ClientClient(o.config).QueryConsents(o)
+}
+
+// QuerySecrets queries the "secrets" edge of the Oauth2Client entity.
+func (o *Oauth2Client) QuerySecrets() *ClientSecretQuery {
+	return NewOauth2ClientClient(o.config).QuerySecrets(o)
+}
+
 // Update returns a builder for updating this Oauth2Client.
 // Note that you need to call Oauth2Client.Unwrap() before calling this method if this Oauth2Client
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -102,44 +339,75 @@ func (o *Oauth2Client) String() string {
 	var builder strings.Builder
 	builder.WriteString("Oauth2Client(")
 	builder.WriteString(fmt.Sprintf("id=%v, ", o.ID))
-	builder.WriteString("secret=")
-	builder.WriteString(o.Secret)
+	builder.WriteString("secret=<sensitive>")
 	builder.WriteString(", ")
 	builder.WriteString("domain=")
 	builder.WriteString(o.Domain)
+	builder.WriteString(", ")
+	builder.WriteString("require_pkce=")
+	builder.WriteString(fmt.Sprintf("%v", o.RequirePkce))
+	builder.WriteString(", ")
+	builder.WriteString("exchange_audiences=")
+	builder.WriteString(fmt.Sprintf("%v", o.ExchangeAudiences))
+	builder.WriteString(", ")
+	builder.WriteString("exchange_scopes=")
+	builder.WriteString(fmt.Sprintf("%v", o.ExchangeScopes))
+	builder.WriteString(", ")
+	builder.WriteString("jwt_bearer_issuers=")
+	builder.WriteString(fmt.Sprintf("%v", o.JwtBearerIssuers))
+	builder.WriteString(", ")
+	builder.WriteString("token_endpoint_auth_method=")
+	builder.WriteString(o.TokenEndpointAuthMethod)
+	builder.WriteString(", ")
+	builder.WriteString("jwks=")
+	builder.WriteString(fmt.Sprintf("%v", o.Jwks))
+	builder.WriteString(", ")
+	builder.WriteString("jwks_uri=")
+	builder.WriteString(o.JwksURI)
+	builder.WriteString(", ")
+	builder.WriteString("tls_client_auth_subject_dn=")
+	builder.WriteString(o.TLSClientAuthSubjectDn)
+	builder.WriteString(", ")
+	builder.WriteString("dpop_bound_access_tokens=")
+	builder.WriteString(fmt.Sprintf("%v", o.DpopBoundAccessTokens))
+	builder.WriteString(", ")
+	builder.WriteString("require_pushed_authorization_requests=")
+	builder.WriteString(fmt.Sprintf("%v", o.RequirePushedAuthorizationRequests))
+	builder.WriteString(", ")
+	builder.WriteString("request_uris=")
+	builder.WriteString(fmt.Sprintf("%v", o.RequestUris))
+	builder.WriteString(", ")
+	builder.WriteString("client_name=")
+	builder.WriteString(o.ClientName)
+	builder.WriteString(", ")
+	builder.WriteString("redirect_uris=")
+	builder.WriteString(fmt.Sprintf("%v", o.RedirectUris))
+	builder.WriteString(", ")
+	builder.WriteString("grant_types=")
+	builder.WriteString(fmt.Sprintf("%v", o.GrantTypes))
+	builder.WriteString(", ")
+	builder.WriteString("response_types=")
+	builder.WriteString(fmt.Sprintf("%v", o.ResponseTypes))
+	builder.WriteString(", ")
+	builder.WriteString("registration_access_token_hash=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("first_party=")
+	builder.WriteString(fmt.Sprintf("%v", o.FirstParty))
+	builder.WriteString(", ")
+	builder.WriteString("logo_uri=")
+	builder.WriteString(o.LogoURI)
+	builder.WriteString(", ")
+	builder.WriteString("post_logout_redirect_uris=")
+	builder.WriteString(fmt.Sprintf("%v", o.PostLogoutRedirectUris))
+	builder.WriteString(", ")
+	builder.WriteString("allowed_scopes=")
+	builder.WriteString(fmt.Sprintf("%v", o.AllowedScopes))
+	builder.WriteString(", ")
+	builder.WriteString("token_claims=")
+	builder.WriteString(fmt.Sprintf("%v", o.TokenClaims))
 	builder.WriteByte(')')
 	return builder.String()
 }
 
-// GetID returns the ID of the Oauth2Client.
-func (o *Oauth2Client) GetID() string {
-	return o.ID.String()
-}
-
-// GetSecret returns the secret of the Oauth2Client.
-func (o *Oauth2Client) GetSecret() string {
-	return o.Secret
-}
-
-// GetDomain returns the domain of the Oauth2Client.
-func (o *Oauth2Client) GetDomain() string {
-	return o.Domain
-}
-
-// IsPublic returns whether the Oauth2Client is public.
-func (o *Oauth2Client) IsPublic() bool {
-	return o.Secret == ""
-}
-
-// GetUserID returns the user ID of the Oauth2Client.
-func (o *Oauth2Client) GetUserID() string {
-	return ""
-}
-
-// implement ClientPasswordVerifier
-func (o *Oauth2Client) VerifyPassword(password string) bool {
-	return o.Secret == password
-}
-
 // Oauth2Clients is a parsable slice of Oauth2Client.
 type Oauth2Clients []*Oauth2Client
@@ -4,6 +4,7 @@ package oauth2client
 
 import (
 	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/google/uuid"
 )
 
@@ -16,8 +17,68 @@ const (
 	FieldSecret = "secret"
 	// FieldDomain holds the string denoting the domain field in the database.
 	FieldDomain = "domain"
+	// FieldRequirePkce holds the string denoting the require_pkce field in the database.
+	FieldRequirePkce = "require_pkce"
+	// FieldExchangeAudiences holds the string denoting the exchange_audiences field in the database.
+	FieldExchangeAudiences = "exchange_audiences"
+	// FieldExchangeScopes holds the string denoting the exchange_scopes field in the database.
+	FieldExchangeScopes = "exchange_scopes"
+	// FieldJwtBearerIssuers holds the string denoting the jwt_bearer_issuers field in the database.
+	FieldJwtBearerIssuers = "jwt_bearer_issuers"
+	// FieldTokenEndpointAuthMethod holds the string denoting the token_endpoint_auth_method field in the database.
+	FieldTokenEndpointAuthMethod = "token_endpoint_auth_method"
+	// FieldJwks holds the string denoting the jwks field in the database.
+	FieldJwks = "jwks"
+	// FieldJwksURI holds the string denoting the jwks_uri field in the database.
+	FieldJwksURI = "jwks_uri"
+	// FieldTLSClientAuthSubjectDn holds the string denoting the tls_client_auth_subject_dn field in the database.
+	FieldTLSClientAuthSubjectDn = "tls_client_auth_subject_dn"
+	// FieldDpopBoundAccessTokens holds the string denoting the dpop_bound_access_tokens field in the database.
+	FieldDpopBoundAccessTokens = "dpop_bound_access_tokens"
+	// FieldRequirePushedAuthorizationRequests holds the string denoting the require_pushed_authorization_requests field in the database.
+	FieldRequirePushedAuthorizationRequests = "require_pushed_authorization_requests"
+	// FieldRequestUris holds the string denoting the request_uris field in the database.
+	FieldRequestUris = "request_uris"
+	// FieldClientName holds the string denoting the client_name field in the database.
+	FieldClientName = "client_name"
+	// FieldRedirectUris holds the string denoting the redirect_uris field in the database.
+	FieldRedirectUris = "redirect_uris"
+	// FieldGrantTypes holds the string denoting the grant_types field in the database.
+	FieldGrantTypes = "grant_types"
+	// FieldResponseTypes holds the string denoting the response_types field in the database.
+	FieldResponseTypes = "response_types"
+	// FieldRegistrationAccessTokenHash holds the string denoting the registration_access_token_hash field in the database.
+	FieldRegistrationAccessTokenHash = "registration_access_token_hash"
+	// FieldFirstParty holds the string denoting the first_party field in the database.
+	FieldFirstParty = "first_party"
+	// FieldLogoURI holds the string denoting the logo_uri field in the database.
+	FieldLogoURI = "logo_uri"
+	// FieldPostLogoutRedirectUris holds the string denoting the post_logout_redirect_uris field in the database.
+	FieldPostLogoutRedirectUris = "post_logout_redirect_uris"
+	// FieldAllowedScopes holds the string denoting the allowed_scopes field in the database.
+	FieldAllowedScopes = "allowed_scopes"
+	// FieldTokenClaims holds the string denoting the token_claims field in the database.
+	FieldTokenClaims = "token_claims"
+	// EdgeConsents holds the string denoting the consents edge name in mutations.
+	EdgeConsents = "consents"
+	// EdgeSecrets holds the string denoting the secrets edge name in mutations.
+	EdgeSecrets = "secrets"
 	// Table holds the table name of the oauth2client in the database.
 	Table = "oauth2clients"
+	// ConsentsTable is the table that holds the consents relation/edge.
+	ConsentsTable = "consents"
+	// ConsentsInverseTable is the table name for the Consent entity.
+	// It exists in this package in order to avoid circular dependency with the "consent" package.
+	ConsentsInverseTable = "consents"
+	// ConsentsColumn is the table column denoting the consents relation/edge.
+	ConsentsColumn = "oauth2client_consents"
+	// SecretsTable is the table that holds the secrets relation/edge.
+	SecretsTable = "client_secrets"
+	// SecretsInverseTable is the table name for the ClientSecret entity.
+	// It exists in this package in order to avoid circular dependency with the "clientsecret" package.
+	SecretsInverseTable = "client_secrets"
+	// SecretsColumn is the table column denoting the secrets relation/edge.
+	SecretsColumn = "oauth2client_secrets"
 )
 
 // Columns holds all SQL columns for oauth2client fields.
@@ -25,6 +86,27 @@ var Columns = []string{
 	FieldID,
 	FieldSecret,
 	FieldDomain,
+	FieldRequirePkce,
+	FieldExchangeAudiences,
+	FieldExchangeScopes,
+	FieldJwtBearerIssuers,
+	FieldTokenEndpointAuthMethod,
+	FieldJwks,
+	FieldJwksURI,
+	FieldTLSClientAuthSubjectDn,
+	FieldDpopBoundAccessTokens,
+	FieldRequirePushedAuthorizationRequests,
+	FieldRequestUris,
+	FieldClientName,
+	FieldRedirectUris,
+	FieldGrantTypes,
+	FieldResponseTypes,
+	FieldRegistrationAccessTokenHash,
+	FieldFirstParty,
+	FieldLogoURI,
+	FieldPostLogoutRedirectUris,
+	FieldAllowedScopes,
+	FieldTokenClaims,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -38,10 +120,16 @@ func ValidColumn(column string) bool {
 }
 
 var (
-	// SecretValidator is a validator for the "secret" field. It is called by the builders before save.
-	SecretValidator func(string) error
 	// DomainValidator is a validator for the "domain" field. It is called by the builders before save.
 	DomainValidator func(string) error
+	// DefaultRequirePkce holds the default value on creation for the "require_pkce" field.
+	DefaultRequirePkce bool
+	// DefaultDpopBoundAccessTokens holds the default value on creation for the "dpop_bound_access_tokens" field.
+	DefaultDpopBoundAccessTokens bool
+	// DefaultRequirePushedAuthorizationRequests holds the default value on creation for the "require_pushed_authorization_requests" field.
+	DefaultRequirePushedAuthorizationRequests bool
+	// DefaultFirstParty holds the default value on creation for the "first_party" field.
+	DefaultFirstParty bool
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() uuid.UUID
 )
@@ -63,3 +151,95 @@ func BySecret(opts ...sql.OrderTermOption) OrderOption {
 func ByDomain(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldDomain, opts...).ToFunc()
 }
+
+// ByRequirePkce orders the results by the require_pkce field.
+func ByRequirePkce(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRequirePkce, opts...).ToFunc()
+}
+
+// ByTokenEndpointAuthMethod orders the results by the token_endpoint_auth_method field.
+func ByTokenEndpointAuthMethod(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTokenEndpointAuthMethod, opts...).ToFunc()
+}
+
+// ByJwksURI orders the results by the jwks_uri field.
+func ByJwksURI(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldJwksURI, opts...).ToFunc()
+}
+
+// ByTLSClientAuthSubjectDn orders the results by the tls_client_auth_subject_dn field.
+func ByTLSClientAuthSubjectDn(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTLSClientAuthSubjectDn, opts...).ToFunc()
+}
+
+// ByDpopBoundAccessTokens orders the results by the dpop_bound_access_tokens field.
+func ByDpopBoundAccessTokens(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDpopBoundAccessTokens, opts...).ToFunc()
+}
+
+// ByRequirePushedAuthorizationRequests orders the results by the require_pushed_authorization_requests field.
+func ByRequirePushedAuthorizationRequests(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRequirePushedAuthorizationRequests, opts...).ToFunc()
+}
+
+// ByClientName orders the results by the client_name field.
+func ByClientName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldClientName, opts...).ToFunc()
+}
+
+// ByRegistrationAccessTokenHash orders the results by the registration_access_token_hash field.
+func ByRegistrationAccessTokenHash(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRegistrationAccessTokenHash, opts...).ToFunc()
+}
+
+// ByFirstParty orders the results by the first_party field.
+func ByFirstParty(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFirstParty, opts...).ToFunc()
+}
+
+// ByLogoURI orders the results by the logo_uri field.
+func ByLogoURI(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLogoURI, opts...).ToFunc()
+}
+
+// ByConsentsCount orders the results by consents count.
+func ByConsentsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newConsentsStep(), opts...)
+	}
+}
+
+// ByConsents orders the results by consents terms.
+func ByConsents(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newConsentsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// BySecretsCount orders the results by secrets count.
+func BySecretsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newSecretsStep(), opts...)
+	}
+}
+
+// BySecrets orders the results by secrets terms.
+func BySecrets(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSecretsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newConsentsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ConsentsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ConsentsTable, ConsentsColumn),
+	)
+}
+func newSecretsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SecretsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, SecretsTable, SecretsColumn),
+	)
+}
@@ -4,6 +4,7 @@ package oauth2client
 
 import (
 	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/byebyebymyai/oauth2-api/ent/predicate"
 	"github.com/google/uuid"
 )
@@ -63,6 +64,56 @@ func Domain(v string) predicate.Oauth2Client {
 	return predicate.Oauth2Client(sql.FieldEQ(FieldDomain, v))
 }
 
+// RequirePkce applies equality check predicate on the "require_pkce" field. It's identical to RequirePkceEQ.
+func RequirePkce(v bool) predicate.Oauth2Client {
+	return predicate.Oauth2Client(sql.FieldEQ(FieldRequirePkce, v))
+}
+
+// TokenEndpointAuthMethod applies equality check predicate on the "token_endpoint_auth_method" field. It's identical to TokenEndpointAuthMethodEQ.
+func TokenEndpointAuthMethod(v string) predicate.Oauth2Client {
+	return predicate.Oauth2Client(sql.FieldEQ(FieldTokenEndpointAuthMethod, v))
+}
+
+// JwksURI applies equality check predicate on the "jwks_uri" field. It's identical to JwksURIEQ.
+func JwksURI(v string) predicate.Oauth2Client {
+	return predicate.Oauth2Client(sql.FieldEQ(FieldJwksURI, v))
+}
+
+// TLSClientAuthSubjectDn applies equality check predicate on the "tls_client_auth_subject_dn" field. It's identical to TLSClientAuthSubjectDnEQ.
+func TLSClientAuthSubjectDn(v string) predicate.Oauth2Client {
+	return predicate.Oauth2Client(sql.FieldEQ(FieldTLSClientAuthSubjectDn, v))
+}
+
+// DpopBoundAccessTokens applies equality check predicate on the "dpop_bound_access_tokens" field. It's identical to DpopBoundAccessTokensEQ.
+func DpopBoundAccessTokens(v bool) predicate.Oauth2Client {
+	return predicate.Oauth2Client(sql.FieldEQ(FieldDpopBoundAccessTokens, v))
+}
+
+// RequirePushedAuthorizationRequests applies equality check predicate on the "require_pushed_authorization_requests" field. It's identical to RequirePushedAuthorizationRequestsEQ.
+func RequirePushedAuthorizationRequests(v bool) predicate.Oauth2Client {
+	return predicate.Oauth2Client(sql.FieldEQ(FieldRequirePushedAuthorizationRequests, v))
+}
+
+// ClientName applies equality check predicate on the "client_name" field. It's identical to ClientNameEQ.
+func ClientName(v string) predicate.Oauth2Client {
+	return predicate.Oauth2Client(sql.FieldEQ(FieldClientName, v))
+}
+
+// RegistrationAccessTokenHash applies equality check predicate on the "registration_access_token_hash" field. It's identical to RegistrationAccessTokenHashEQ.
+func RegistrationAccessTokenHash(v string) predicate.Oauth2Client {
+	return predicate.Oauth2Client(sql.FieldEQ(FieldRegistrationAccessTokenHash, v))
+}
+
+// FirstParty applies equality check predicate on the "first_party" field. It's identical to FirstPartyEQ.
+func FirstParty(v bool) predicate.Oauth2Client {
+	return predicate.Oauth2Client(sql.FieldEQ(FieldFirstParty, v))
+}
+
+// LogoURI applies equality check predicate on the "logo_uri" field. It's identical to LogoURIEQ.
+func LogoURI(v string) predicate.Oauth2Client {
+	return predicate.Oauth2Client(sql.FieldEQ(FieldLogoURI, v))
+}
+
 // SecretEQ applies the EQ predicate on the "secret" field.
 func SecretEQ(v string) predicate.Oauth2Client {
 	return predicate.Oauth2Client(sql.FieldEQ(FieldSecret, v))
@@ -118,6 +169,16 @@ func SecretHasSuffix(v string) predicate.Oauth2Client {
 	return predicate.Oauth2Client(sql.FieldHasSuffix(FieldSecret, v))
 }
 
+// SecretIsNil applies the IsNil predicate on the "secret" field.
+func SecretIsNil() predicate.Oauth2Client {
+	return predicate.Oauth2Client(sql.FieldIsNull(FieldSecret))
+}
+
+// SecretNotNil applies the NotNil predicate on the "secret" field.
+func SecretNotNil() predicate.Oauth2Client {
+	return predicate.Oauth2Client(sql.FieldNotNull(FieldSecret))
+}
+
 // SecretEqualFold applies the EqualFold predicate on the "secret" field.
 func SecretEqualFold(v string) predicate.Oauth2Client {
 	return predicate.Oauth2Client(sql.FieldEqualFold(FieldSecret, v))
@@ -193,6 +254,652 @@ func DomainContainsFold(v string) predicate.Oauth2Client {
 	return predicate.Oauth2Client(sql.FieldContainsFold(FieldDomain, v))
 }
 
+// RequirePkceEQ applies the EQ predicate on the "require_pkce" field.
+func RequirePkceEQ(v bool) predicate.Oauth2Client {
+	return predicate.Oauth2Client(sql.FieldEQ(FieldRequirePkce, v))
+}
+
+// RequirePkceNEQ applies the NEQ predicate on the "require_pkce" field.
+func RequirePkceNEQ(v bool) predicate.Oauth2Client {
+	return predicate.Oauth2Client(sql.FieldNEQ(FieldRequirePkce, v))
+}
+
+// ExchangeAudiencesIsNil applies the IsNil predicate on the "exchange_audiences" field.
+func ExchangeAudiencesIsNil() predicate.Oauth2Client {
+	return predicate.Oauth2Client(sql.FieldIsNull(FieldExchangeAudiences))
+}
+
+// ExchangeAudiencesNotNil applies the NotNil predicate on the "exchange_audiences" field.
+func ExchangeAudiencesNotNil() predicate.Oauth2Client {
+	return predicate.Oauth2Client(sql.FieldNotNull(FieldExchangeAudiences))
+}
+
+// ExchangeScopesIsNil applies the IsNil predicate on the "exchange_scopes" field.
+func ExchangeScopesIsNil() predicate.Oauth2Client {
+	return predicate.Oauth2Client(sql.FieldIsNull(FieldExchangeScopes))
+}
+
+// ExchangeScopesNotNil applies the NotNil predicate on the "exchange_scopes" field.
+func ExchangeScopesNotNil() predicate.Oauth2Client {
+	return predicate.Oauth2Client(sql.FieldNotNull(FieldExchangeScopes))
+}
+
+// JwtBearerIssuersIsNil applies the IsNil predicate on the "jwt_bearer_issuers" field.
+func JwtBearerIssuersIsNil() predicate.Oauth2Client {
+	return predicate.Oauth2Client(sql.FieldIsNull(FieldJwtBearerIssuers))
+}
+
+// JwtBearerIssuersNotNil applies the NotNil predicate on the "jwt_bearer_issuers" field.
+func JwtBearerIssuersNotNil() predicate.Oauth2Client {
+	return predicate.Oauth2Client(sql.FieldNotNull(FieldJwtBearerIssuers))
+}
+
+// TokenEndpointAuthMethodEQ applies the EQ predicate on the "token_endpoint_auth_method" field.
+func TokenEndpointAuthMethodEQ(v string) predicate.Oauth2Client {
+	return predicate.Oauth2Client(sql.FieldEQ(FieldTokenEndpointAuthMethod, v))
+}
+
+// TokenEndpointAuthMethodNEQ applies the NEQ predicate on the "token_endpoint_auth_method" field.
+func TokenEndpointAuthMethodNEQ(v string) predicate.Oauth2Client {
+	return predicate.Oauth2Client(sql.FieldNEQ(FieldTokenEndpointAuthMethod, v))
+}
+
+// TokenEndpointAuthMethodIn applies the In predicate on the "token_endpoint_auth_method" field.
+func TokenEndpointAuthMethodIn(vs ...string) predicate.Oauth2Client {
+	return predicate.Oauth2Client(sql.FieldIn(FieldTokenEndpointAuthMethod, vs...))
+}
+
+// TokenEndpointAuthMethodNotIn applies the NotIn predicate on the "token_endpoint_auth_method" field.
+func TokenEndpointAuthMethodNotIn(vs ...string) predicate.Oauth2Client {
+	return predicate.Oauth2Client(sql.FieldNotIn(FieldTokenEndpointAuthMethod, vs...))
+}
+
+// TokenEndpointAuthMethodGT applies the GT predicate on the "token_endpoint_auth_method" field.
+func TokenEndpointAuthMethodGT(v string) predicate.Oauth2Client {
+	return predicate.Oauth2Client(sql.FieldGT(FieldTokenEndpointAuthMethod, v))
+}
+
+// TokenEndpointAuthMethodGTE applies the GTE predicate on the "token_endpoint_auth_method" field.
+func TokenEndpointAuthMethodGTE(v string) predicate.Oauth2Client {
+	return predicate.Oauth2Client(sql.FieldGTE(FieldTokenEndpointAuthMethod, v))
+}
+
+// TokenEndpointAuthMethodLT applies the LT predicate on the "token_endpoint_auth_method" field.
+func TokenEndpointAuthMethodLT(v string) predicate.Oauth2Client {
+	return predicate.Oauth2Client(sql.FieldLT(FieldTokenEndpointAuthMethod, v))
+}
+
+// TokenEndpointAuthMethodLTE applies the LTE predicate on the "token_endpoint_auth_method" field.
+func TokenEndpointAuthMethodLTE(v string) predicate.Oauth2Client {
+	return predicate.Oauth2Client(sql.FieldLTE(FieldTokenEndpointAuthMethod, v))
+}
+
+// TokenEndpointAuthMethodContains applies the Contains predicate on the "token_endpoint_auth_method" field.
+func TokenEndpointAuthMethodContains(v string) predicate.Oauth2Client {
+	return predicate.Oauth2Client(sql.FieldContains(FieldTokenEndpointAuthMethod, v))
+}
+
+// TokenEndpointAuthMethodHasPrefix applies the HasPrefix predicate on the "token_endpoint_auth_method" field.
+func TokenEndpointAuthMethodHasPrefix(v string) predicate.Oauth2Client {
+	return predicate.Oauth2Client(sql.FieldHasPrefix(FieldTokenEndpointAuthMethod, v))
+}
+
+// TokenEndpointAuthMethodHasSuffix applies the HasSuffix predicate on the "token_endpoint_auth_method" field.
+func TokenEndpointAuthMethodHasSuffix(v string) predicate.Oauth2Client {
+	return predicate.Oauth2Client(sql.FieldHasSuffix(FieldTokenEndpointAuthMethod, v))
+}
+
+// TokenEndpointAuthMethodIsNil applies the IsNil predicate on the "token_endpoint_auth_method" field.
+func TokenEndpointAuthMethodIsNil() predicate.Oauth2Client {
+	return predicate.Oauth2Client(sql.FieldIsNull(FieldTokenEndpointAuthMethod))
+}
+
+// TokenEndpointAuthMethodNotNil applies the NotNil predicate on the "token_endpoint_auth_method" field.
+func TokenEndpointAuthMethodNotNil() predicate.Oauth2Client {
+	return predicate.Oauth2Client(sql.FieldNotNull(FieldTokenEndpointAuthMethod))
+}
+
+// TokenEndpointAuthMethodEqualFold applies the EqualFold predicate on the "token_endpoint_auth_method" field.
+func TokenEndpointAuthMethodEqualFold(v string) predicate.Oauth2Client {
+	return predicate.Oauth2Client(sql.FieldEqualFold(FieldTokenEndpointAuthMethod, v))
+}
+
+// TokenEndpointAuthMethodContainsFold applies the ContainsFold predicate on the "token_endpoint_auth_method" field.
+func TokenEndpointAuthMethodContainsFold(v string) predicate.Oauth2Client {
+	return predicate.Oauth2Client(sql.FieldContainsFold(FieldTokenEndpointAuthMethod, v))
+}
+
+// JwksIsNil applies the IsNil predicate on the "jwks" field.
+func JwksIsNil() predicate.Oauth2Client {
+	return predicate.Oauth2Client(sql.FieldIsNull(FieldJwks))
+}
+
+// JwksNotNil applies the NotNil predicate on the "jwks" field.
+func JwksNotNil() predicate.Oauth2Client {
+	return predicate.Oauth2Client(sql.FieldNotNull(FieldJwks))
+}
+
+// JwksURIEQ applies the EQ predicate on the "jwks_uri" field.
+func JwksURIEQ(v string) predicate.Oauth2Client {
+	return predicate.Oauth2Client(sql.FieldEQ(FieldJwksURI, v))
+}
+
+// JwksURINEQ applies the NEQ predicate on the "jwks_uri" field.
+func JwksURINEQ(v string) predicate.Oauth2Client {
+	return predicate.Oauth2Client(sql.FieldNEQ(FieldJwksURI, v))
+}
+
+// JwksURIIn applies the In predicate on the "jwks_uri" field.
+func JwksURIIn(vs ...string) predicate.Oauth2Client {
+	return predicate.Oauth2Client(sql.FieldIn(FieldJwksURI, vs...))
+}
+
+// JwksURINotIn applies the NotIn predicate on the "jwks_uri" field.
+func JwksURINotIn(vs ...string) predicate.Oauth2Client {
+	return predicate.Oauth2Client(sql.FieldNotIn(FieldJwksURI, vs...))
+}
+
+// JwksURIGT applies the GT predicate on the "jwks_uri" field.
+func JwksURIGT(v string) predicate.Oauth2Client {
+	return predicate.Oauth2Client(sql.FieldGT(FieldJwksURI, v))
+}
+
+// JwksURIGTE applies the GTE predicate on the "jwks_uri" field.
+func JwksURIGTE(v string) predicate.Oauth2Client {
+	return predicate.Oauth2Client(sql.FieldGTE(FieldJwksURI, v))
+}
+
+// JwksURILT applies the LT predicate on the "jwks_uri" field.
+func JwksURILT(v string) predicate.Oauth2Client {
+	return predicate.Oauth2Client(sql.FieldLT(FieldJwksURI, v))
+}
+
+// JwksURILTE applies the LTE predicate on the "jwks_uri" field.
+func JwksURILTE(v string) predicate.Oauth2Client {
+	return predicate.Oauth2Client(sql.FieldLTE(FieldJwksURI, v))
+}
+
+// JwksURIContains applies the Contains predicate on the "jwks_uri" field.
+func JwksURIContains(v string) predicate.Oauth2Client {
+	return predicate.Oauth2Client(sql.FieldContains(FieldJwksURI, v))
+}
+
+// JwksURIHasPrefix applies the HasPrefix predicate on the "jwks_uri" field.
+func JwksURIHasPrefix(v string) predicate.Oauth2Client {
+	return predicate.Oauth2Client(sql.FieldHasPrefix(FieldJwksURI, v))
+}
+
+// JwksURIHasSuffix applies the HasSuffix predicate on the "jwks_uri" field.
+func JwksURIHasSuffix(v string) predicate.Oauth2Client {
+	return predicate.Oauth2Client(sql.FieldHasSuffix(FieldJwksURI, v))
+}
+
+// JwksURIIsNil applies the IsNil predicate on the "jwks_uri" field.
+func JwksURIIsNil() predicate.Oauth2Client {
+	return predicate.Oauth2Client(sql.FieldIsNull(FieldJwksURI))
+}
+
+// JwksURINotNil applies the NotNil predicate on the "jwks_uri" field.
+func JwksURINotNil() predicate.Oauth2Client {
+	return predicate.Oauth2Client(sql.FieldNotNull(FieldJwksURI))
+}
+
+// JwksURIEqualFold applies the EqualFold predicate on the "jwks_uri" field.
+func JwksURIEqualFold(v string) predicate.Oauth2Client {
+	return predicate.Oauth2Client(sql.FieldEqualFold(FieldJwksURI, v))
+}
+
+// JwksURIContainsFold applies the ContainsFold predicate on the "jwks_uri" field.
+func JwksURIContainsFold(v string) predicate.Oauth2Client {
+	return predicate.Oauth2Client(sql.FieldContainsFold(FieldJwksURI, v))
+}
+
+// TLSClientAuthSubjectDnEQ applies the EQ predicate on the "tls_client_auth_subject_dn" field.
+func TLSClientAuthSubjectDnEQ(v string) predicate.Oauth2Client {
+	return predicate.Oauth2Client(sql.FieldEQ(FieldTLSClientAuthSubjectDn, v))
+}
+
+// TLSClientAuthSubjectDnNEQ applies the NEQ predicate on the "tls_client_auth_subject_dn" field.
+func TLSClientAuthSubjectDnNEQ(v string) predicate.Oauth2Client {
+	return predicate.Oauth2Client(sql.FieldNEQ(FieldTLSClientAuthSubjectDn, v))
+}
+
+// TLSClientAuthSubjectDnIn applies the In predicate on the "tls_client_auth_subject_dn" field.
+func TLSClientAuthSubjectDnIn(vs ...string) predicate.Oauth2Client {
+	return predicate.Oauth2Client(sql.FieldIn(FieldTLSClientAuthSubjectDn, vs...))
+}
+
+// TLSClientAuthSubjectDnNotIn applies the NotIn predicate on the "tls_client_auth_subject_dn" field.
+func TLSClientAuthSubjectDnNotIn(vs ...string) predicate.Oauth2Client {
+	return predicate.Oauth2Client(sql.FieldNotIn(FieldTLSClientAuthSubjectDn, vs...))
+}
+
+// TLSClientAuthSubjectDnGT applies the GT predicate on the "tls_client_auth_subject_dn" field.
+func TLSClientAuthSubjectDnGT(v string) predicate.Oauth2Client {
+	return predicate.Oauth2Client(sql.FieldGT(FieldTLSClientAuthSubjectDn, v))
+}
+
+// TLSClientAuthSubjectDnGTE applies the GTE predicate on the "tls_client_auth_subject_dn" field.
+func TLSClientAuthSubjectDnGTE(v string) predicate.Oauth2Client {
+	return predicate.Oauth2Client(sql.FieldGTE(FieldTLSClientAuthSubjectDn, v))
+}
+
+// TLSClientAuthSubjectDnLT applies the LT predicate on the "tls_client_auth_subject_dn" field.
+func TLSClientAuthSubjectDnLT(v string) predicate.Oauth2Client {
+	return predicate.Oauth2Client(sql.FieldLT(FieldTLSClientAuthSubjectDn, v))
+}
+
+// TLSClientAuthSubjectDnLTE applies the LTE predicate on the "tls_client_auth_subject_dn" field.
+func TLSClientAuthSubjectDnLTE(v string) predicate.Oauth2Client {
+	return predicate.Oauth2Client(sql.FieldLTE(FieldTLSClientAuthSubjectDn, v))
+}
+
+// TLSClientAuthSubjectDnContains applies the Contains predicate on the "tls_client_auth_subject_dn" field.
+func TLSClientAuthSubjectDnContains(v string) predicate.Oauth2Client {
+	return predicate.Oauth2Client(sql.FieldContains(FieldTLSClientAuthSubjectDn, v))
+}
+
+// TLSClientAuthSubjectDnHasPrefix applies the HasPrefix predicate on the "tls_client_auth_subject_dn" field.
+func TLSClientAuthSubjectDnHasPrefix(v string) predicate.Oauth2Client {
+	return predicate.Oauth2Client(sql.FieldHasPrefix(FieldTLSClientAuthSubjectDn, v))
+}
+
+// TLSClientAuthSubjectDnHasSuffix applies the HasSuffix predicate on the "tls_client_auth_subject_dn" field.
+func TLSClientAuthSubjectDnHasSuffix(v string) predicate.Oauth2Client {
+	return predicate.Oauth2Client(sql.FieldHasSuffix(FieldTLSClientAuthSubjectDn, v))
+}
+
+// TLSClientAuthSubjectDnIsNil applies the IsNil predicate on the "tls_client_auth_subject_dn" field.
+func TLSClientAuthSubjectDnIsNil() predicate.Oauth2Client {
+	return predicate.Oauth2Client(sql.FieldIsNull(FieldTLSClientAuthSubjectDn))
+}
+
+// TLSClientAuthSubjectDnNotNil applies the NotNil predicate on the "tls_client_auth_subject_dn" field.
+func TLSClientAuthSubjectDnNotNil() predicate.Oauth2Client {
+	return predicate.Oauth2Client(sql.FieldNotNull(FieldTLSClientAuthSubjectDn))
+}
+
+// TLSClientAuthSubjectDnEqualFold applies the EqualFold predicate on the "tls_client_auth_subject_dn" field.
+func TLSClientAuthSubjectDnEqualFold(v string) predicate.Oauth2Client {
+	return predicate.Oauth2Client(sql.FieldEqualFold(FieldTLSClientAuthSubjectDn, v))
+}
+
+// TLSClientAuthSubjectDnContainsFold applies the ContainsFold predicate on the "tls_client_auth_subject_dn" field.
+func TLSClientAuthSubjectDnContainsFold(v string) predicate.Oauth2Client {
+	return predicate.Oauth2Client(sql.FieldContainsFold(FieldTLSClientAuthSubjectDn, v))
+}
+
+// DpopBoundAccessTokensEQ applies the EQ predicate on the "dpop_bound_access_tokens" field.
+func DpopBoundAccessTokensEQ(v bool) predicate.Oauth2Client {
+	return predicate.Oauth2Client(sql.FieldEQ(FieldDpopBoundAccessTokens, v))
+}
+
+// DpopBoundAccessTokensNEQ applies the NEQ predicate on the "dpop_bound_access_tokens" field.
+func DpopBoundAccessTokensNEQ(v bool) predicate.Oauth2Client {
+	return predicate.Oauth2Client(sql.FieldNEQ(FieldDpopBoundAccessTokens, v))
+}
+
+// RequirePushedAuthorizationRequestsEQ applies the EQ predicate on the "require_pushed_authorization_requests" field.
+func RequirePushedAuthorizationRequestsEQ(v bool) predicate.Oauth2Client {
+	return predicate.Oauth2Client(sql.FieldEQ(FieldRequirePushedAuthorizationRequests, v))
+}
+
+// RequirePushedAuthorizationRequestsNEQ applies the NEQ predicate on the "require_pushed_authorization_requests" field.
+func RequirePushedAuthorizationRequestsNEQ(v bool) predicate.Oauth2Client {
+	return predicate.Oauth2Client(sql.FieldNEQ(FieldRequirePushedAuthorizationRequests, v))
+}
+
+// RequestUrisIsNil applies the IsNil predicate on the "request_uris" field.
+func RequestUrisIsNil() predicate.Oauth2Client {
+	return predicate.Oauth2Client(sql.FieldIsNull(FieldRequestUris))
+}
+
+// RequestUrisNotNil applies the NotNil predicate on the "request_uris" field.
+func RequestUrisNotNil() predicate.Oauth2Client {
+	return predicate.Oauth2Client(sql.FieldNotNull(FieldRequestUris))
+}
+
+// ClientNameEQ applies the EQ predicate on the "client_name" field.
+func ClientNameEQ(v string) predicate.Oauth2Client {
+	return predicate.Oauth2Client(sql.FieldEQ(FieldClientName, v))
+}
+
+// ClientNameNEQ applies the NEQ predicate on the "client_name" field.
+func ClientNameNEQ(v string) predicate.Oauth2Client {
+	return predicate.Oauth2Client(sql.FieldNEQ(FieldClientName, v))
+}
+
+// ClientNameIn applies the In predicate on the "client_name" field.
+func ClientNameIn(vs ...string) predicate.Oauth2Client {
+	return predicate.Oauth2Client(sql.FieldIn(FieldClientName, vs...))
+}
+
+// ClientNameNotIn applies the NotIn predicate on the "client_name" field.
+func ClientNameNotIn(vs ...string) predicate.Oauth2Client {
+	return predicate.Oauth2Client(sql.FieldNotIn(FieldClientName, vs...))
+}
+
+// ClientNameGT applies the GT predicate on the "client_name" field.
+func ClientNameGT(v string) predicate.Oauth2Client {
+	return predicate.Oauth2Client(sql.FieldGT(FieldClientName, v))
+}
+
+// ClientNameGTE applies the GTE predicate on the "client_name" field.
+func ClientNameGTE(v string) predicate.Oauth2Client {
+	return predicate.Oauth2Client(sql.FieldGTE(FieldClientName, v))
+}
+
+// ClientNameLT applies the LT predicate on the "client_name" field.
+func ClientNameLT(v string) predicate.Oauth2Client {
+	return predicate.Oauth2Client(sql.FieldLT(FieldClientName, v))
+}
+
+// ClientNameLTE applies the LTE predicate on the "client_name" field.
+func ClientNameLTE(v string) predicate.Oauth2Client {
+	return predicate.Oauth2Client(sql.FieldLTE(FieldClientName, v))
+}
+
+// ClientNameContains applies the Contains predicate on the "client_name" field.
+func ClientNameContains(v string) predicate.Oauth2Client {
+	return predicate.Oauth2Client(sql.FieldContains(FieldClientName, v))
+}
+
+// ClientNameHasPrefix applies the HasPrefix predicate on the "client_name" field.
+func ClientNameHasPrefix(v string) predicate.Oauth2Client {
+	return predicate.Oauth2Client(sql.FieldHasPrefix(FieldClientName, v))
+}
+
+// ClientNameHasSuffix applies the HasSuffix predicate on the "client_name" field.
+func ClientNameHasSuffix(v string) predicate.Oauth2Client {
+	return predicate.Oauth2Client(sql.FieldHasSuffix(FieldClientName, v))
+}
+
+// ClientNameIsNil applies the IsNil predicate on the "client_name" field.
+func ClientNameIsNil() predicate.Oauth2Client {
+	return predicate.Oauth2Client(sql.FieldIsNull(FieldClientName))
+}
+
+// ClientNameNotNil applies the NotNil predicate on the "client_name" field.
+func ClientNameNotNil() predicate.Oauth2Client {
+	return predicate.Oauth2Client(sql.FieldNotNull(FieldClientName))
+}
+
+// ClientNameEqualFold applies the EqualFold predicate on the "client_name" field.
+func ClientNameEqualFold(v string) predicate.Oauth2Client {
+	return predicate.Oauth2Client(sql.FieldEqualFold(FieldClientName, v))
+}
+
+// ClientNameContainsFold applies the ContainsFold predicate on the "client_name" field.
+func ClientNameContainsFold(v string) predicate.Oauth2Client {
+	return predicate.Oauth2Client(sql.FieldContainsFold(FieldClientName, v))
+}
+
+// RedirectUrisIsNil applies the IsNil predicate on the "redirect_uris" field.
+func RedirectUrisIsNil() predicate.Oauth2Client {
+	return predicate.Oauth2Client(sql.FieldIsNull(FieldRedirectUris))
+}
+
+// RedirectUrisNotNil applies the NotNil predicate on the "redirect_uris" field.
+func RedirectUrisNotNil() predicate.Oauth2Client {
+	return predicate.Oauth2Client(sql.FieldNotNull(FieldRedirectUris))
+}
+
+// GrantTypesIsNil applies the IsNil predicate on the "grant_types" field.
+func GrantTypesIsNil() predicate.Oauth2Client {
+	return predicate.Oauth2Client(sql.FieldIsNull(FieldGrantTypes))
+}
+
+// GrantTypesNotNil applies the NotNil predicate on the "grant_types" field.
+func GrantTypesNotNil() predicate.Oauth2Client {
+	return predicate.Oauth2Client(sql.FieldNotNull(FieldGrantTypes))
+}
+
+// ResponseTypesIsNil applies the IsNil predicate on the "response_types" field.
+func ResponseTypesIsNil() predicate.Oauth2Client {
+	return predicate.Oauth2Client(sql.FieldIsNull(FieldResponseTypes))
+}
+
+// ResponseTypesNotNil applies the NotNil predicate on the "response_types" field.
+func ResponseTypesNotNil() predicate.Oauth2Client {
+	return predicate.Oauth2Client(sql.FieldNotNull(FieldResponseTypes))
+}
+
+// RegistrationAccessTokenHashEQ applies the EQ predicate on the "registration_access_token_hash" field.
+func RegistrationAccessTokenHashEQ(v string) predicate.Oauth2Client {
+	return predicate.Oauth2Client(sql.FieldEQ(FieldRegistrationAccessTokenHash, v))
+}
+
+// RegistrationAccessTokenHashNEQ applies the NEQ predicate on the "registration_access_token_hash" field.
+func RegistrationAccessTokenHashNEQ(v string) predicate.Oauth2Client {
+	return predicate.Oauth2Client(sql.FieldNEQ(FieldRegistrationAccessTokenHash, v))
+}
+
+// RegistrationAccessTokenHashIn applies the In predicate on the "registration_access_token_hash" field.
+func RegistrationAccessTokenHashIn(vs ...string) predicate.Oauth2Client {
+	return predicate.Oauth2Client(sql.FieldIn(FieldRegistrationAccessTokenHash, vs...))
+}
+
+// RegistrationAccessTokenHashNotIn applies the NotIn predicate on the "registration_access_token_hash" field.
+func RegistrationAccessTokenHashNotIn(vs ...string) predicate.Oauth2Client {
+	return predicate.Oauth2Client(sql.FieldNotIn(FieldRegistrationAccessTokenHash, vs...))
+}
+
+// RegistrationAccessTokenHashGT applies the GT predicate on the "registration_access_token_hash" field.
+func RegistrationAccessTokenHashGT(v string) predicate.Oauth2Client {
+	return predicate.Oauth2Client(sql.FieldGT(FieldRegistrationAccessTokenHash, v))
+}
+
+// RegistrationAccessTokenHashGTE applies the GTE predicate on the "registration_access_token_hash" field.
+func RegistrationAccessTokenHashGTE(v string) predicate.Oauth2Client {
+	return predicate.Oauth2Client(sql.FieldGTE(FieldRegistrationAccessTokenHash, v))
+}
+
+// RegistrationAccessTokenHashLT applies the LT predicate on the "registration_access_token_hash" field.
+func RegistrationAccessTokenHashLT(v string) predicate.Oauth2Client {
+	return predicate.Oauth2Client(sql.FieldLT(FieldRegistrationAccessTokenHash, v))
+}
+
+// RegistrationAccessTokenHashLTE applies the LTE predicate on the "registration_access_token_hash" field.
+func RegistrationAccessTokenHashLTE(v string) predicate.Oauth2Client {
+	return predicate.Oauth2Client(sql.FieldLTE(FieldRegistrationAccessTokenHash, v))
+}
+
+// RegistrationAccessTokenHashContains applies the Contains predicate on the "registration_access_token_hash" field.
+func RegistrationAccessTokenHashContains(v string) predicate.Oauth2Client {
+	return predicate.Oauth2Client(sql.FieldContains(FieldRegistrationAccessTokenHash, v))
+}
+
+// RegistrationAccessTokenHashHasPrefix applies the HasPrefix predicate on the "registration_access_token_hash" field.
+func RegistrationAccessTokenHashHasPrefix(v string) predicate.Oauth2Client {
+	return predicate.Oauth2Client(sql.FieldHasPrefix(FieldRegistrationAccessTokenHash, v))
+}
+
+// RegistrationAccessTokenHashHasSuffix applies the HasSuffix predicate on the "registration_access_token_hash" field.
+func RegistrationAccessTokenHashHasSuffix(v string) predicate.Oauth2Client {
+	return predicate.Oauth2Client(sql.FieldHasSuffix(FieldRegistrationAccessTokenHash, v))
+}
+
+// RegistrationAccessTokenHashIsNil applies the IsNil predicate on the "registration_access_token_hash" field.
+func RegistrationAccessTokenHashIsNil() predicate.Oauth2Client {
+	return predicate.Oauth2Client(sql.FieldIsNull(FieldRegistrationAccessTokenHash))
+}
+
+// RegistrationAccessTokenHashNotNil applies the NotNil predicate on the "registration_access_token_hash" field.
+func RegistrationAccessTokenHashNotNil() predicate.Oauth2Client {
+	return predicate.Oauth2Client(sql.FieldNotNull(FieldRegistrationAccessTokenHash))
+}
+
+// RegistrationAccessTokenHashEqualFold applies the EqualFold predicate on the "registration_access_token_hash" field.
+func RegistrationAccessTokenHashEqualFold(v string) predicate.Oauth2Client {
+	return predicate.Oauth2Client(sql.FieldEqualFold(FieldRegistrationAccessTokenHash, v))
+}
+
+// RegistrationAccessTokenHashContainsFold applies the ContainsFold predicate on the "registration_access_token_hash" field.
+func RegistrationAccessTokenHashContainsFold(v string) predicate.Oauth2Client {
+	return predicate.Oauth2Client(sql.FieldContainsFold(FieldRegistrationAccessTokenHash, v))
+}
+
+// FirstPartyEQ applies the EQ predicate on the "first_party" field.
+func FirstPartyEQ(v bool) predicate.Oauth2Client {
+	return predicate.Oauth2Client(sql.FieldEQ(FieldFirstParty, v))
+}
+
+// FirstPartyNEQ applies the NEQ predicate on the "first_party" field.
+func FirstPartyNEQ(v bool) predicate.Oauth2Client {
+	return predicate.Oauth2Client(sql.FieldNEQ(FieldFirstParty, v))
+}
+
+// LogoURIEQ applies the EQ predicate on the "logo_uri" field.
+func LogoURIEQ(v string) predicate.Oauth2Client {
+	return predicate.Oauth2Client(sql.FieldEQ(FieldLogoURI, v))
+}
+
+// LogoURINEQ applies the NEQ predicate on the "logo_uri" field.
+func LogoURINEQ(v string) predicate.Oauth2Client {
+	return predicate.Oauth2Client(sql.FieldNEQ(FieldLogoURI, v))
+}
+
+// LogoURIIn applies the In predicate on the "logo_uri" field.
+func LogoURIIn(vs ...string) predicate.Oauth2Client {
+	return predicate.Oauth2Client(sql.FieldIn(FieldLogoURI, vs...))
+}
+
+// LogoURINotIn applies the NotIn predicate on the "logo_uri" field.
+func LogoURINotIn(vs ...string) predicate.Oauth2Client {
+	return predicate.Oauth2Client(sql.FieldNotIn(FieldLogoURI, vs...))
+}
+
+// LogoURIGT applies the GT predicate on the "logo_uri" field.
+func LogoURIGT(v string) predicate.Oauth2Client {
+	return predicate.Oauth2Client(sql.FieldGT(FieldLogoURI, v))
+}
+
+// LogoURIGTE applies the GTE predicate on the "logo_uri" field.
+func LogoURIGTE(v string) predicate.Oauth2Client {
+	return predicate.Oauth2Client(sql.FieldGTE(FieldLogoURI, v))
+}
+
+// LogoURILT applies the LT predicate on the "logo_uri" field.
+func LogoURILT(v string) predicate.Oauth2Client {
+	return predicate.Oauth2Client(sql.FieldLT(FieldLogoURI, v))
+}
+
+// LogoURILTE applies the LTE predicate on the "logo_uri" field.
+func LogoURILTE(v string) predicate.Oauth2Client {
+	return predicate.Oauth2Client(sql.FieldLTE(FieldLogoURI, v))
+}
+
+// LogoURIContains applies the Contains predicate on the "logo_uri" field.
+func LogoURIContains(v string) predicate.Oauth2Client {
+	return predicate.Oauth2Client(sql.FieldContains(FieldLogoURI, v))
+}
+
+// LogoURIHasPrefix applies the HasPrefix predicate on the "logo_uri" field.
+func LogoURIHasPrefix(v string) predicate.Oauth2Client {
+	return predicate.Oauth2Client(sql.FieldHasPrefix(FieldLogoURI, v))
+}
+
+// LogoURIHasSuffix applies the HasSuffix predicate on the "logo_uri" field.
+func LogoURIHasSuffix(v string) predicate.Oauth2Client {
+	return predicate.Oauth2Client(sql.FieldHasSuffix(FieldLogoURI, v))
+}
+
+// LogoURIIsNil applies the IsNil predicate on the "logo_uri" field.
+func LogoURIIsNil() predicate.Oauth2Client {
+	return predicate.Oauth2Client(sql.FieldIsNull(FieldLogoURI))
+}
+
+// LogoURINotNil applies the NotNil predicate on the "logo_uri" field.
+func LogoURINotNil() predicate.Oauth2Client {
+	return predicate.Oauth2Client(sql.FieldNotNull(FieldLogoURI))
+}
+
+// LogoURIEqualFold applies the EqualFold predicate on the "logo_uri" field.
+func LogoURIEqualFold(v string) predicate.Oauth2Client {
+	return predicate.Oauth2Client(sql.FieldEqualFold(FieldLogoURI, v))
+}
+
+// LogoURIContainsFold applies the ContainsFold predicate on the "logo_uri" field.
+func LogoURIContainsFold(v string) predicate.Oauth2Client {
+	return predicate.Oauth2Client(sql.FieldContainsFold(FieldLogoURI, v))
+}
+
+// PostLogoutRedirectUrisIsNil applies the IsNil predicate on the "post_logout_redirect_uris" field.
+func PostLogoutRedirectUrisIsNil() predicate.Oauth2Client {
+	return predicate.Oauth2Client(sql.FieldIsNull(FieldPostLogoutRedirectUris))
+}
+
+// PostLogoutRedirectUrisNotNil applies the NotNil predicate on the "post_logout_redirect_uris" field.
+func PostLogoutRedirectUrisNotNil() predicate.Oauth2Client {
+	return predicate.Oauth2Client(sql.FieldNotNull(FieldPostLogoutRedirectUris))
+}
+
+// AllowedScopesIsNil applies the IsNil predicate on the "allowed_scopes" field.
+func AllowedScopesIsNil() predicate.Oauth2Client {
+	return predicate.Oauth2Client(sql.FieldIsNull(FieldAllowedScopes))
+}
+
+// AllowedScopesNotNil applies the NotNil predicate on the "allowed_scopes" field.
+func AllowedScopesNotNil() predicate.Oauth2Client {
+	return predicate.Oauth2Client(sql.FieldNotNull(FieldAllowedScopes))
+}
+
+// TokenClaimsIsNil applies the IsNil predicate on the "token_claims" field.
+func TokenClaimsIsNil() predicate.Oauth2Client {
+	return predicate.Oauth2Client(sql.FieldIsNull(FieldTokenClaims))
+}
+
+// TokenClaimsNotNil applies the NotNil predicate on the "token_claims" field.
+func TokenClaimsNotNil() predicate.Oauth2Client {
+	return predicate.Oauth2Client(sql.FieldNotNull(FieldTokenClaims))
+}
+
+// HasConsents applies the HasEdge predicate on the "consents" edge.
+func HasConsents() predicate.Oauth2Client {
+	return predicate.Oauth2Client(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ConsentsTable, ConsentsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasConsentsWith applies the HasEdge predicate on the "consents" edge with a given conditions (other predicates).
+func HasConsentsWith(preds ...predicate.Consent) predicate.Oauth2Client {
+	return predicate.Oauth2Client(func(s *sql.Selector) {
+		step := newConsentsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasSecrets applies the HasEdge predicate on the "secrets" edge.
+func HasSecrets() predicate.Oauth2Client {
+	return predicate.Oauth2Client(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, SecretsTable, SecretsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSecretsWith applies the HasEdge predicate on the "secrets" edge with a given conditions (other predicates).
+func HasSecretsWith(preds ...predicate.ClientSecret) predicate.Oauth2Client {
+	return predicate.Oauth2Client(func(s *sql.Selector) {
+		step := newSecretsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Oauth2Client) predicate.Oauth2Client {
 	return predicate.Oauth2Client(sql.AndPredicates(predicates...))
@@ -4,11 +4,14 @@ package ent
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/byebyebymyai/oauth2-api/ent/clientsecret"
+	"github.com/byebyebymyai/oauth2-api/ent/consent"
 	"github.com/byebyebymyai/oauth2-api/ent/oauth2client"
 	"github.com/google/uuid"
 )
@@ -26,12 +29,226 @@ func (oc *Oauth2ClientCreate) SetSecret(s string) *Oauth2ClientCreate {
 	return oc
 }
 
+// SetNillableSecret sets the "secret" field if the given value is not nil.
+func (oc *Oauth2ClientCreate) SetNillableSecret(s *string) *Oauth2ClientCreate {
+	if s != nil {
+		oc.SetSecret(*s)
+	}
+	return oc
+}
+
 // SetDomain sets the "domain" field.
 func (oc *Oauth2ClientCreate) SetDomain(s string) *Oauth2ClientCreate {
 	oc.mutation.SetDomain(s)
 	return oc
 }
 
+// SetRequirePkce sets the "require_pkce" field.
+func (oc *Oauth2ClientCreate) SetRequirePkce(b bool) *Oauth2ClientCreate {
+	oc.mutation.SetRequirePkce(b)
+	return oc
+}
+
+// SetNillableRequirePkce sets the "require_pkce" field if the given value is not nil.
+func (oc *Oauth2ClientCreate) SetNillableRequirePkce(b *bool) *Oauth2ClientCreate {
+	if b != nil {
+		oc.SetRequirePkce(*b)
+	}
+	return oc
+}
+
+// SetExchangeAudiences sets the "exchange_audiences" field.
+func (oc *Oauth2ClientCreate) SetExchangeAudiences(s []string) *Oauth2ClientCreate {
+	oc.mutation.SetExchangeAudiences(s)
+	return oc
+}
+
+// SetExchangeScopes sets the "exchange_scopes" field.
+func (oc *Oauth2ClientCreate) SetExchangeScopes(s []string) *Oauth2ClientCreate {
+	oc.mutation.SetExchangeScopes(s)
+	return oc
+}
+
+// SetJwtBearerIssuers sets the "jwt_bearer_issuers" field.
+func (oc *Oauth2ClientCreate) SetJwtBearerIssuers(mm map[string]json.RawMessage) *Oauth2ClientCreate {
+	oc.mutation.SetJwtBearerIssuers(mm)
+	return oc
+}
+
+// SetTokenEndpointAuthMethod sets the "token_endpoint_auth_method" field.
+func (oc *Oauth2ClientCreate) SetTokenEndpointAuthMethod(s string) *Oauth2ClientCreate {
+	oc.mutation.SetTokenEndpointAuthMethod(s)
+	return oc
+}
+
+// SetNillableTokenEndpointAuthMethod sets the "token_endpoint_auth_method" field if the given value is not nil.
+func (oc *Oauth2ClientCreate) SetNillableTokenEndpointAuthMethod(s *string) *Oauth2ClientCreate {
+	if s != nil {
+		oc.SetTokenEndpointAuthMethod(*s)
+	}
+	return oc
+}
+
+// SetJwks sets the "jwks" field.
+func (oc *Oauth2ClientCreate) SetJwks(jm json.RawMessage) *Oauth2ClientCreate {
+	oc.mutation.SetJwks(jm)
+	return oc
+}
+
+// SetJwksURI sets the "jwks_uri" field.
+func (oc *Oauth2ClientCreate) SetJwksURI(s string) *Oauth2ClientCreate {
+	oc.mutation.SetJwksURI(s)
+	return oc
+}
+
+// SetNillableJwksURI sets the "jwks_uri" field if the given value is not nil.
+func (oc *Oauth2ClientCreate) SetNillableJwksURI(s *string) *Oauth2ClientCreate {
+	if s != nil {
+		oc.SetJwksURI(*s)
+	}
+	return oc
+}
+
+// SetTLSClientAuthSubjectDn sets the "tls_client_auth_subject_dn" field.
+func (oc *Oauth2ClientCreate) SetTLSClientAuthSubjectDn(s string) *Oauth2ClientCreate {
+	oc.mutation.SetTLSClientAuthSubjectDn(s)
+	return oc
+}
+
+// SetNillableTLSClientAuthSubjectDn sets the "tls_client_auth_subject_dn" field if the given value is not nil.
+func (oc *Oauth2ClientCreate) SetNillableTLSClientAuthSubjectDn(s *string) *Oauth2ClientCreate {
+	if s != nil {
+		oc.SetTLSClientAuthSubjectDn(*s)
+	}
+	return oc
+}
+
+// SetDpopBoundAccessTokens sets the "dpop_bound_access_tokens" field.
+func (oc *Oauth2ClientCreate) SetDpopBoundAccessTokens(b bool) *Oauth2ClientCreate {
+	oc.mutation.SetDpopBoundAccessTokens(b)
+	return oc
+}
+
+// SetNillableDpopBoundAccessTokens sets the "dpop_bound_access_tokens" field if the given value is not nil.
+func (oc *Oauth2ClientCreate) SetNillableDpopBoundAccessTokens(b *bool) *Oauth2ClientCreate {
+	if b != nil {
+		oc.SetDpopBoundAccessTokens(*b)
+	}
+	return oc
+}
+
+// SetRequirePushedAuthorizationRequests sets the "require_pushed_authorization_requests" field.
+func (oc *Oauth2ClientCreate) SetRequirePushedAuthorizationRequests(b bool) *Oauth2ClientCreate {
+	oc.mutation.SetRequirePushedAuthorizationRequests(b)
+	return oc
+}
+
+// SetNillableRequirePushedAuthorizationRequests sets the "require_pushed_authorization_requests" field if the given value is not nil.
+func (oc *Oauth2ClientCreate) SetNillableRequirePushedAuthorizationRequests(b *bool) *Oauth2ClientCreate {
+	if b != nil {
+		oc.SetRequirePushedAuthorizationRequests(*b)
+	}
+	return oc
+}
+
+// SetRequestUris sets the "request_uris" field.
+func (oc *Oauth2ClientCreate) SetRequestUris(s []string) *Oauth2ClientCreate {
+	oc.mutation.SetRequestUris(s)
+	return oc
+}
+
+// SetClientName sets the "client_name" field.
+func (oc *Oauth2ClientCreate) SetClientName(s string) *Oauth2ClientCreate {
+	oc.mutation.SetClientName(s)
+	return oc
+}
+
+// SetNillableClientName sets the "client_name" field if the given value is not nil.
+func (oc *Oauth2ClientCreate) SetNillableClientName(s *string) *Oauth2ClientCreate {
+	if s != nil {
+		oc.SetClientName(*s)
+	}
+	return oc
+}
+
+// SetRedirectUris sets the "redirect_uris" field.
+func (oc *Oauth2ClientCreate) SetRedirectUris(s []string) *Oauth2ClientCreate {
+	oc.mutation.SetRedirectUris(s)
+	return oc
+}
+
+// SetGrantTypes sets the "grant_types" field.
+func (oc *Oauth2ClientCreate) SetGrantTypes(s []string) *Oauth2ClientCreate {
+	oc.mutation.SetGrantTypes(s)
+	return oc
+}
+
+// SetResponseTypes sets the "response_types" field.
+func (oc *Oauth2ClientCreate) SetResponseTypes(s []string) *Oauth2ClientCreate {
+	oc.mutation.SetResponseTypes(s)
+	return oc
+}
+
+// SetRegistrationAccessTokenHash sets the "registration_access_token_hash" field.
+func (oc *Oauth2ClientCreate) SetRegistrationAccessTokenHash(s string) *Oauth2ClientCreate {
+	oc.mutation.SetRegistrationAccessTokenHash(s)
+	return oc
+}
+
+// SetNillableRegistrationAccessTokenHash sets the "registration_access_token_hash" field if the given value is not nil.
+func (oc *Oauth2ClientCreate) SetNillableRegistrationAccessTokenHash(s *string) *Oauth2ClientCreate {
+	if s != nil {
+		oc.SetRegistrationAccessTokenHash(*s)
+	}
+	return oc
+}
+
+// SetFirstParty sets the "first_party" field.
+func (oc *Oauth2ClientCreate) SetFirstParty(b bool) *Oauth2ClientCreate {
+	oc.mutation.SetFirstParty(b)
+	return oc
+}
+
+// SetNillableFirstParty sets the "first_party" field if the given value is not nil.
+func (oc *Oauth2ClientCreate) SetNillableFirstParty(b *bool) *Oauth2ClientCreate {
+	if b != nil {
+		oc.SetFirstParty(*b)
+	}
+	return oc
+}
+
+// SetLogoURI sets the "logo_uri" field.
+func (oc *Oauth2ClientCreate) SetLogoURI(s string) *Oauth2ClientCreate {
+	oc.mutation.SetLogoURI(s)
+	return oc
+}
+
+// SetNillableLogoURI sets the "logo_uri" field if the given value is not nil.
+func (oc *Oauth2ClientCreate) SetNillableLogoURI(s *string) *Oauth2ClientCreate {
+	if s != nil {
+		oc.SetLogoURI(*s)
+	}
+	return oc
+}
+
+// SetPostLogoutRedirectUris sets the "post_logout_redirect_uris" field.
+func (oc *Oauth2ClientCreate) SetPostLogoutRedirectUris(s []string) *Oauth2ClientCreate {
+	oc.mutation.SetPostLogoutRedirectUris(s)
+	return oc
+}
+
+// SetAllowedScopes sets the "allowed_scopes" field.
+func (oc *Oauth2ClientCreate) SetAllowedScopes(s []string) *Oauth2ClientCreate {
+	oc.mutation.SetAllowedScopes(s)
+	return oc
+}
+
+// SetTokenClaims sets the "token_claims" field.
+func (oc *Oauth2ClientCreate) SetTokenClaims(s []string) *Oauth2ClientCreate {
+	oc.mutation.SetTokenClaims(s)
+	return oc
+}
+
 // SetID sets the "id" field.
 func (oc *Oauth2ClientCreate) SetID(u uuid.UUID) *Oauth2ClientCreate {
 	oc.mutation.SetID(u)
@@ -46,6 +263,36 @@ func (oc *Oauth2ClientCreate) SetNillableID(u *uuid.UUID) *Oauth2ClientCreate {
 	return oc
 }
 
+// AddConsentIDs adds the "consents" edge to the Consent entity by IDs.
+func (oc *Oauth2ClientCreate) AddConsentIDs(ids ...uuid.UUID) *Oauth2ClientCreate {
+	oc.mutation.AddConsentIDs(ids...)
+	return oc
+}
+
+// AddConsents adds the "consents" edges to the Consent entity.
+func (oc *Oauth2ClientCreate) AddConsents(c ...*Consent) *Oauth2ClientCreate {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return oc.AddConsentIDs(ids...)
+}
+
+// AddSecretIDs adds the "secrets" edge to the ClientSecret entity by IDs.
+func (oc *Oauth2ClientCreate) AddSecretIDs(ids ...uuid.UUID) *Oauth2ClientCreate {
+	oc.mutation.AddSecretIDs(ids...)
+	return oc
+}
+
+// AddSecrets adds the "secrets" edges to the ClientSecret entity.
+func (oc *Oauth2ClientCreate) AddSecrets(c ...*ClientSecret) *Oauth2ClientCreate {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return oc.AddSecretIDs(ids...)
+}
+
 // Mutation returns the Oauth2ClientMutation object of the builder.
 func (oc *Oauth2ClientCreate) Mutation() *Oauth2ClientMutation {
 	return oc.mutation
@@ -81,6 +328,22 @@ func (oc *Oauth2ClientCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (oc *Oauth2ClientCreate) defaults() {
+	if _, ok := oc.mutation.RequirePkce(); !ok {
+		v := oauth2client.DefaultRequirePkce
+		oc.mutation.SetRequirePkce(v)
+	}
+	if _, ok := oc.mutation.DpopBoundAccessTokens(); !ok {
+		v := oauth2client.DefaultDpopBoundAccessTokens
+		oc.mutation.SetDpopBoundAccessTokens(v)
+	}
+	if _, ok := oc.mutation.RequirePushedAuthorizationRequests(); !ok {
+		v := oauth2client.DefaultRequirePushedAuthorizationRequests
+		oc.mutation.SetRequirePushedAuthorizationRequests(v)
+	}
+	if _, ok := oc.mutation.FirstParty(); !ok {
+		v := oauth2client.DefaultFirstParty
+		oc.mutation.SetFirstParty(v)
+	}
 	if _, ok := oc.mutation.ID(); !ok {
 		v := oauth2client.DefaultID()
 		oc.mutation.SetID(v)
@@ -89,14 +352,6 @@ func (oc *Oauth2ClientCreate) defaults() {
 
 // check runs all checks and user-defined validators on the builder.
 func (oc *Oauth2ClientCreate) check() error {
-	if _, ok := oc.mutation.Secret(); !ok {
-		return &ValidationError{Name: "secret", err: errors.New(`ent: missing required field "Oauth2Client.secret"`)}
-	}
-	if v, ok := oc.mutation.Secret(); ok {
-		if err := oauth2client.SecretValidator(v); err != nil {
-			return &ValidationError{Name: "secret", err: fmt.Errorf(`ent: validator failed for field "Oauth2Client.secret": %w`, err)}
-		}
-	}
 	if _, ok := oc.mutation.Domain(); !ok {
 		return &ValidationError{Name: "domain", err: errors.New(`ent: missing required field "Oauth2Client.domain"`)}
 	}
@@ -105,6 +360,18 @@ func (oc *Oauth2ClientCreate) check() error {
 			return &ValidationError{Name: "domain", err: fmt.Errorf(`ent: validator failed for field "Oauth2Client.domain": %w`, err)}
 		}
 	}
+	if _, ok := oc.mutation.RequirePkce(); !ok {
+		return &ValidationError{Name: "require_pkce", err: errors.New(`ent: missing required field "Oauth2Client.require_pkce"`)}
+	}
+	if _, ok := oc.mutation.DpopBoundAccessTokens(); !ok {
+		return &ValidationError{Name: "dpop_bound_access_tokens", err: errors.New(`ent: missing required field "Oauth2Client.dpop_bound_access_tokens"`)}
+	}
+	if _, ok := oc.mutation.RequirePushedAuthorizationRequests(); !ok {
+		return &ValidationError{Name: "require_pushed_authorization_requests", err: errors.New(`ent: missing required field "Oauth2Client.require_pushed_authorization_requests"`)}
+	}
+	if _, ok := oc.mutation.FirstParty(); !ok {
+		return &ValidationError{Name: "first_party", err: errors.New(`ent: missing required field "Oauth2Client.first_party"`)}
+	}
 	return nil
 }
 
@@ -148,6 +415,122 @@ func (oc *Oauth2ClientCreate) createSpec() (*Oauth2Client, *sqlgraph.CreateSpec)
 		_spec.SetField(oauth2client.FieldDomain, field.TypeString, value)
 		_node.Domain = value
 	}
+	if value, ok := oc.mutation.RequirePkce(); ok {
+		_spec.SetField(oauth2client.FieldRequirePkce, field.TypeBool, value)
+		_node.RequirePkce = value
+	}
+	if value, ok := oc.mutation.ExchangeAudiences(); ok {
+		_spec.SetField(oauth2client.FieldExchangeAudiences, field.TypeJSON, value)
+		_node.ExchangeAudiences = value
+	}
+	if value, ok := oc.mutation.ExchangeScopes(); ok {
+		_spec.SetField(oauth2client.FieldExchangeScopes, field.TypeJSON, value)
+		_node.ExchangeScopes = value
+	}
+	if value, ok := oc.mutation.JwtBearerIssuers(); ok {
+		_spec.SetField(oauth2client.FieldJwtBearerIssuers, field.TypeJSON, value)
+		_node.JwtBearerIssuers = value
+	}
+	if value, ok := oc.mutation.TokenEndpointAuthMethod(); ok {
+		_spec.SetField(oauth2client.FieldTokenEndpointAuthMethod, field.TypeString, value)
+		_node.TokenEndpointAuthMethod = value
+	}
+	if value, ok := oc.mutation.Jwks(); ok {
+		_spec.SetField(oauth2client.FieldJwks, field.TypeJSON, value)
+		_node.Jwks = value
+	}
+	if value, ok := oc.mutation.JwksURI(); ok {
+		_spec.SetField(oauth2client.FieldJwksURI, field.TypeString, value)
+		_node.JwksURI = value
+	}
+	if value, ok := oc.mutation.TLSClientAuthSubjectDn(); ok {
+		_spec.SetField(oauth2client.FieldTLSClientAuthSubjectDn, field.TypeString, value)
+		_node.TLSClientAuthSubjectDn = value
+	}
+	if value, ok := oc.mutation.DpopBoundAccessTokens(); ok {
+		_spec.SetField(oauth2client.FieldDpopBoundAccessTokens, field.TypeBool, value)
+		_node.DpopBoundAccessTokens = value
+	}
+	if value, ok := oc.mutation.RequirePushedAuthorizationRequests(); ok {
+		_spec.SetField(oauth2client.FieldRequirePushedAuthorizationRequests, field.TypeBool, value)
+		_node.RequirePushedAuthorizationRequests = value
+	}
+	if value, ok := oc.mutation.RequestUris(); ok {
+		_spec.SetField(oauth2client.FieldRequestUris, field.TypeJSON, value)
+		_node.RequestUris = value
+	}
+	if value, ok := oc.mutation.ClientName(); ok {
+		_spec.SetField(oauth2client.FieldClientName, field.TypeString, value)
+		_node.ClientName = value
+	}
+	if value, ok := oc.mutation.RedirectUris(); ok {
+		_spec.SetField(oauth2client.FieldRedirectUris, field.TypeJSON, value)
+		_node.RedirectUris = value
+	}
+	if value, ok := oc.mutation.GrantTypes(); ok {
+		_spec.SetField(oauth2client.FieldGrantTypes, field.TypeJSON, value)
+		_node.GrantTypes = value
+	}
+	if value, ok := oc.mutation.ResponseTypes(); ok {
+		_spec.SetField(oauth2client.FieldResponseTypes, field.TypeJSON, value)
+		_node.ResponseTypes = value
+	}
+	if value, ok := oc.mutation.RegistrationAccessTokenHash(); ok {
+		_spec.SetField(oauth2client.FieldRegistrationAccessTokenHash, field.TypeString, value)
+		_node.RegistrationAccessTokenHash = value
+	}
+	if value, ok := oc.mutation.FirstParty(); ok {
+		_spec.SetField(oauth2client.FieldFirstParty, field.TypeBool, value)
+		_node.FirstParty = value
+	}
+	if value, ok := oc.mutation.LogoURI(); ok {
+		_spec.SetField(oauth2client.FieldLogoURI, field.TypeString, value)
+		_node.LogoURI = value
+	}
+	if value, ok := oc.mutation.PostLogoutRedirectUris(); ok {
+		_spec.SetField(oauth2client.FieldPostLogoutRedirectUris, field.TypeJSON, value)
+		_node.PostLogoutRedirectUris = value
+	}
+	if value, ok := oc.mutation.AllowedScopes(); ok {
+		_spec.SetField(oauth2client.FieldAllowedScopes, field.TypeJSON, value)
+		_node.AllowedScopes = value
+	}
+	if value, ok := oc.mutation.TokenClaims(); ok {
+		_spec.SetField(oauth2client.FieldTokenClaims, field.TypeJSON, value)
+		_node.TokenClaims = value
+	}
+	if nodes := oc.mutation.ConsentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   oauth2client.ConsentsTable,
+			Columns: []string{oauth2client.ConsentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(consent.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := oc.mutation.SecretsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   oauth2client.SecretsTable,
+			Columns: []string{oauth2client.SecretsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(clientsecret.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
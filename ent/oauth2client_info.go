@@ -0,0 +1,68 @@
+package ent
+
+import (
+	"time"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+// GetID returns the ID of the Oauth2Client.
+func (o *Oauth2Client) GetID() string {
+	return o.ID.String()
+}
+
+// GetSecret returns the secret of the Oauth2Client.
+func (o *Oauth2Client) GetSecret() string {
+	return o.Secret
+}
+
+// GetDomain returns the domain of the Oauth2Client.
+func (o *Oauth2Client) GetDomain() string {
+	return o.Domain
+}
+
+// IsPublic returns whether the Oauth2Client is public. Clients that
+// authenticate with keys or certificates are confidential without a secret.
+func (o *Oauth2Client) IsPublic() bool {
+	switch o.TokenEndpointAuthMethod {
+	case "none":
+		return true
+	case "":
+		return o.Secret == "" && len(o.Edges.Secrets) == 0
+	}
+	return false
+}
+
+// GetUserID returns the user ID of the Oauth2Client.
+func (o *Oauth2Client) GetUserID() string {
+	return ""
+}
+
+// implement ClientPasswordVerifier. Clients registered for JWT or TLS client
+// authentication cannot authenticate with their secret.
+func (o *Oauth2Client) VerifyPassword(password string) bool {
+	switch o.TokenEndpointAuthMethod {
+	case "private_key_jwt", "client_secret_jwt", "tls_client_auth", "self_signed_tls_client_auth":
+		return false
+	}
+	if o.IsPublic() {
+		return password == ""
+	}
+	return o.HasSecret(password)
+}
+
+// HasSecret reports whether password is one of the unexpired secrets of the
+// Oauth2Client. It is checked against their hashes, which must have been
+// loaded with the client.
+func (o *Oauth2Client) HasSecret(password string) bool {
+	now := time.Now()
+	for _, secret := range o.Edges.Secrets {
+		if secret.ExpiresAt != nil && !secret.ExpiresAt.After(now) {
+			continue
+		}
+		if bcrypt.CompareHashAndPassword([]byte(secret.Hash), []byte(password)) == nil {
+			return true
+		}
+	}
+	return false
+}
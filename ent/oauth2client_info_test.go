@@ -0,0 +1,124 @@
+package ent
+
+import (
+	"testing"
+	"time"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+func hashTestSecret(t *testing.T, secret string, expiresAt *time.Time) *ClientSecret {
+	t.Helper()
+	hash, err := bcrypt.GenerateFromPassword([]byte(secret), bcrypt.MinCost)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &ClientSecret{Hash: string(hash), ExpiresAt: expiresAt}
+}
+
+func TestOauth2ClientVerifyPassword(t *testing.T) {
+	past := time.Now().Add(-time.Minute)
+	future := time.Now().Add(time.Hour)
+	current := hashTestSecret(t, "current", nil)
+	rotated := hashTestSecret(t, "rotated", &future)
+	expired := hashTestSecret(t, "expired", &past)
+
+	tests := []struct {
+		name     string
+		client   *Oauth2Client
+		password string
+		want     bool
+	}{
+		{
+			name:     "current secret",
+			client:   &Oauth2Client{Edges: Oauth2ClientEdges{Secrets: []*ClientSecret{current, rotated}}},
+			password: "current",
+			want:     true,
+		},
+		{
+			name:     "rotated secret until it expires",
+			client:   &Oauth2Client{Edges: Oauth2ClientEdges{Secrets: []*ClientSecret{current, rotated}}},
+			password: "rotated",
+			want:     true,
+		},
+		{
+			name:     "expired secret",
+			client:   &Oauth2Client{Edges: Oauth2ClientEdges{Secrets: []*ClientSecret{current, expired}}},
+			password: "expired",
+		},
+		{
+			name:     "wrong secret",
+			client:   &Oauth2Client{Edges: Oauth2ClientEdges{Secrets: []*ClientSecret{current}}},
+			password: "wrong",
+		},
+		{
+			name:     "hash as the secret",
+			client:   &Oauth2Client{Edges: Oauth2ClientEdges{Secrets: []*ClientSecret{current}}},
+			password: current.Hash,
+		},
+		{
+			name:     "empty secret of a confidential client",
+			client:   &Oauth2Client{TokenEndpointAuthMethod: "client_secret_basic", Edges: Oauth2ClientEdges{Secrets: []*ClientSecret{current}}},
+			password: "",
+		},
+		{
+			name:     "secrets not loaded",
+			client:   &Oauth2Client{TokenEndpointAuthMethod: "client_secret_basic"},
+			password: "current",
+		},
+		{
+			name:     "client_secret_jwt",
+			client:   &Oauth2Client{TokenEndpointAuthMethod: "client_secret_jwt", Edges: Oauth2ClientEdges{Secrets: []*ClientSecret{current}}},
+			password: "current",
+		},
+		{
+			name:     "private_key_jwt",
+			client:   &Oauth2Client{TokenEndpointAuthMethod: "private_key_jwt"},
+			password: "",
+		},
+		{
+			name:     "tls_client_auth",
+			client:   &Oauth2Client{TokenEndpointAuthMethod: "tls_client_auth"},
+			password: "",
+		},
+		{
+			name:     "public client",
+			client:   &Oauth2Client{TokenEndpointAuthMethod: "none"},
+			password: "",
+			want:     true,
+		},
+		{
+			name:     "public client with a secret",
+			client:   &Oauth2Client{TokenEndpointAuthMethod: "none"},
+			password: "current",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.client.VerifyPassword(tt.password); got != tt.want {
+				t.Errorf("VerifyPassword(%q) = %v, want %v", tt.password, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOauth2ClientIsPublic(t *testing.T) {
+	tests := []struct {
+		name   string
+		client *Oauth2Client
+		want   bool
+	}{
+		{"none", &Oauth2Client{TokenEndpointAuthMethod: "none", Secret: "secret"}, true},
+		{"unset without secrets", &Oauth2Client{}, true},
+		{"unset with a secret", &Oauth2Client{Secret: "secret"}, false},
+		{"unset with hashed secrets", &Oauth2Client{Edges: Oauth2ClientEdges{Secrets: []*ClientSecret{{}}}}, false},
+		{"private_key_jwt", &Oauth2Client{TokenEndpointAuthMethod: "private_key_jwt"}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.client.IsPublic(); got != tt.want {
+				t.Errorf("IsPublic() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -4,6 +4,7 @@ package ent
 
 import (
 	"context"
+	"database/sql/driver"
 	"fmt"
 	"math"
 
@@ -11,6 +12,8 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/byebyebymyai/oauth2-api/ent/clientsecret"
+	"github.com/byebyebymyai/oauth2-api/ent/consent"
 	"github.com/byebyebymyai/oauth2-api/ent/oauth2client"
 	"github.com/byebyebymyai/oauth2-api/ent/predicate"
 	"github.com/google/uuid"
@@ -19,10 +22,12 @@ import (
 // Oauth2ClientQuery is the builder for querying Oauth2Client entities.
 type Oauth2ClientQuery struct {
 	config
-	ctx        *QueryContext
-	order      []oauth2client.OrderOption
-	inters     []Interceptor
-	predicates []predicate.Oauth2Client
+	ctx          *QueryContext
+	order        []oauth2client.OrderOption
+	inters       []Interceptor
+	predicates   []predicate.Oauth2Client
+	withConsents *ConsentQuery
+	withSecrets  *ClientSecretQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -59,6 +64,50 @@ func (oq *Oauth2ClientQuery) Order(o ...oauth2client.OrderOption) *Oauth2ClientQ
 	return oq
 }
 
+// QueryConsents chains the current query on the "consents" edge.
+func (oq *Oauth2ClientQuery) QueryConsents() *ConsentQuery {
+	query := (&ConsentClient{config: oq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := oq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := oq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(oauth2client.Table, oauth2client.FieldID, selector),
+			sqlgraph.To(consent.Table, consent.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, oauth2client.ConsentsTable, oauth2client.ConsentsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(oq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QuerySecrets chains the current query on the "secrets" edge.
+func (oq *Oauth2ClientQuery) QuerySecrets() *ClientSecretQuery {
+	query := (&ClientSecretClient{config: oq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := oq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := oq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(oauth2client.Table, oauth2client.FieldID, selector),
+			sqlgraph.To(clientsecret.Table, clientsecret.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, oauth2client.SecretsTable, oauth2client.SecretsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(oq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Oauth2Client entity from the query.
 // Returns a *NotFoundError when no Oauth2Client was found.
 func (oq *Oauth2ClientQuery) First(ctx context.Context) (*Oauth2Client, error) {
@@ -246,17 +295,41 @@ func (oq *Oauth2ClientQuery) Clone() *Oauth2ClientQuery {
 		return nil
 	}
 	return &Oauth2ClientQuery{
-		config:     oq.config,
-		ctx:        oq.ctx.Clone(),
-		order:      append([]oauth2client.OrderOption{}, oq.order...),
-		inters:     append([]Interceptor{}, oq.inters...),
-		predicates: append([]predicate.Oauth2Client{}, oq.predicates...),
+		config:       oq.config,
+		ctx:          oq.ctx.Clone(),
+		order:        append([]oauth2client.OrderOption{}, oq.order...),
+		inters:       append([]Interceptor{}, oq.inters...),
+		predicates:   append([]predicate.Oauth2Client{}, oq.predicates...),
+		withConsents: oq.withConsents.Clone(),
+		withSecrets:  oq.withSecrets.Clone(),
 		// clone intermediate query.
 		sql:  oq.sql.Clone(),
 		path: oq.path,
 	}
 }
 
+// WithConsents tells the query-builder to eager-load the nodes that are connected to
+// the "consents" edge. The optional arguments are used to configure the query builder of the edge.
+func (oq *Oauth2ClientQuery) WithConsents(opts ...func(*ConsentQuery)) *Oauth2ClientQuery {
+	query := (&ConsentClient{config: oq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	oq.withConsents = query
+	return oq
+}
+
+// WithSecrets tells the query-builder to eager-load the nodes that are connected to
+// the "secrets" edge. The optional arguments are used to configure the query builder of the edge.
+func (oq *Oauth2ClientQuery) WithSecrets(opts ...func(*ClientSecretQuery)) *Oauth2ClientQuery {
+	query := (&ClientSecretClient{config: oq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	oq.withSecrets = query
+	return oq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -333,8 +406,12 @@ func (oq *Oauth2ClientQuery) prepareQuery(ctx context.Context) error {
 
 func (oq *Oauth2ClientQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Oauth2Client, error) {
 	var (
-		nodes = []*Oauth2Client{}
-		_spec = oq.querySpec()
+		nodes       = []*Oauth2Client{}
+		_spec       = oq.querySpec()
+		loadedTypes = [2]bool{
+			oq.withConsents != nil,
+			oq.withSecrets != nil,
+		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
 		return (*Oauth2Client).scanValues(nil, columns)
@@ -342,6 +419,7 @@ func (oq *Oauth2ClientQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]
 	_spec.Assign = func(columns []string, values []any) error {
 		node := &Oauth2Client{config: oq.config}
 		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
 	for i := range hooks {
@@ -353,9 +431,86 @@ func (oq *Oauth2ClientQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]
 	if len(nodes) == 0 {
 		return nodes, nil
 	}
+	if query := oq.withConsents; query != nil {
+		if err := oq.loadConsents(ctx, query, nodes,
+			func(n *Oauth2Client) { n.Edges.Consents = []*Consent{} },
+			func(n *Oauth2Client, e *Consent) { n.Edges.Consents = append(n.Edges.Consents, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := oq.withSecrets; query != nil {
+		if err := oq.loadSecrets(ctx, query, nodes,
+			func(n *Oauth2Client) { n.Edges.Secrets = []*ClientSecret{} },
+			func(n *Oauth2Client, e *ClientSecret) { n.Edges.Secrets = append(n.Edges.Secrets, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
+func (oq *Oauth2ClientQuery) loadConsents(ctx context.Context, query *ConsentQuery, nodes []*Oauth2Client, init func(*Oauth2Client), assign func(*Oauth2Client, *Consent)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Oauth2Client)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.Consent(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(oauth2client.ConsentsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.oauth2client_consents
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "oauth2client_consents" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "oauth2client_consents" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (oq *Oauth2ClientQuery) loadSecrets(ctx context.Context, query *ClientSecretQuery, nodes []*Oauth2Client, init func(*Oauth2Client), assign func(*Oauth2Client, *ClientSecret)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Oauth2Client)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.ClientSecret(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(oauth2client.SecretsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.oauth2client_secrets
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "oauth2client_secrets" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "oauth2client_secrets" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+
 func (oq *Oauth2ClientQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := oq.querySpec()
 	_spec.Node.Columns = oq.ctx.Fields
@@ -405,7 +560,7 @@ func (oq *Oauth2ClientQuery) querySpec() *sqlgraph.QuerySpec {
 	return _spec
 }
 
-func (oq *Oauth2ClientQuery) sqlQuery(_ context.Context) *sql.Selector {
+func (oq *Oauth2ClientQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	builder := sql.Dialect(oq.driver.Dialect())
 	t1 := builder.Table(oauth2client.Table)
 	columns := oq.ctx.Fields
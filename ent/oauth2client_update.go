@@ -4,14 +4,19 @@ package ent
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
+	"github.com/byebyebymyai/oauth2-api/ent/clientsecret"
+	"github.com/byebyebymyai/oauth2-api/ent/consent"
 	"github.com/byebyebymyai/oauth2-api/ent/oauth2client"
 	"github.com/byebyebymyai/oauth2-api/ent/predicate"
+	"github.com/google/uuid"
 )
 
 // Oauth2ClientUpdate is the builder for updating Oauth2Client entities.
@@ -41,138 +46,1282 @@ func (ou *Oauth2ClientUpdate) SetNillableSecret(s *string) *Oauth2ClientUpdate {
 	return ou
 }
 
+// ClearSecret clears the value of the "secret" field.
+func (ou *Oauth2ClientUpdate) ClearSecret() *Oauth2ClientUpdate {
+	ou.mutation.ClearSecret()
+	return ou
+}
+
 // SetDomain sets the "domain" field.
 func (ou *Oauth2ClientUpdate) SetDomain(s string) *Oauth2ClientUpdate {
 	ou.mutation.SetDomain(s)
 	return ou
 }
 
-// SetNillableDomain sets the "domain" field if the given value is not nil.
-func (ou *Oauth2ClientUpdate) SetNillableDomain(s *string) *Oauth2ClientUpdate {
-	if s != nil {
-		ou.SetDomain(*s)
+// SetNillableDomain sets the "domain" field if the given value is not nil.
+func (ou *Oauth2ClientUpdate) SetNillableDomain(s *string) *Oauth2ClientUpdate {
+	if s != nil {
+		ou.SetDomain(*s)
+	}
+	return ou
+}
+
+// SetRequirePkce sets the "require_pkce" field.
+func (ou *Oauth2ClientUpdate) SetRequirePkce(b bool) *Oauth2ClientUpdate {
+	ou.mutation.SetRequirePkce(b)
+	return ou
+}
+
+// SetNillableRequirePkce sets the "require_pkce" field if the given value is not nil.
+func (ou *Oauth2ClientUpdate) SetNillableRequirePkce(b *bool) *Oauth2ClientUpdate {
+	if b != nil {
+		ou.SetRequirePkce(*b)
+	}
+	return ou
+}
+
+// SetExchangeAudiences sets the "exchange_audiences" field.
+func (ou *Oauth2ClientUpdate) SetExchangeAudiences(s []string) *Oauth2ClientUpdate {
+	ou.mutation.SetExchangeAudiences(s)
+	return ou
+}
+
+// AppendExchangeAudiences appends s to the "exchange_audiences" field.
+func (ou *Oauth2ClientUpdate) AppendExchangeAudiences(s []string) *Oauth2ClientUpdate {
+	ou.mutation.AppendExchangeAudiences(s)
+	return ou
+}
+
+// ClearExchangeAudiences clears the value of the "exchange_audiences" field.
+func (ou *Oauth2ClientUpdate) ClearExchangeAudiences() *Oauth2ClientUpdate {
+	ou.mutation.ClearExchangeAudiences()
+	return ou
+}
+
+// SetExchangeScopes sets the "exchange_scopes" field.
+func (ou *Oauth2ClientUpdate) SetExchangeScopes(s []string) *Oauth2ClientUpdate {
+	ou.mutation.SetExchangeScopes(s)
+	return ou
+}
+
+// AppendExchangeScopes appends s to the "exchange_scopes" field.
+func (ou *Oauth2ClientUpdate) AppendExchangeScopes(s []string) *Oauth2ClientUpdate {
+	ou.mutation.AppendExchangeScopes(s)
+	return ou
+}
+
+// ClearExchangeScopes clears the value of the "exchange_scopes" field.
+func (ou *Oauth2ClientUpdate) ClearExchangeScopes() *Oauth2ClientUpdate {
+	ou.mutation.ClearExchangeScopes()
+	return ou
+}
+
+// SetJwtBearerIssuers sets the "jwt_bearer_issuers" field.
+func (ou *Oauth2ClientUpdate) SetJwtBearerIssuers(mm map[string]json.RawMessage) *Oauth2ClientUpdate {
+	ou.mutation.SetJwtBearerIssuers(mm)
+	return ou
+}
+
+// ClearJwtBearerIssuers clears the value of the "jwt_bearer_issuers" field.
+func (ou *Oauth2ClientUpdate) ClearJwtBearerIssuers() *Oauth2ClientUpdate {
+	ou.mutation.ClearJwtBearerIssuers()
+	return ou
+}
+
+// SetTokenEndpointAuthMethod sets the "token_endpoint_auth_method" field.
+func (ou *Oauth2ClientUpdate) SetTokenEndpointAuthMethod(s string) *Oauth2ClientUpdate {
+	ou.mutation.SetTokenEndpointAuthMethod(s)
+	return ou
+}
+
+// SetNillableTokenEndpointAuthMethod sets the "token_endpoint_auth_method" field if the given value is not nil.
+func (ou *Oauth2ClientUpdate) SetNillableTokenEndpointAuthMethod(s *string) *Oauth2ClientUpdate {
+	if s != nil {
+		ou.SetTokenEndpointAuthMethod(*s)
+	}
+	return ou
+}
+
+// ClearTokenEndpointAuthMethod clears the value of the "token_endpoint_auth_method" field.
+func (ou *Oauth2ClientUpdate) ClearTokenEndpointAuthMethod() *Oauth2ClientUpdate {
+	ou.mutation.ClearTokenEndpointAuthMethod()
+	return ou
+}
+
+// SetJwks sets the "jwks" field.
+func (ou *Oauth2ClientUpdate) SetJwks(jm json.RawMessage) *Oauth2ClientUpdate {
+	ou.mutation.SetJwks(jm)
+	return ou
+}
+
+// AppendJwks appends jm to the "jwks" field.
+func (ou *Oauth2ClientUpdate) AppendJwks(jm json.RawMessage) *Oauth2ClientUpdate {
+	ou.mutation.AppendJwks(jm)
+	return ou
+}
+
+// ClearJwks clears the value of the "jwks" field.
+func (ou *Oauth2ClientUpdate) ClearJwks() *Oauth2ClientUpdate {
+	ou.mutation.ClearJwks()
+	return ou
+}
+
+// SetJwksURI sets the "jwks_uri" field.
+func (ou *Oauth2ClientUpdate) SetJwksURI(s string) *Oauth2ClientUpdate {
+	ou.mutation.SetJwksURI(s)
+	return ou
+}
+
+// SetNillableJwksURI sets the "jwks_uri" field if the given value is not nil.
+func (ou *Oauth2ClientUpdate) SetNillableJwksURI(s *string) *Oauth2ClientUpdate {
+	if s != nil {
+		ou.SetJwksURI(*s)
+	}
+	return ou
+}
+
+// ClearJwksURI clears the value of the "jwks_uri" field.
+func (ou *Oauth2ClientUpdate) ClearJwksURI() *Oauth2ClientUpdate {
+	ou.mutation.ClearJwksURI()
+	return ou
+}
+
+// SetTLSClientAuthSubjectDn sets the "tls_client_auth_subject_dn" field.
+func (ou *Oauth2ClientUpdate) SetTLSClientAuthSubjectDn(s string) *Oauth2ClientUpdate {
+	ou.mutation.SetTLSClientAuthSubjectDn(s)
+	return ou
+}
+
+// SetNillableTLSClientAuthSubjectDn sets the "tls_client_auth_subject_dn" field if the given value is not nil.
+func (ou *Oauth2ClientUpdate) SetNillableTLSClientAuthSubjectDn(s *string) *Oauth2ClientUpdate {
+	if s != nil {
+		ou.SetTLSClientAuthSubjectDn(*s)
+	}
+	return ou
+}
+
+// ClearTLSClientAuthSubjectDn clears the value of the "tls_client_auth_subject_dn" field.
+func (ou *Oauth2ClientUpdate) ClearTLSClientAuthSubjectDn() *Oauth2ClientUpdate {
+	ou.mutation.ClearTLSClientAuthSubjectDn()
+	return ou
+}
+
+// SetDpopBoundAccessTokens sets the "dpop_bound_access_tokens" field.
+func (ou *Oauth2ClientUpdate) SetDpopBoundAccessTokens(b bool) *Oauth2ClientUpdate {
+	ou.mutation.SetDpopBoundAccessTokens(b)
+	return ou
+}
+
+// SetNillableDpopBoundAccessTokens sets the "dpop_bound_access_tokens" field if the given value is not nil.
+func (ou *Oauth2ClientUpdate) SetNillableDpopBoundAccessTokens(b *bool) *Oauth2ClientUpdate {
+	if b != nil {
+		ou.SetDpopBoundAccessTokens(*b)
+	}
+	return ou
+}
+
+// SetRequirePushedAuthorizationRequests sets the "require_pushed_authorization_requests" field.
+func (ou *Oauth2ClientUpdate) SetRequirePushedAuthorizationRequests(b bool) *Oauth2ClientUpdate {
+	ou.mutation.SetRequirePushedAuthorizationRequests(b)
+	return ou
+}
+
+// SetNillableRequirePushedAuthorizationRequests sets the "require_pushed_authorization_requests" field if the given value is not nil.
+func (ou *Oauth2ClientUpdate) SetNillableRequirePushedAuthorizationRequests(b *bool) *Oauth2ClientUpdate {
+	if b != nil {
+		ou.SetRequirePushedAuthorizationRequests(*b)
+	}
+	return ou
+}
+
+// SetRequestUris sets the "request_uris" field.
+func (ou *Oauth2ClientUpdate) SetRequestUris(s []string) *Oauth2ClientUpdate {
+	ou.mutation.SetRequestUris(s)
+	return ou
+}
+
+// AppendRequestUris appends s to the "request_uris" field.
+func (ou *Oauth2ClientUpdate) AppendRequestUris(s []string) *Oauth2ClientUpdate {
+	ou.mutation.AppendRequestUris(s)
+	return ou
+}
+
+// ClearRequestUris clears the value of the "request_uris" field.
+func (ou *Oauth2ClientUpdate) ClearRequestUris() *Oauth2ClientUpdate {
+	ou.mutation.ClearRequestUris()
+	return ou
+}
+
+// SetClientName sets the "client_name" field.
+func (ou *Oauth2ClientUpdate) SetClientName(s string) *Oauth2ClientUpdate {
+	ou.mutation.SetClientName(s)
+	return ou
+}
+
+// SetNillableClientName sets the "client_name" field if the given value is not nil.
+func (ou *Oauth2ClientUpdate) SetNillableClientName(s *string) *Oauth2ClientUpdate {
+	if s != nil {
+		ou.SetClientName(*s)
+	}
+	return ou
+}
+
+// ClearClientName clears the value of the "client_name" field.
+func (ou *Oauth2ClientUpdate) ClearClientName() *Oauth2ClientUpdate {
+	ou.mutation.ClearClientName()
+	return ou
+}
+
+// SetRedirectUris sets the "redirect_uris" field.
+func (ou *Oauth2ClientUpdate) SetRedirectUris(s []string) *Oauth2ClientUpdate {
+	ou.mutation.SetRedirectUris(s)
+	return ou
+}
+
+// AppendRedirectUris appends s to the "redirect_uris" field.
+func (ou *Oauth2ClientUpdate) AppendRedirectUris(s []string) *Oauth2ClientUpdate {
+	ou.mutation.AppendRedirectUris(s)
+	return ou
+}
+
+// ClearRedirectUris clears the value of the "redirect_uris" field.
+func (ou *Oauth2ClientUpdate) ClearRedirectUris() *Oauth2ClientUpdate {
+	ou.mutation.ClearRedirectUris()
+	return ou
+}
+
+// SetGrantTypes sets the "grant_types" field.
+func (ou *Oauth2ClientUpdate) SetGrantTypes(s []string) *Oauth2ClientUpdate {
+	ou.mutation.SetGrantTypes(s)
+	return ou
+}
+
+// AppendGrantTypes appends s to the "grant_types" field.
+func (ou *Oauth2ClientUpdate) AppendGrantTypes(s []string) *Oauth2ClientUpdate {
+	ou.mutation.AppendGrantTypes(s)
+	return ou
+}
+
+// ClearGrantTypes clears the value of the "grant_types" field.
+func (ou *Oauth2ClientUpdate) ClearGrantTypes() *Oauth2ClientUpdate {
+	ou.mutation.ClearGrantTypes()
+	return ou
+}
+
+// SetResponseTypes sets the "response_types" field.
+func (ou *Oauth2ClientUpdate) SetResponseTypes(s []string) *Oauth2ClientUpdate {
+	ou.mutation.SetResponseTypes(s)
+	return ou
+}
+
+// AppendResponseTypes appends s to the "response_types" field.
+func (ou *Oauth2ClientUpdate) AppendResponseTypes(s []string) *Oauth2ClientUpdate {
+	ou.mutation.AppendResponseTypes(s)
+	return ou
+}
+
+// ClearResponseTypes clears the value of the "response_types" field.
+func (ou *Oauth2ClientUpdate) ClearResponseTypes() *Oauth2ClientUpdate {
+	ou.mutation.ClearResponseTypes()
+	return ou
+}
+
+// SetRegistrationAccessTokenHash sets the "registration_access_token_hash" field.
+func (ou *Oauth2ClientUpdate) SetRegistrationAccessTokenHash(s string) *Oauth2ClientUpdate {
+	ou.mutation.SetRegistrationAccessTokenHash(s)
+	return ou
+}
+
+// SetNillableRegistrationAccessTokenHash sets the "registration_access_token_hash" field if the given value is not nil.
+func (ou *Oauth2ClientUpdate) SetNillableRegistrationAccessTokenHash(s *string) *Oauth2ClientUpdate {
+	if s != nil {
+		ou.SetRegistrationAccessTokenHash(*s)
+	}
+	return ou
+}
+
+// ClearRegistrationAccessTokenHash clears the value of the "registration_access_token_hash" field.
+func (ou *Oauth2ClientUpdate) ClearRegistrationAccessTokenHash() *Oauth2ClientUpdate {
+	ou.mutation.ClearRegistrationAccessTokenHash()
+	return ou
+}
+
+// SetFirstParty sets the "first_party" field.
+func (ou *Oauth2ClientUpdate) SetFirstParty(b bool) *Oauth2ClientUpdate {
+	ou.mutation.SetFirstParty(b)
+	return ou
+}
+
+// SetNillableFirstParty sets the "first_party" field if the given value is not nil.
+func (ou *Oauth2ClientUpdate) SetNillableFirstParty(b *bool) *Oauth2ClientUpdate {
+	if b != nil {
+		ou.SetFirstParty(*b)
+	}
+	return ou
+}
+
+// SetLogoURI sets the "logo_uri" field.
+func (ou *Oauth2ClientUpdate) SetLogoURI(s string) *Oauth2ClientUpdate {
+	ou.mutation.SetLogoURI(s)
+	return ou
+}
+
+// SetNillableLogoURI sets the "logo_uri" field if the given value is not nil.
+func (ou *Oauth2ClientUpdate) SetNillableLogoURI(s *string) *Oauth2ClientUpdate {
+	if s != nil {
+		ou.SetLogoURI(*s)
+	}
+	return ou
+}
+
+// ClearLogoURI clears the value of the "logo_uri" field.
+func (ou *Oauth2ClientUpdate) ClearLogoURI() *Oauth2ClientUpdate {
+	ou.mutation.ClearLogoURI()
+	return ou
+}
+
+// SetPostLogoutRedirectUris sets the "post_logout_redirect_uris" field.
+func (ou *Oauth2ClientUpdate) SetPostLogoutRedirectUris(s []string) *Oauth2ClientUpdate {
+	ou.mutation.SetPostLogoutRedirectUris(s)
+	return ou
+}
+
+// AppendPostLogoutRedirectUris appends s to the "post_logout_redirect_uris" field.
+func (ou *Oauth2ClientUpdate) AppendPostLogoutRedirectUris(s []string) *Oauth2ClientUpdate {
+	ou.mutation.AppendPostLogoutRedirectUris(s)
+	return ou
+}
+
+// ClearPostLogoutRedirectUris clears the value of the "post_logout_redirect_uris" field.
+func (ou *Oauth2ClientUpdate) ClearPostLogoutRedirectUris() *Oauth2ClientUpdate {
+	ou.mutation.ClearPostLogoutRedirectUris()
+	return ou
+}
+
+// SetAllowedScopes sets the "allowed_scopes" field.
+func (ou *Oauth2ClientUpdate) SetAllowedScopes(s []string) *Oauth2ClientUpdate {
+	ou.mutation.SetAllowedScopes(s)
+	return ou
+}
+
+// AppendAllowedScopes appends s to the "allowed_scopes" field.
+func (ou *Oauth2ClientUpdate) AppendAllowedScopes(s []string) *Oauth2ClientUpdate {
+	ou.mutation.AppendAllowedScopes(s)
+	return ou
+}
+
+// ClearAllowedScopes clears the value of the "allowed_scopes" field.
+func (ou *Oauth2ClientUpdate) ClearAllowedScopes() *Oauth2ClientUpdate {
+	ou.mutation.ClearAllowedScopes()
+	return ou
+}
+
+// SetTokenClaims sets the "token_claims" field.
+func (ou *Oauth2ClientUpdate) SetTokenClaims(s []string) *Oauth2ClientUpdate {
+	ou.mutation.SetTokenClaims(s)
+	return ou
+}
+
+// AppendTokenClaims appends s to the "token_claims" field.
+func (ou *Oauth2ClientUpdate) AppendTokenClaims(s []string) *Oauth2ClientUpdate {
+	ou.mutation.AppendTokenClaims(s)
+	return ou
+}
+
+// ClearTokenClaims clears the value of the "token_claims" field.
+func (ou *Oauth2ClientUpdate) ClearTokenClaims() *Oauth2ClientUpdate {
+	ou.mutation.ClearTokenClaims()
+	return ou
+}
+
+// AddConsentIDs adds the "consents" edge to the Consent entity by IDs.
+func (ou *Oauth2ClientUpdate) AddConsentIDs(ids ...uuid.UUID) *Oauth2ClientUpdate {
+	ou.mutation.AddConsentIDs(ids...)
+	return ou
+}
+
+// AddConsents adds the "consents" edges to the Consent entity.
+func (ou *Oauth2ClientUpdate) AddConsents(c ...*Consent) *Oauth2ClientUpdate {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return ou.AddConsentIDs(ids...)
+}
+
+// AddSecretIDs adds the "secrets" edge to the ClientSecret entity by IDs.
+func (ou *Oauth2ClientUpdate) AddSecretIDs(ids ...uuid.UUID) *Oauth2ClientUpdate {
+	ou.mutation.AddSecretIDs(ids...)
+	return ou
+}
+
+// AddSecrets adds the "secrets" edges to the ClientSecret entity.
+func (ou *Oauth2ClientUpdate) AddSecrets(c ...*ClientSecret) *Oauth2ClientUpdate {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return ou.AddSecretIDs(ids...)
+}
+
+// Mutation returns the Oauth2ClientMutation object of the builder.
+func (ou *Oauth2ClientUpdate) Mutation() *Oauth2ClientMutation {
+	return ou.mutation
+}
+
+// ClearConsents clears all "consents" edges to the Consent entity.
+func (ou *Oauth2ClientUpdate) ClearConsents() *Oauth2ClientUpdate {
+	ou.mutation.ClearConsents()
+	return ou
+}
+
+// RemoveConsentIDs removes the "consents" edge to Consent entities by IDs.
+func (ou *Oauth2ClientUpdate) RemoveConsentIDs(ids ...uuid.UUID) *Oauth2ClientUpdate {
+	ou.mutation.RemoveConsentIDs(ids...)
+	return ou
+}
+
+// RemoveConsents removes "consents" edges to Consent entities.
+func (ou *Oauth2ClientUpdate) RemoveConsents(c ...*Consent) *Oauth2ClientUpdate {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return ou.RemoveConsentIDs(ids...)
+}
+
+// ClearSecrets clears all "secrets" edges to the ClientSecret entity.
+func (ou *Oauth2ClientUpdate) ClearSecrets() *Oauth2ClientUpdate {
+	ou.mutation.ClearSecrets()
+	return ou
+}
+
+// RemoveSecretIDs removes the "secrets" edge to ClientSecret entities by IDs.
+func (ou *Oauth2ClientUpdate) RemoveSecretIDs(ids ...uuid.UUID) *Oauth2ClientUpdate {
+	ou.mutation.RemoveSecretIDs(ids...)
+	return ou
+}
+
+// RemoveSecrets removes "secrets" edges to ClientSecret entities.
+func (ou *Oauth2ClientUpdate) RemoveSecrets(c ...*ClientSecret) *Oauth2ClientUpdate {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return ou.RemoveSecretIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ou *Oauth2ClientUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, ou.sqlSave, ou.mutation, ou.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ou *Oauth2ClientUpdate) SaveX(ctx context.Context) int {
+	affected, err := ou.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ou *Oauth2ClientUpdate) Exec(ctx context.Context) error {
+	_, err := ou.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ou *Oauth2ClientUpdate) ExecX(ctx context.Context) {
+	if err := ou.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ou *Oauth2ClientUpdate) check() error {
+	if v, ok := ou.mutation.Domain(); ok {
+		if err := oauth2client.DomainValidator(v); err != nil {
+			return &ValidationError{Name: "domain", err: fmt.Errorf(`ent: validator failed for field "Oauth2Client.domain": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (ou *Oauth2ClientUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := ou.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(oauth2client.Table, oauth2client.Columns, sqlgraph.NewFieldSpec(oauth2client.FieldID, field.TypeUUID))
+	if ps := ou.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ou.mutation.Secret(); ok {
+		_spec.SetField(oauth2client.FieldSecret, field.TypeString, value)
+	}
+	if ou.mutation.SecretCleared() {
+		_spec.ClearField(oauth2client.FieldSecret, field.TypeString)
+	}
+	if value, ok := ou.mutation.Domain(); ok {
+		_spec.SetField(oauth2client.FieldDomain, field.TypeString, value)
+	}
+	if value, ok := ou.mutation.RequirePkce(); ok {
+		_spec.SetField(oauth2client.FieldRequirePkce, field.TypeBool, value)
+	}
+	if value, ok := ou.mutation.ExchangeAudiences(); ok {
+		_spec.SetField(oauth2client.FieldExchangeAudiences, field.TypeJSON, value)
+	}
+	if value, ok := ou.mutation.AppendedExchangeAudiences(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, oauth2client.FieldExchangeAudiences, value)
+		})
+	}
+	if ou.mutation.ExchangeAudiencesCleared() {
+		_spec.ClearField(oauth2client.FieldExchangeAudiences, field.TypeJSON)
+	}
+	if value, ok := ou.mutation.ExchangeScopes(); ok {
+		_spec.SetField(oauth2client.FieldExchangeScopes, field.TypeJSON, value)
+	}
+	if value, ok := ou.mutation.AppendedExchangeScopes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, oauth2client.FieldExchangeScopes, value)
+		})
+	}
+	if ou.mutation.ExchangeScopesCleared() {
+		_spec.ClearField(oauth2client.FieldExchangeScopes, field.TypeJSON)
+	}
+	if value, ok := ou.mutation.JwtBearerIssuers(); ok {
+		_spec.SetField(oauth2client.FieldJwtBearerIssuers, field.TypeJSON, value)
+	}
+	if ou.mutation.JwtBearerIssuersCleared() {
+		_spec.ClearField(oauth2client.FieldJwtBearerIssuers, field.TypeJSON)
+	}
+	if value, ok := ou.mutation.TokenEndpointAuthMethod(); ok {
+		_spec.SetField(oauth2client.FieldTokenEndpointAuthMethod, field.TypeString, value)
+	}
+	if ou.mutation.TokenEndpointAuthMethodCleared() {
+		_spec.ClearField(oauth2client.FieldTokenEndpointAuthMethod, field.TypeString)
+	}
+	if value, ok := ou.mutation.Jwks(); ok {
+		_spec.SetField(oauth2client.FieldJwks, field.TypeJSON, value)
+	}
+	if value, ok := ou.mutation.AppendedJwks(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, oauth2client.FieldJwks, value)
+		})
+	}
+	if ou.mutation.JwksCleared() {
+		_spec.ClearField(oauth2client.FieldJwks, field.TypeJSON)
+	}
+	if value, ok := ou.mutation.JwksURI(); ok {
+		_spec.SetField(oauth2client.FieldJwksURI, field.TypeString, value)
+	}
+	if ou.mutation.JwksURICleared() {
+		_spec.ClearField(oauth2client.FieldJwksURI, field.TypeString)
+	}
+	if value, ok := ou.mutation.TLSClientAuthSubjectDn(); ok {
+		_spec.SetField(oauth2client.FieldTLSClientAuthSubjectDn, field.TypeString, value)
+	}
+	if ou.mutation.TLSClientAuthSubjectDnCleared() {
+		_spec.ClearField(oauth2client.FieldTLSClientAuthSubjectDn, field.TypeString)
+	}
+	if value, ok := ou.mutation.DpopBoundAccessTokens(); ok {
+		_spec.SetField(oauth2client.FieldDpopBoundAccessTokens, field.TypeBool, value)
+	}
+	if value, ok := ou.mutation.RequirePushedAuthorizationRequests(); ok {
+		_spec.SetField(oauth2client.FieldRequirePushedAuthorizationRequests, field.TypeBool, value)
+	}
+	if value, ok := ou.mutation.RequestUris(); ok {
+		_spec.SetField(oauth2client.FieldRequestUris, field.TypeJSON, value)
+	}
+	if value, ok := ou.mutation.AppendedRequestUris(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, oauth2client.FieldRequestUris, value)
+		})
+	}
+	if ou.mutation.RequestUrisCleared() {
+		_spec.ClearField(oauth2client.FieldRequestUris, field.TypeJSON)
+	}
+	if value, ok := ou.mutation.ClientName(); ok {
+		_spec.SetField(oauth2client.FieldClientName, field.TypeString, value)
+	}
+	if ou.mutation.ClientNameCleared() {
+		_spec.ClearField(oauth2client.FieldClientName, field.TypeString)
+	}
+	if value, ok := ou.mutation.RedirectUris(); ok {
+		_spec.SetField(oauth2client.FieldRedirectUris, field.TypeJSON, value)
+	}
+	if value, ok := ou.mutation.AppendedRedirectUris(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, oauth2client.FieldRedirectUris, value)
+		})
+	}
+	if ou.mutation.RedirectUrisCleared() {
+		_spec.ClearField(oauth2client.FieldRedirectUris, field.TypeJSON)
+	}
+	if value, ok := ou.mutation.GrantTypes(); ok {
+		_spec.SetField(oauth2client.FieldGrantTypes, field.TypeJSON, value)
+	}
+	if value, ok := ou.mutation.AppendedGrantTypes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, oauth2client.FieldGrantTypes, value)
+		})
+	}
+	if ou.mutation.GrantTypesCleared() {
+		_spec.ClearField(oauth2client.FieldGrantTypes, field.TypeJSON)
+	}
+	if value, ok := ou.mutation.ResponseTypes(); ok {
+		_spec.SetField(oauth2client.FieldResponseTypes, field.TypeJSON, value)
+	}
+	if value, ok := ou.mutation.AppendedResponseTypes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, oauth2client.FieldResponseTypes, value)
+		})
+	}
+	if ou.mutation.ResponseTypesCleared() {
+		_spec.ClearField(oauth2client.FieldResponseTypes, field.TypeJSON)
+	}
+	if value, ok := ou.mutation.RegistrationAccessTokenHash(); ok {
+		_spec.SetField(oauth2client.FieldRegistrationAccessTokenHash, field.TypeString, value)
+	}
+	if ou.mutation.RegistrationAccessTokenHashCleared() {
+		_spec.ClearField(oauth2client.FieldRegistrationAccessTokenHash, field.TypeString)
+	}
+	if value, ok := ou.mutation.FirstParty(); ok {
+		_spec.SetField(oauth2client.FieldFirstParty, field.TypeBool, value)
+	}
+	if value, ok := ou.mutation.LogoURI(); ok {
+		_spec.SetField(oauth2client.FieldLogoURI, field.TypeString, value)
+	}
+	if ou.mutation.LogoURICleared() {
+		_spec.ClearField(oauth2client.FieldLogoURI, field.TypeString)
+	}
+	if value, ok := ou.mutation.PostLogoutRedirectUris(); ok {
+		_spec.SetField(oauth2client.FieldPostLogoutRedirectUris, field.TypeJSON, value)
+	}
+	if value, ok := ou.mutation.AppendedPostLogoutRedirectUris(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, oauth2client.FieldPostLogoutRedirectUris, value)
+		})
+	}
+	if ou.mutation.PostLogoutRedirectUrisCleared() {
+		_spec.ClearField(oauth2client.FieldPostLogoutRedirectUris, field.TypeJSON)
+	}
+	if value, ok := ou.mutation.AllowedScopes(); ok {
+		_spec.SetField(oauth2client.FieldAllowedScopes, field.TypeJSON, value)
+	}
+	if value, ok := ou.mutation.AppendedAllowedScopes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, oauth2client.FieldAllowedScopes, value)
+		})
+	}
+	if ou.mutation.AllowedScopesCleared() {
+		_spec.ClearField(oauth2client.FieldAllowedScopes, field.TypeJSON)
+	}
+	if value, ok := ou.mutation.TokenClaims(); ok {
+		_spec.SetField(oauth2client.FieldTokenClaims, field.TypeJSON, value)
+	}
+	if value, ok := ou.mutation.AppendedTokenClaims(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, oauth2client.FieldTokenClaims, value)
+		})
+	}
+	if ou.mutation.TokenClaimsCleared() {
+		_spec.ClearField(oauth2client.FieldTokenClaims, field.TypeJSON)
+	}
+	if ou.mutation.ConsentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   oauth2client.ConsentsTable,
+			Columns: []string{oauth2client.ConsentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(consent.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ou.mutation.RemovedConsentsIDs(); len(nodes) > 0 && !ou.mutation.ConsentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   oauth2client.ConsentsTable,
+			Columns: []string{oauth2client.ConsentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(consent.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ou.mutation.ConsentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   oauth2client.ConsentsTable,
+			Columns: []string{oauth2client.ConsentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(consent.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ou.mutation.SecretsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   oauth2client.SecretsTable,
+			Columns: []string{oauth2client.SecretsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(clientsecret.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ou.mutation.RemovedSecretsIDs(); len(nodes) > 0 && !ou.mutation.SecretsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   oauth2client.SecretsTable,
+			Columns: []string{oauth2client.SecretsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(clientsecret.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ou.mutation.SecretsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   oauth2client.SecretsTable,
+			Columns: []string{oauth2client.SecretsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(clientsecret.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, ou.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{oauth2client.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	ou.mutation.done = true
+	return n, nil
+}
+
+// Oauth2ClientUpdateOne is the builder for updating a single Oauth2Client entity.
+type Oauth2ClientUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *Oauth2ClientMutation
+}
+
+// SetSecret sets the "secret" field.
+func (ouo *Oauth2ClientUpdateOne) SetSecret(s string) *Oauth2ClientUpdateOne {
+	ouo.mutation.SetSecret(s)
+	return ouo
+}
+
+// SetNillableSecret sets the "secret" field if the given value is not nil.
+func (ouo *Oauth2ClientUpdateOne) SetNillableSecret(s *string) *Oauth2ClientUpdateOne {
+	if s != nil {
+		ouo.SetSecret(*s)
+	}
+	return ouo
+}
+
+// ClearSecret clears the value of the "secret" field.
+func (ouo *Oauth2ClientUpdateOne) ClearSecret() *Oauth2ClientUpdateOne {
+	ouo.mutation.ClearSecret()
+	return ouo
+}
+
+// SetDomain sets the "domain" field.
+func (ouo *Oauth2ClientUpdateOne) SetDomain(s string) *Oauth2ClientUpdateOne {
+	ouo.mutation.SetDomain(s)
+	return ouo
+}
+
+// SetNillableDomain sets the "domain" field if the given value is not nil.
+func (ouo *Oauth2ClientUpdateOne) SetNillableDomain(s *string) *Oauth2ClientUpdateOne {
+	if s != nil {
+		ouo.SetDomain(*s)
+	}
+	return ouo
+}
+
+// SetRequirePkce sets the "require_pkce" field.
+func (ouo *Oauth2ClientUpdateOne) SetRequirePkce(b bool) *Oauth2ClientUpdateOne {
+	ouo.mutation.SetRequirePkce(b)
+	return ouo
+}
+
+// SetNillableRequirePkce sets the "require_pkce" field if the given value is not nil.
+func (ouo *Oauth2ClientUpdateOne) SetNillableRequirePkce(b *bool) *Oauth2ClientUpdateOne {
+	if b != nil {
+		ouo.SetRequirePkce(*b)
+	}
+	return ouo
+}
+
+// SetExchangeAudiences sets the "exchange_audiences" field.
+func (ouo *Oauth2ClientUpdateOne) SetExchangeAudiences(s []string) *Oauth2ClientUpdateOne {
+	ouo.mutation.SetExchangeAudiences(s)
+	return ouo
+}
+
+// AppendExchangeAudiences appends s to the "exchange_audiences" field.
+func (ouo *Oauth2ClientUpdateOne) AppendExchangeAudiences(s []string) *Oauth2ClientUpdateOne {
+	ouo.mutation.AppendExchangeAudiences(s)
+	return ouo
+}
+
+// ClearExchangeAudiences clears the value of the "exchange_audiences" field.
+func (ouo *Oauth2ClientUpdateOne) ClearExchangeAudiences() *Oauth2ClientUpdateOne {
+	ouo.mutation.ClearExchangeAudiences()
+	return ouo
+}
+
+// SetExchangeScopes sets the "exchange_scopes" field.
+func (ouo *Oauth2ClientUpdateOne) SetExchangeScopes(s []string) *Oauth2ClientUpdateOne {
+	ouo.mutation.SetExchangeScopes(s)
+	return ouo
+}
+
+// AppendExchangeScopes appends s to the "exchange_scopes" field.
+func (ouo *Oauth2ClientUpdateOne) AppendExchangeScopes(s []string) *Oauth2ClientUpdateOne {
+	ouo.mutation.AppendExchangeScopes(s)
+	return ouo
+}
+
+// ClearExchangeScopes clears the value of the "exchange_scopes" field.
+func (ouo *Oauth2ClientUpdateOne) ClearExchangeScopes() *Oauth2ClientUpdateOne {
+	ouo.mutation.ClearExchangeScopes()
+	return ouo
+}
+
+// SetJwtBearerIssuers sets the "jwt_bearer_issuers" field.
+func (ouo *Oauth2ClientUpdateOne) SetJwtBearerIssuers(mm map[string]json.RawMessage) *Oauth2ClientUpdateOne {
+	ouo.mutation.SetJwtBearerIssuers(mm)
+	return ouo
+}
+
+// ClearJwtBearerIssuers clears the value of the "jwt_bearer_issuers" field.
+func (ouo *Oauth2ClientUpdateOne) ClearJwtBearerIssuers() *Oauth2ClientUpdateOne {
+	ouo.mutation.ClearJwtBearerIssuers()
+	return ouo
+}
+
+// SetTokenEndpointAuthMethod sets the "token_endpoint_auth_method" field.
+func (ouo *Oauth2ClientUpdateOne) SetTokenEndpointAuthMethod(s string) *Oauth2ClientUpdateOne {
+	ouo.mutation.SetTokenEndpointAuthMethod(s)
+	return ouo
+}
+
+// SetNillableTokenEndpointAuthMethod sets the "token_endpoint_auth_method" field if the given value is not nil.
+func (ouo *Oauth2ClientUpdateOne) SetNillableTokenEndpointAuthMethod(s *string) *Oauth2ClientUpdateOne {
+	if s != nil {
+		ouo.SetTokenEndpointAuthMethod(*s)
+	}
+	return ouo
+}
+
+// ClearTokenEndpointAuthMethod clears the value of the "token_endpoint_auth_method" field.
+func (ouo *Oauth2ClientUpdateOne) ClearTokenEndpointAuthMethod() *Oauth2ClientUpdateOne {
+	ouo.mutation.ClearTokenEndpointAuthMethod()
+	return ouo
+}
+
+// SetJwks sets the "jwks" field.
+func (ouo *Oauth2ClientUpdateOne) SetJwks(jm json.RawMessage) *Oauth2ClientUpdateOne {
+	ouo.mutation.SetJwks(jm)
+	return ouo
+}
+
+// AppendJwks appends jm to the "jwks" field.
+func (ouo *Oauth2ClientUpdateOne) AppendJwks(jm json.RawMessage) *Oauth2ClientUpdateOne {
+	ouo.mutation.AppendJwks(jm)
+	return ouo
+}
+
+// ClearJwks clears the value of the "jwks" field.
+func (ouo *Oauth2ClientUpdateOne) ClearJwks() *Oauth2ClientUpdateOne {
+	ouo.mutation.ClearJwks()
+	return ouo
+}
+
+// SetJwksURI sets the "jwks_uri" field.
+func (ouo *Oauth2ClientUpdateOne) SetJwksURI(s string) *Oauth2ClientUpdateOne {
+	ouo.mutation.SetJwksURI(s)
+	return ouo
+}
+
+// SetNillableJwksURI sets the "jwks_uri" field if the given value is not nil.
+func (ouo *Oauth2ClientUpdateOne) SetNillableJwksURI(s *string) *Oauth2ClientUpdateOne {
+	if s != nil {
+		ouo.SetJwksURI(*s)
+	}
+	return ouo
+}
+
+// ClearJwksURI clears the value of the "jwks_uri" field.
+func (ouo *Oauth2ClientUpdateOne) ClearJwksURI() *Oauth2ClientUpdateOne {
+	ouo.mutation.ClearJwksURI()
+	return ouo
+}
+
+// SetTLSClientAuthSubjectDn sets the "tls_client_auth_subject_dn" field.
+func (ouo *Oauth2ClientUpdateOne) SetTLSClientAuthSubjectDn(s string) *Oauth2ClientUpdateOne {
+	ouo.mutation.SetTLSClientAuthSubjectDn(s)
+	return ouo
+}
+
+// SetNillableTLSClientAuthSubjectDn sets the "tls_client_auth_subject_dn" field if the given value is not nil.
+func (ouo *Oauth2ClientUpdateOne) SetNillableTLSClientAuthSubjectDn(s *string) *Oauth2ClientUpdateOne {
+	if s != nil {
+		ouo.SetTLSClientAuthSubjectDn(*s)
+	}
+	return ouo
+}
+
+// ClearTLSClientAuthSubjectDn clears the value of the "tls_client_auth_subject_dn" field.
+func (ouo *Oauth2ClientUpdateOne) ClearTLSClientAuthSubjectDn() *Oauth2ClientUpdateOne {
+	ouo.mutation.ClearTLSClientAuthSubjectDn()
+	return ouo
+}
+
+// SetDpopBoundAccessTokens sets the "dpop_bound_access_tokens" field.
+func (ouo *Oauth2ClientUpdateOne) SetDpopBoundAccessTokens(b bool) *Oauth2ClientUpdateOne {
+	ouo.mutation.SetDpopBoundAccessTokens(b)
+	return ouo
+}
+
+// SetNillableDpopBoundAccessTokens sets the "dpop_bound_access_tokens" field if the given value is not nil.
+func (ouo *Oauth2ClientUpdateOne) SetNillableDpopBoundAccessTokens(b *bool) *Oauth2ClientUpdateOne {
+	if b != nil {
+		ouo.SetDpopBoundAccessTokens(*b)
+	}
+	return ouo
+}
+
+// SetRequirePushedAuthorizationRequests sets the "require_pushed_authorization_requests" field.
+func (ouo *Oauth2ClientUpdateOne) SetRequirePushedAuthorizationRequests(b bool) *Oauth2ClientUpdateOne {
+	ouo.mutation.SetRequirePushedAuthorizationRequests(b)
+	return ouo
+}
+
+// SetNillableRequirePushedAuthorizationRequests sets the "require_pushed_authorization_requests" field if the given value is not nil.
+func (ouo *Oauth2ClientUpdateOne) SetNillableRequirePushedAuthorizationRequests(b *bool) *Oauth2ClientUpdateOne {
+	if b != nil {
+		ouo.SetRequirePushedAuthorizationRequests(*b)
 	}
-	return ou
+	return ouo
 }
 
-// Mutation returns the Oauth2ClientMutation object of the builder.
-func (ou *Oauth2ClientUpdate) Mutation() *Oauth2ClientMutation {
-	return ou.mutation
+// SetRequestUris sets the "request_uris" field.
+func (ouo *Oauth2ClientUpdateOne) SetRequestUris(s []string) *Oauth2ClientUpdateOne {
+	ouo.mutation.SetRequestUris(s)
+	return ouo
 }
 
-// Save executes the query and returns the number of nodes affected by the update operation.
-func (ou *Oauth2ClientUpdate) Save(ctx context.Context) (int, error) {
-	return withHooks(ctx, ou.sqlSave, ou.mutation, ou.hooks)
+// AppendRequestUris appends s to the "request_uris" field.
+func (ouo *Oauth2ClientUpdateOne) AppendRequestUris(s []string) *Oauth2ClientUpdateOne {
+	ouo.mutation.AppendRequestUris(s)
+	return ouo
 }
 
-// SaveX is like Save, but panics if an error occurs.
-func (ou *Oauth2ClientUpdate) SaveX(ctx context.Context) int {
-	affected, err := ou.Save(ctx)
-	if err != nil {
-		panic(err)
-	}
-	return affected
+// ClearRequestUris clears the value of the "request_uris" field.
+func (ouo *Oauth2ClientUpdateOne) ClearRequestUris() *Oauth2ClientUpdateOne {
+	ouo.mutation.ClearRequestUris()
+	return ouo
 }
 
-// Exec executes the query.
-func (ou *Oauth2ClientUpdate) Exec(ctx context.Context) error {
-	_, err := ou.Save(ctx)
-	return err
+// SetClientName sets the "client_name" field.
+func (ouo *Oauth2ClientUpdateOne) SetClientName(s string) *Oauth2ClientUpdateOne {
+	ouo.mutation.SetClientName(s)
+	return ouo
 }
 
-// ExecX is like Exec, but panics if an error occurs.
-func (ou *Oauth2ClientUpdate) ExecX(ctx context.Context) {
-	if err := ou.Exec(ctx); err != nil {
-		panic(err)
+// SetNillableClientName sets the "client_name" field if the given value is not nil.
+func (ouo *Oauth2ClientUpdateOne) SetNillableClientName(s *string) *Oauth2ClientUpdateOne {
+	if s != nil {
+		ouo.SetClientName(*s)
 	}
+	return ouo
 }
 
-// check runs all checks and user-defined validators on the builder.
-func (ou *Oauth2ClientUpdate) check() error {
-	if v, ok := ou.mutation.Secret(); ok {
-		if err := oauth2client.SecretValidator(v); err != nil {
-			return &ValidationError{Name: "secret", err: fmt.Errorf(`ent: validator failed for field "Oauth2Client.secret": %w`, err)}
-		}
-	}
-	if v, ok := ou.mutation.Domain(); ok {
-		if err := oauth2client.DomainValidator(v); err != nil {
-			return &ValidationError{Name: "domain", err: fmt.Errorf(`ent: validator failed for field "Oauth2Client.domain": %w`, err)}
-		}
-	}
-	return nil
+// ClearClientName clears the value of the "client_name" field.
+func (ouo *Oauth2ClientUpdateOne) ClearClientName() *Oauth2ClientUpdateOne {
+	ouo.mutation.ClearClientName()
+	return ouo
 }
 
-func (ou *Oauth2ClientUpdate) sqlSave(ctx context.Context) (n int, err error) {
-	if err := ou.check(); err != nil {
-		return n, err
-	}
-	_spec := sqlgraph.NewUpdateSpec(oauth2client.Table, oauth2client.Columns, sqlgraph.NewFieldSpec(oauth2client.FieldID, field.TypeUUID))
-	if ps := ou.mutation.predicates; len(ps) > 0 {
-		_spec.Predicate = func(selector *sql.Selector) {
-			for i := range ps {
-				ps[i](selector)
-			}
-		}
-	}
-	if value, ok := ou.mutation.Secret(); ok {
-		_spec.SetField(oauth2client.FieldSecret, field.TypeString, value)
-	}
-	if value, ok := ou.mutation.Domain(); ok {
-		_spec.SetField(oauth2client.FieldDomain, field.TypeString, value)
-	}
-	if n, err = sqlgraph.UpdateNodes(ctx, ou.driver, _spec); err != nil {
-		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{oauth2client.Label}
-		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
-		}
-		return 0, err
-	}
-	ou.mutation.done = true
-	return n, nil
+// SetRedirectUris sets the "redirect_uris" field.
+func (ouo *Oauth2ClientUpdateOne) SetRedirectUris(s []string) *Oauth2ClientUpdateOne {
+	ouo.mutation.SetRedirectUris(s)
+	return ouo
 }
 
-// Oauth2ClientUpdateOne is the builder for updating a single Oauth2Client entity.
-type Oauth2ClientUpdateOne struct {
-	config
-	fields   []string
-	hooks    []Hook
-	mutation *Oauth2ClientMutation
+// AppendRedirectUris appends s to the "redirect_uris" field.
+func (ouo *Oauth2ClientUpdateOne) AppendRedirectUris(s []string) *Oauth2ClientUpdateOne {
+	ouo.mutation.AppendRedirectUris(s)
+	return ouo
 }
 
-// SetSecret sets the "secret" field.
-func (ouo *Oauth2ClientUpdateOne) SetSecret(s string) *Oauth2ClientUpdateOne {
-	ouo.mutation.SetSecret(s)
+// ClearRedirectUris clears the value of the "redirect_uris" field.
+func (ouo *Oauth2ClientUpdateOne) ClearRedirectUris() *Oauth2ClientUpdateOne {
+	ouo.mutation.ClearRedirectUris()
 	return ouo
 }
 
-// SetNillableSecret sets the "secret" field if the given value is not nil.
-func (ouo *Oauth2ClientUpdateOne) SetNillableSecret(s *string) *Oauth2ClientUpdateOne {
+// SetGrantTypes sets the "grant_types" field.
+func (ouo *Oauth2ClientUpdateOne) SetGrantTypes(s []string) *Oauth2ClientUpdateOne {
+	ouo.mutation.SetGrantTypes(s)
+	return ouo
+}
+
+// AppendGrantTypes appends s to the "grant_types" field.
+func (ouo *Oauth2ClientUpdateOne) AppendGrantTypes(s []string) *Oauth2ClientUpdateOne {
+	ouo.mutation.AppendGrantTypes(s)
+	return ouo
+}
+
+// ClearGrantTypes clears the value of the "grant_types" field.
+func (ouo *Oauth2ClientUpdateOne) ClearGrantTypes() *Oauth2ClientUpdateOne {
+	ouo.mutation.ClearGrantTypes()
+	return ouo
+}
+
+// SetResponseTypes sets the "response_types" field.
+func (ouo *Oauth2ClientUpdateOne) SetResponseTypes(s []string) *Oauth2ClientUpdateOne {
+	ouo.mutation.SetResponseTypes(s)
+	return ouo
+}
+
+// AppendResponseTypes appends s to the "response_types" field.
+func (ouo *Oauth2ClientUpdateOne) AppendResponseTypes(s []string) *Oauth2ClientUpdateOne {
+	ouo.mutation.AppendResponseTypes(s)
+	return ouo
+}
+
+// ClearResponseTypes clears the value of the "response_types" field.
+func (ouo *Oauth2ClientUpdateOne) ClearResponseTypes() *Oauth2ClientUpdateOne {
+	ouo.mutation.ClearResponseTypes()
+	return ouo
+}
+
+// SetRegistrationAccessTokenHash sets the "registration_access_token_hash" field.
+func (ouo *Oauth2ClientUpdateOne) SetRegistrationAccessTokenHash(s string) *Oauth2ClientUpdateOne {
+	ouo.mutation.SetRegistrationAccessTokenHash(s)
+	return ouo
+}
+
+// SetNillableRegistrationAccessTokenHash sets the "registration_access_token_hash" field if the given value is not nil.
+func (ouo *Oauth2ClientUpdateOne) SetNillableRegistrationAccessTokenHash(s *string) *Oauth2ClientUpdateOne {
 	if s != nil {
-		ouo.SetSecret(*s)
+		ouo.SetRegistrationAccessTokenHash(*s)
 	}
 	return ouo
 }
 
-// SetDomain sets the "domain" field.
-func (ouo *Oauth2ClientUpdateOne) SetDomain(s string) *Oauth2ClientUpdateOne {
-	ouo.mutation.SetDomain(s)
+// ClearRegistrationAccessTokenHash clears the value of the "registration_access_token_hash" field.
+func (ouo *Oauth2ClientUpdateOne) ClearRegistrationAccessTokenHash() *Oauth2ClientUpdateOne {
+	ouo.mutation.ClearRegistrationAccessTokenHash()
 	return ouo
 }
 
-// SetNillableDomain sets the "domain" field if the given value is not nil.
-func (ouo *Oauth2ClientUpdateOne) SetNillableDomain(s *string) *Oauth2ClientUpdateOne {
+// SetFirstParty sets the "first_party" field.
+func (ouo *Oauth2ClientUpdateOne) SetFirstParty(b bool) *Oauth2ClientUpdateOne {
+	ouo.mutation.SetFirstParty(b)
+	return ouo
+}
+
+// SetNillableFirstParty sets the "first_party" field if the given value is not nil.
+func (ouo *Oauth2ClientUpdateOne) SetNillableFirstParty(b *bool) *Oauth2ClientUpdateOne {
+	if b != nil {
+		ouo.SetFirstParty(*b)
+	}
+	return ouo
+}
+
+// SetLogoURI sets the "logo_uri" field.
+func (ouo *Oauth2ClientUpdateOne) SetLogoURI(s string) *Oauth2ClientUpdateOne {
+	ouo.mutation.SetLogoURI(s)
+	return ouo
+}
+
+// SetNillableLogoURI sets the "logo_uri" field if the given value is not nil.
+func (ouo *Oauth2ClientUpdateOne) SetNillableLogoURI(s *string) *Oauth2ClientUpdateOne {
 	if s != nil {
-		ouo.SetDomain(*s)
+		ouo.SetLogoURI(*s)
+	}
+	return ouo
+}
+
+// ClearLogoURI clears the value of the "logo_uri" field.
+func (ouo *Oauth2ClientUpdateOne) ClearLogoURI() *Oauth2ClientUpdateOne {
+	ouo.mutation.ClearLogoURI()
+	return ouo
+}
+
+// SetPostLogoutRedirectUris sets the "post_logout_redirect_uris" field.
+func (ouo *Oauth2ClientUpdateOne) SetPostLogoutRedirectUris(s []string) *Oauth2ClientUpdateOne {
+	ouo.mutation.SetPostLogoutRedirectUris(s)
+	return ouo
+}
+
+// AppendPostLogoutRedirectUris appends s to the "post_logout_redirect_uris" field.
+func (ouo *Oauth2ClientUpdateOne) AppendPostLogoutRedirectUris(s []string) *Oauth2ClientUpdateOne {
+	ouo.mutation.AppendPostLogoutRedirectUris(s)
+	return ouo
+}
+
+// ClearPostLogoutRedirectUris clears the value of the "post_logout_redirect_uris" field.
+func (ouo *Oauth2ClientUpdateOne) ClearPostLogoutRedirectUris() *Oauth2ClientUpdateOne {
+	ouo.mutation.ClearPostLogoutRedirectUris()
+	return ouo
+}
+
+// SetAllowedScopes sets the "allowed_scopes" field.
+func (ouo *Oauth2ClientUpdateOne) SetAllowedScopes(s []string) *Oauth2ClientUpdateOne {
+	ouo.mutation.SetAllowedScopes(s)
+	return ouo
+}
+
+// AppendAllowedScopes appends s to the "allowed_scopes" field.
+func (ouo *Oauth2ClientUpdateOne) AppendAllowedScopes(s []string) *Oauth2ClientUpdateOne {
+	ouo.mutation.AppendAllowedScopes(s)
+	return ouo
+}
+
+// ClearAllowedScopes clears the value of the "allowed_scopes" field.
+func (ouo *Oauth2ClientUpdateOne) ClearAllowedScopes() *Oauth2ClientUpdateOne {
+	ouo.mutation.ClearAllowedScopes()
+	return ouo
+}
+
+// SetTokenClaims sets the "token_claims" field.
+func (ouo *Oauth2ClientUpdateOne) SetTokenClaims(s []string) *Oauth2ClientUpdateOne {
+	ouo.mutation.SetTokenClaims(s)
+	return ouo
+}
+
+// AppendTokenClaims appends s to the "token_claims" field.
+func (ouo *Oauth2ClientUpdateOne) AppendTokenClaims(s []string) *Oauth2ClientUpdateOne {
+	ouo.mutation.AppendTokenClaims(s)
+	return ouo
+}
+
+// ClearTokenClaims clears the value of the "token_claims" field.
+func (ouo *Oauth2ClientUpdateOne) ClearTokenClaims() *Oauth2ClientUpdateOne {
+	ouo.mutation.ClearTokenClaims()
+	return ouo
+}
+
+// AddConsentIDs adds the "consents" edge to the Consent entity by IDs.
+func (ouo *Oauth2ClientUpdateOne) AddConsentIDs(ids ...uuid.UUID) *Oauth2ClientUpdateOne {
+	ouo.mutation.AddConsentIDs(ids...)
+	return ouo
+}
+
+// AddConsents adds the "consents" edges to the Consent entity.
+func (ouo *Oauth2ClientUpdateOne) AddConsents(c ...*Consent) *Oauth2ClientUpdateOne {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
 	}
+	return ouo.AddConsentIDs(ids...)
+}
+
+// AddSecretIDs adds the "secrets" edge to the ClientSecret entity by IDs.
+func (ouo *Oauth2ClientUpdateOne) AddSecretIDs(ids ...uuid.UUID) *Oauth2ClientUpdateOne {
+	ouo.mutation.AddSecretIDs(ids...)
 	return ouo
 }
 
+// AddSecrets adds the "secrets" edges to the ClientSecret entity.
+func (ouo *Oauth2ClientUpdateOne) AddSecrets(c ...*ClientSecret) *Oauth2ClientUpdateOne {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return ouo.AddSecretIDs(ids...)
+}
+
 // Mutation returns the Oauth2ClientMutation object of the builder.
 func (ouo *Oauth2ClientUpdateOne) Mutation() *Oauth2ClientMutation {
 	return ouo.mutation
 }
 
+// ClearConsents clears all "consents" edges to the Consent entity.
+func (ouo *Oauth2ClientUpdateOne) ClearConsents() *Oauth2ClientUpdateOne {
+	ouo.mutation.ClearConsents()
+	return ouo
+}
+
+// RemoveConsentIDs removes the "consents" edge to Consent entities by IDs.
+func (ouo *Oauth2ClientUpdateOne) RemoveConsentIDs(ids ...uuid.UUID) *Oauth2ClientUpdateOne {
+	ouo.mutation.RemoveConsentIDs(ids...)
+	return ouo
+}
+
+// RemoveConsents removes "consents" edges to Consent entities.
+func (ouo *Oauth2ClientUpdateOne) RemoveConsents(c ...*Consent) *Oauth2ClientUpdateOne {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return ouo.RemoveConsentIDs(ids...)
+}
+
+// ClearSecrets clears all "secrets" edges to the ClientSecret entity.
+func (ouo *Oauth2ClientUpdateOne) ClearSecrets() *Oauth2ClientUpdateOne {
+	ouo.mutation.ClearSecrets()
+	return ouo
+}
+
+// RemoveSecretIDs removes the "secrets" edge to ClientSecret entities by IDs.
+func (ouo *Oauth2ClientUpdateOne) RemoveSecretIDs(ids ...uuid.UUID) *Oauth2ClientUpdateOne {
+	ouo.mutation.RemoveSecretIDs(ids...)
+	return ouo
+}
+
+// RemoveSecrets removes "secrets" edges to ClientSecret entities.
+func (ouo *Oauth2ClientUpdateOne) RemoveSecrets(c ...*ClientSecret) *Oauth2ClientUpdateOne {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return ouo.RemoveSecretIDs(ids...)
+}
+
 // Where appends a list predicates to the Oauth2ClientUpdate builder.
 func (ouo *Oauth2ClientUpdateOne) Where(ps ...predicate.Oauth2Client) *Oauth2ClientUpdateOne {
 	ouo.mutation.Where(ps...)
@@ -215,11 +1364,6 @@ func (ouo *Oauth2ClientUpdateOne) ExecX(ctx context.Context) {
 
 // check runs all checks and user-defined validators on the builder.
 func (ouo *Oauth2ClientUpdateOne) check() error {
-	if v, ok := ouo.mutation.Secret(); ok {
-		if err := oauth2client.SecretValidator(v); err != nil {
-			return &ValidationError{Name: "secret", err: fmt.Errorf(`ent: validator failed for field "Oauth2Client.secret": %w`, err)}
-		}
-	}
 	if v, ok := ouo.mutation.Domain(); ok {
 		if err := oauth2client.DomainValidator(v); err != nil {
 			return &ValidationError{Name: "domain", err: fmt.Errorf(`ent: validator failed for field "Oauth2Client.domain": %w`, err)}
@@ -260,9 +1404,266 @@ func (ouo *Oauth2ClientUpdateOne) sqlSave(ctx context.Context) (_node *Oauth2Cli
 	if value, ok := ouo.mutation.Secret(); ok {
 		_spec.SetField(oauth2client.FieldSecret, field.TypeString, value)
 	}
+	if ouo.mutation.SecretCleared() {
+		_spec.ClearField(oauth2client.FieldSecret, field.TypeString)
+	}
 	if value, ok := ouo.mutation.Domain(); ok {
 		_spec.SetField(oauth2client.FieldDomain, field.TypeString, value)
 	}
+	if value, ok := ouo.mutation.RequirePkce(); ok {
+		_spec.SetField(oauth2client.FieldRequirePkce, field.TypeBool, value)
+	}
+	if value, ok := ouo.mutation.ExchangeAudiences(); ok {
+		_spec.SetField(oauth2client.FieldExchangeAudiences, field.TypeJSON, value)
+	}
+	if value, ok := ouo.mutation.AppendedExchangeAudiences(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, oauth2client.FieldExchangeAudiences, value)
+		})
+	}
+	if ouo.mutation.ExchangeAudiencesCleared() {
+		_spec.ClearField(oauth2client.FieldExchangeAudiences, field.TypeJSON)
+	}
+	if value, ok := ouo.mutation.ExchangeScopes(); ok {
+		_spec.SetField(oauth2client.FieldExchangeScopes, field.TypeJSON, value)
+	}
+	if value, ok := ouo.mutation.AppendedExchangeScopes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, oauth2client.FieldExchangeScopes, value)
+		})
+	}
+	if ouo.mutation.ExchangeScopesCleared() {
+		_spec.ClearField(oauth2client.FieldExchangeScopes, field.TypeJSON)
+	}
+	if value, ok := ouo.mutation.JwtBearerIssuers(); ok {
+		_spec.SetField(oauth2client.FieldJwtBearerIssuers, field.TypeJSON, value)
+	}
+	if ouo.mutation.JwtBearerIssuersCleared() {
+		_spec.ClearField(oauth2client.FieldJwtBearerIssuers, field.TypeJSON)
+	}
+	if value, ok := ouo.mutation.TokenEndpointAuthMethod(); ok {
+		_spec.SetField(oauth2client.FieldTokenEndpointAuthMethod, field.TypeString, value)
+	}
+	if ouo.mutation.TokenEndpointAuthMethodCleared() {
+		_spec.ClearField(oauth2client.FieldTokenEndpointAuthMethod, field.TypeString)
+	}
+	if value, ok := ouo.mutation.Jwks(); ok {
+		_spec.SetField(oauth2client.FieldJwks, field.TypeJSON, value)
+	}
+	if value, ok := ouo.mutation.AppendedJwks(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, oauth2client.FieldJwks, value)
+		})
+	}
+	if ouo.mutation.JwksCleared() {
+		_spec.ClearField(oauth2client.FieldJwks, field.TypeJSON)
+	}
+	if value, ok := ouo.mutation.JwksURI(); ok {
+		_spec.SetField(oauth2client.FieldJwksURI, field.TypeString, value)
+	}
+	if ouo.mutation.JwksURICleared() {
+		_spec.ClearField(oauth2client.FieldJwksURI, field.TypeString)
+	}
+	if value, ok := ouo.mutation.TLSClientAuthSubjectDn(); ok {
+		_spec.SetField(oauth2client.FieldTLSClientAuthSubjectDn, field.TypeString, value)
+	}
+	if ouo.mutation.TLSClientAuthSubjectDnCleared() {
+		_spec.ClearField(oauth2client.FieldTLSClientAuthSubjectDn, field.TypeString)
+	}
+	if value, ok := ouo.mutation.DpopBoundAccessTokens(); ok {
+		_spec.SetField(oauth2client.FieldDpopBoundAccessTokens, field.TypeBool, value)
+	}
+	if value, ok := ouo.mutation.RequirePushedAuthorizationRequests(); ok {
+		_spec.SetField(oauth2client.FieldRequirePushedAuthorizationRequests, field.TypeBool, value)
+	}
+	if value, ok := ouo.mutation.RequestUris(); ok {
+		_spec.SetField(oauth2client.FieldRequestUris, field.TypeJSON, value)
+	}
+	if value, ok := ouo.mutation.AppendedRequestUris(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, oauth2client.FieldRequestUris, value)
+		})
+	}
+	if ouo.mutation.RequestUrisCleared() {
+		_spec.ClearField(oauth2client.FieldRequestUris, field.TypeJSON)
+	}
+	if value, ok := ouo.mutation.ClientName(); ok {
+		_spec.SetField(oauth2client.FieldClientName, field.TypeString, value)
+	}
+	if ouo.mutation.ClientNameCleared() {
+		_spec.ClearField(oauth2client.FieldClientName, field.TypeString)
+	}
+	if value, ok := ouo.mutation.RedirectUris(); ok {
+		_spec.SetField(oauth2client.FieldRedirectUris, field.TypeJSON, value)
+	}
+	if value, ok := ouo.mutation.AppendedRedirectUris(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, oauth2client.FieldRedirectUris, value)
+		})
+	}
+	if ouo.mutation.RedirectUrisCleared() {
+		_spec.ClearField(oauth2client.FieldRedirectUris, field.TypeJSON)
+	}
+	if value, ok := ouo.mutation.GrantTypes(); ok {
+		_spec.SetField(oauth2client.FieldGrantTypes, field.TypeJSON, value)
+	}
+	if value, ok := ouo.mutation.AppendedGrantTypes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, oauth2client.FieldGrantTypes, value)
+		})
+	}
+	if ouo.mutation.GrantTypesCleared() {
+		_spec.ClearField(oauth2client.FieldGrantTypes, field.TypeJSON)
+	}
+	if value, ok := ouo.mutation.ResponseTypes(); ok {
+		_spec.SetField(oauth2client.FieldResponseTypes, field.TypeJSON, value)
+	}
+	if value, ok := ouo.mutation.AppendedResponseTypes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, oauth2client.FieldResponseTypes, value)
+		})
+	}
+	if ouo.mutation.ResponseTypesCleared() {
+		_spec.ClearField(oauth2client.FieldResponseTypes, field.TypeJSON)
+	}
+	if value, ok := ouo.mutation.RegistrationAccessTokenHash(); ok {
+		_spec.SetField(oauth2client.FieldRegistrationAccessTokenHash, field.TypeString, value)
+	}
+	if ouo.mutation.RegistrationAccessTokenHashCleared() {
+		_spec.ClearField(oauth2client.FieldRegistrationAccessTokenHash, field.TypeString)
+	}
+	if value, ok := ouo.mutation.FirstParty(); ok {
+		_spec.SetField(oauth2client.FieldFirstParty, field.TypeBool, value)
+	}
+	if value, ok := ouo.mutation.LogoURI(); ok {
+		_spec.SetField(oauth2client.FieldLogoURI, field.TypeString, value)
+	}
+	if ouo.mutation.LogoURICleared() {
+		_spec.ClearField(oauth2client.FieldLogoURI, field.TypeString)
+	}
+	if value, ok := ouo.mutation.PostLogoutRedirectUris(); ok {
+		_spec.SetField(oauth2client.FieldPostLogoutRedirectUris, field.TypeJSON, value)
+	}
+	if value, ok := ouo.mutation.AppendedPostLogoutRedirectUris(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, oauth2client.FieldPostLogoutRedirectUris, value)
+		})
+	}
+	if ouo.mutation.PostLogoutRedirectUrisCleared() {
+		_spec.ClearField(oauth2client.FieldPostLogoutRedirectUris, field.TypeJSON)
+	}
+	if value, ok := ouo.mutation.AllowedScopes(); ok {
+		_spec.SetField(oauth2client.FieldAllowedScopes, field.TypeJSON, value)
+	}
+	if value, ok := ouo.mutation.AppendedAllowedScopes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, oauth2client.FieldAllowedScopes, value)
+		})
+	}
+	if ouo.mutation.AllowedScopesCleared() {
+		_spec.ClearField(oauth2client.FieldAllowedScopes, field.TypeJSON)
+	}
+	if value, ok := ouo.mutation.TokenClaims(); ok {
+		_spec.SetField(oauth2client.FieldTokenClaims, field.TypeJSON, value)
+	}
+	if value, ok := ouo.mutation.AppendedTokenClaims(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, oauth2client.FieldTokenClaims, value)
+		})
+	}
+	if ouo.mutation.TokenClaimsCleared() {
+		_spec.ClearField(oauth2client.FieldTokenClaims, field.TypeJSON)
+	}
+	if ouo.mutation.ConsentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   oauth2client.ConsentsTable,
+			Columns: []string{oauth2client.ConsentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(consent.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ouo.mutation.RemovedConsentsIDs(); len(nodes) > 0 && !ouo.mutation.ConsentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   oauth2client.ConsentsTable,
+			Columns: []string{oauth2client.ConsentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(consent.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ouo.mutation.ConsentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   oauth2client.ConsentsTable,
+			Columns: []string{oauth2client.ConsentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(consent.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ouo.mutation.SecretsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   oauth2client.SecretsTable,
+			Columns: []string{oauth2client.SecretsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(clientsecret.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ouo.mutation.RemovedSecretsIDs(); len(nodes) > 0 && !ouo.mutation.SecretsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   oauth2client.SecretsTable,
+			Columns: []string{oauth2client.SecretsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(clientsecret.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ouo.mutation.SecretsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   oauth2client.SecretsTable,
+			Columns: []string{oauth2client.SecretsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(clientsecret.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Oauth2Client{config: ouo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -6,5 +6,17 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// ClientSecret is the predicate function for clientsecret builders.
+type ClientSecret func(*sql.Selector)
+
+// Consent is the predicate function for consent builders.
+type Consent func(*sql.Selector)
+
 // Oauth2Client is the predicate function for oauth2client builders.
 type Oauth2Client func(*sql.Selector)
+
+// Scope is the predicate function for scope builders.
+type Scope func(*sql.Selector)
+
+// ScopePermission is the predicate function for scopepermission builders.
+type ScopePermission func(*sql.Selector)
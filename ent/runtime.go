@@ -3,8 +3,14 @@
 package ent
 
 import (
+	"time"
+
+	"github.com/byebyebymyai/oauth2-api/ent/clientsecret"
+	"github.com/byebyebymyai/oauth2-api/ent/consent"
 	"github.com/byebyebymyai/oauth2-api/ent/oauth2client"
 	"github.com/byebyebymyai/oauth2-api/ent/schema"
+	"github.com/byebyebymyai/oauth2-api/ent/scope"
+	"github.com/byebyebymyai/oauth2-api/ent/scopepermission"
 	"github.com/google/uuid"
 )
 
@@ -12,21 +18,105 @@ import (
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	clientsecretMixin := schema.ClientSecret{}.Mixin()
+	clientsecretMixinFields0 := clientsecretMixin[0].Fields()
+	_ = clientsecretMixinFields0
+	clientsecretFields := schema.ClientSecret{}.Fields()
+	_ = clientsecretFields
+	// clientsecretDescHash is the schema descriptor for hash field.
+	clientsecretDescHash := clientsecretFields[0].Descriptor()
+	// clientsecret.HashValidator is a validator for the "hash" field. It is called by the builders before save.
+	clientsecret.HashValidator = clientsecretDescHash.Validators[0].(func(string) error)
+	// clientsecretDescCreatedAt is the schema descriptor for created_at field.
+	clientsecretDescCreatedAt := clientsecretFields[2].Descriptor()
+	// clientsecret.DefaultCreatedAt holds the default value on creation for the created_at field.
+	clientsecret.DefaultCreatedAt = clientsecretDescCreatedAt.Default.(func() time.Time)
+	// clientsecretDescID is the schema descriptor for id field.
+	clientsecretDescID := clientsecretMixinFields0[0].Descriptor()
+	// clientsecret.DefaultID holds the default value on creation for the id field.
+	clientsecret.DefaultID = clientsecretDescID.Default.(func() uuid.UUID)
+	consentMixin := schema.Consent{}.Mixin()
+	consentMixinFields0 := consentMixin[0].Fields()
+	_ = consentMixinFields0
+	consentFields := schema.Consent{}.Fields()
+	_ = consentFields
+	// consentDescGrantedAt is the schema descriptor for granted_at field.
+	consentDescGrantedAt := consentFields[2].Descriptor()
+	// consent.DefaultGrantedAt holds the default value on creation for the granted_at field.
+	consent.DefaultGrantedAt = consentDescGrantedAt.Default.(func() time.Time)
+	// consentDescID is the schema descriptor for id field.
+	consentDescID := consentMixinFields0[0].Descriptor()
+	// consent.DefaultID holds the default value on creation for the id field.
+	consent.DefaultID = consentDescID.Default.(func() uuid.UUID)
 	oauth2clientMixin := schema.Oauth2Client{}.Mixin()
 	oauth2clientMixinFields0 := oauth2clientMixin[0].Fields()
 	_ = oauth2clientMixinFields0
 	oauth2clientFields := schema.Oauth2Client{}.Fields()
 	_ = oauth2clientFields
-	// oauth2clientDescSecret is the schema descriptor for secret field.
-	oauth2clientDescSecret := oauth2clientFields[0].Descriptor()
-	// oauth2client.SecretValidator is a validator for the "secret" field. It is called by the builders before save.
-	oauth2client.SecretValidator = oauth2clientDescSecret.Validators[0].(func(string) error)
 	// oauth2clientDescDomain is the schema descriptor for domain field.
 	oauth2clientDescDomain := oauth2clientFields[1].Descriptor()
 	// oauth2client.DomainValidator is a validator for the "domain" field. It is called by the builders before save.
 	oauth2client.DomainValidator = oauth2clientDescDomain.Validators[0].(func(string) error)
+	// oauth2clientDescRequirePkce is the schema descriptor for require_pkce field.
+	oauth2clientDescRequirePkce := oauth2clientFields[2].Descriptor()
+	// oauth2client.DefaultRequirePkce holds the default value on creation for the require_pkce field.
+	oauth2client.DefaultRequirePkce = oauth2clientDescRequirePkce.Default.(bool)
+	// oauth2clientDescDpopBoundAccessTokens is the schema descriptor for dpop_bound_access_tokens field.
+	oauth2clientDescDpopBoundAccessTokens := oauth2clientFields[10].Descriptor()
+	// oauth2client.DefaultDpopBoundAccessTokens holds the default value on creation for the dpop_bound_access_tokens field.
+	oauth2client.DefaultDpopBoundAccessTokens = oauth2clientDescDpopBoundAccessTokens.Default.(bool)
+	// oauth2clientDescRequirePushedAuthorizationRequests is the schema descriptor for require_pushed_authorization_requests field.
+	oauth2clientDescRequirePushedAuthorizationRequests := oauth2clientFields[11].Descriptor()
+	// oauth2client.DefaultRequirePushedAuthorizationRequests holds the default value on creation for the require_pushed_authorization_requests field.
+	oauth2client.DefaultRequirePushedAuthorizationRequests = oauth2clientDescRequirePushedAuthorizationRequests.Default.(bool)
+	// oauth2clientDescFirstParty is the schema descriptor for first_party field.
+	oauth2clientDescFirstParty := oauth2clientFields[18].Descriptor()
+	// oauth2client.DefaultFirstParty holds the default value on creation for the first_party field.
+	oauth2client.DefaultFirstParty = oauth2clientDescFirstParty.Default.(bool)
 	// oauth2clientDescID is the schema descriptor for id field.
 	oauth2clientDescID := oauth2clientMixinFields0[0].Descriptor()
 	// oauth2client.DefaultID holds the default value on creation for the id field.
 	oauth2client.DefaultID = oauth2clientDescID.Default.(func() uuid.UUID)
+	scopeMixin := schema.Scope{}.Mixin()
+	scopeMixinFields0 := scopeMixin[0].Fields()
+	_ = scopeMixinFields0
+	scopeFields := schema.Scope{}.Fields()
+	_ = scopeFields
+	// scopeDescName is the schema descriptor for name field.
+	scopeDescName := scopeFields[0].Descriptor()
+	// scope.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	scope.NameValidator = scopeDescName.Validators[0].(func(string) error)
+	// scopeDescIsDefault is the schema descriptor for is_default field.
+	scopeDescIsDefault := scopeFields[2].Descriptor()
+	// scope.DefaultIsDefault holds the default value on creation for the is_default field.
+	scope.DefaultIsDefault = scopeDescIsDefault.Default.(bool)
+	// scopeDescRequiresConsent is the schema descriptor for requires_consent field.
+	scopeDescRequiresConsent := scopeFields[3].Descriptor()
+	// scope.DefaultRequiresConsent holds the default value on creation for the requires_consent field.
+	scope.DefaultRequiresConsent = scopeDescRequiresConsent.Default.(bool)
+	// scopeDescID is the schema descriptor for id field.
+	scopeDescID := scopeMixinFields0[0].Descriptor()
+	// scope.DefaultID holds the default value on creation for the id field.
+	scope.DefaultID = scopeDescID.Default.(func() uuid.UUID)
+	scopepermissionMixin := schema.ScopePermission{}.Mixin()
+	scopepermissionMixinFields0 := scopepermissionMixin[0].Fields()
+	_ = scopepermissionMixinFields0
+	scopepermissionFields := schema.ScopePermission{}.Fields()
+	_ = scopepermissionFields
+	// scopepermissionDescMethod is the schema descriptor for method field.
+	scopepermissionDescMethod := scopepermissionFields[0].Descriptor()
+	// scopepermission.MethodValidator is a validator for the "method" field. It is called by the builders before save.
+	scopepermission.MethodValidator = scopepermissionDescMethod.Validators[0].(func(string) error)
+	// scopepermissionDescHost is the schema descriptor for host field.
+	scopepermissionDescHost := scopepermissionFields[1].Descriptor()
+	// scopepermission.HostValidator is a validator for the "host" field. It is called by the builders before save.
+	scopepermission.HostValidator = scopepermissionDescHost.Validators[0].(func(string) error)
+	// scopepermissionDescPath is the schema descriptor for path field.
+	scopepermissionDescPath := scopepermissionFields[2].Descriptor()
+	// scopepermission.PathValidator is a validator for the "path" field. It is called by the builders before save.
+	scopepermission.PathValidator = scopepermissionDescPath.Validators[0].(func(string) error)
+	// scopepermissionDescID is the schema descriptor for id field.
+	scopepermissionDescID := scopepermissionMixinFields0[0].Descriptor()
+	// scopepermission.DefaultID holds the default value on creation for the id field.
+	scopepermission.DefaultID = scopepermissionDescID.Default.(func() uuid.UUID)
 }
@@ -0,0 +1,57 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/contrib/entproto"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/byebyebymyai/oauth2-api/ent/schema/uuidgql"
+)
+
+// ClientSecret holds the schema definition for the ClientSecret entity, a
+// secret of an Oauth2Client of which only the hash, and for client_secret_jwt
+// the encrypted secret, is stored.
+type ClientSecret struct {
+	ent.Schema
+}
+
+// Fields of the ClientSecret.
+func (ClientSecret) Fields() []ent.Field {
+	return []ent.Field{
+		// hash is the bcrypt hash of the secret.
+		field.String("hash").NotEmpty().Sensitive().Annotations(entproto.Field(2)),
+		// ciphertext is the secret encrypted with CLIENT_SECRET_KEY, kept for
+		// client_secret_jwt clients, which need the secret itself to verify
+		// their assertions.
+		field.Bytes("ciphertext").Optional().Sensitive().Annotations(entproto.Field(6)),
+		field.Time("created_at").Default(time.Now).Immutable().Annotations(entproto.Field(3)),
+		// expires_at is unset for secrets valid until they are revoked.
+		field.Time("expires_at").Optional().Nillable().Annotations(entproto.Field(4)),
+	}
+}
+
+// Edges of the ClientSecret.
+func (ClientSecret) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("client", Oauth2Client.Type).
+			Ref("secrets").
+			Unique().
+			Required().
+			Annotations(entproto.Field(5)),
+	}
+}
+
+// Mixin returns ClientSecret mixed-in schema.
+func (ClientSecret) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		uuidgql.MixinWithID(),
+	}
+}
+
+// Annotations returns ClientSecret annotations.
+func (ClientSecret) Annotations() []schema.Annotation {
+	return []schema.Annotation{}
+}
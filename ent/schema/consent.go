@@ -0,0 +1,61 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/contrib/entproto"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/byebyebymyai/oauth2-api/ent/schema/uuidgql"
+	"github.com/google/uuid"
+)
+
+// Consent holds the schema definition for the Consent entity, the scopes a
+// user has granted to a client.
+type Consent struct {
+	ent.Schema
+}
+
+// Fields of the Consent.
+func (Consent) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("user_id", uuid.UUID{}).Annotations(entproto.Field(2)),
+		field.Strings("scopes").Optional().Annotations(entproto.Field(3)),
+		field.Time("granted_at").Default(time.Now).Annotations(entproto.Field(4)),
+		// expires_at is unset for consents kept until they are revoked.
+		field.Time("expires_at").Optional().Nillable().Annotations(entproto.Field(5)),
+	}
+}
+
+// Edges of the Consent.
+func (Consent) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("client", Oauth2Client.Type).
+			Ref("consents").
+			Unique().
+			Required().
+			Annotations(entproto.Field(6)),
+	}
+}
+
+// Indexes of the Consent.
+func (Consent) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id").Edges("client").Unique(),
+	}
+}
+
+// Mixin returns Consent mixed-in schema.
+func (Consent) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		uuidgql.MixinWithID(),
+	}
+}
+
+// Annotations returns Consent annotations.
+func (Consent) Annotations() []schema.Annotation {
+	return []schema.Annotation{}
+}
@@ -1,9 +1,13 @@
 package schema
 
 import (
+	"encoding/json"
+
 	"entgo.io/contrib/entproto"
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"github.com/byebyebymyai/oauth2-api/ent/schema/uuidgql"
 )
@@ -16,14 +20,74 @@ type Oauth2Client struct {
 // Fields of the Oauth2Client.
 func (Oauth2Client) Fields() []ent.Field {
 	return []ent.Field{
-		field.String("secret").NotEmpty().Annotations(entproto.Field(2)),
+		// secret is the plaintext secret of client_secret_jwt clients, which
+		// is needed to verify their assertions. The secrets of the other
+		// clients are hashed, see ClientSecret.
+		field.String("secret").Optional().Sensitive().Annotations(entproto.Field(2)),
 		field.String("domain").NotEmpty().Annotations(entproto.Field(3)),
+		// require_pkce forces the authorization code flow to use PKCE with S256.
+		field.Bool("require_pkce").Default(false).Annotations(entproto.Field(4)),
+		// token exchange policy: the audiences and scopes the client may
+		// exchange tokens for.
+		field.Strings("exchange_audiences").Optional().Annotations(entproto.Field(5)),
+		field.Strings("exchange_scopes").Optional().Annotations(entproto.Field(6)),
+		// jwt_bearer_issuers maps the issuers trusted for the JWT bearer
+		// grant to their JWKS.
+		field.JSON("jwt_bearer_issuers", map[string]json.RawMessage{}).Optional().Annotations(entproto.Field(7)),
+		// token_endpoint_auth_method is the registered client authentication
+		// method. Clients using private_key_jwt or
+		// self_signed_tls_client_auth are verified with their jwks or the
+		// keys published at their jwks_uri.
+		field.String("token_endpoint_auth_method").Optional().Annotations(entproto.Field(8)),
+		field.JSON("jwks", json.RawMessage{}).Optional().Annotations(entproto.Field(9)),
+		field.String("jwks_uri").Optional().Annotations(entproto.Field(10)),
+		// tls_client_auth_subject_dn is the subject DN of the client
+		// certificate for tls_client_auth, in RFC 4514 form.
+		field.String("tls_client_auth_subject_dn").Optional().Annotations(entproto.Field(11)),
+		// dpop_bound_access_tokens requires DPoP proofs on token requests.
+		field.Bool("dpop_bound_access_tokens").Default(false).Annotations(entproto.Field(12)),
+		// require_pushed_authorization_requests only accepts authorization
+		// requests pushed to /par.
+		field.Bool("require_pushed_authorization_requests").Default(false).Annotations(entproto.Field(13)),
+		// request_uris lists the URIs /authorize may fetch request objects
+		// of the client from.
+		field.Strings("request_uris").Optional().Annotations(entproto.Field(14)),
+		// client metadata of dynamic client registration (RFC 7591)
+		field.String("client_name").Optional().Annotations(entproto.Field(15)),
+		// redirect_uris must match the redirect URI of authorization requests
+		// exactly, except for the port of loopback URIs. Without them, the
+		// redirect URI must be on the domain.
+		field.Strings("redirect_uris").Optional().Annotations(entproto.Field(16)),
+		// grant_types and response_types limit the grants and response types
+		// the client may use; any when empty.
+		field.Strings("grant_types").Optional().Annotations(entproto.Field(17)),
+		field.Strings("response_types").Optional().Annotations(entproto.Field(18)),
+		// registration_access_token_hash is the SHA-256 of the token that
+		// manages the registration (RFC 7592).
+		field.String("registration_access_token_hash").Optional().Sensitive().Annotations(entproto.Field(19)),
+		// first_party clients are trusted and skip the consent screen.
+		field.Bool("first_party").Default(false).Annotations(entproto.Field(20)),
+		field.String("logo_uri").Optional().Annotations(entproto.Field(21)),
+		// post_logout_redirect_uris lists where /logout may send the user.
+		field.Strings("post_logout_redirect_uris").Optional().Annotations(entproto.Field(24)),
+		// allowed_scopes limits the scopes the client may ask for; any scope
+		// when empty.
+		field.Strings("allowed_scopes").Optional().Annotations(entproto.Field(25)),
+		// token_claims lists the RBAC claims added to the access tokens of
+		// the client: roles, permissions, group and position.
+		field.Strings("token_claims").Optional().Annotations(entproto.Field(26)),
 	}
 }
 
 // Edges of the Oauth2Client.
 func (Oauth2Client) Edges() []ent.Edge {
-	return nil
+	return []ent.Edge{
+		// the consents of a deleted client are deleted with it
+		edge.To("consents", Consent.Type).
+			Annotations(entproto.Field(22), entsql.OnDelete(entsql.Cascade)),
+		edge.To("secrets", ClientSecret.Type).
+			Annotations(entproto.Field(23), entsql.OnDelete(entsql.Cascade)),
+	}
 }
 
 // Mixin returns User mixed-in schema.
@@ -0,0 +1,52 @@
+package schema
+
+import (
+	"entgo.io/contrib/entproto"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/byebyebymyai/oauth2-api/ent/schema/uuidgql"
+)
+
+// Scope holds the schema definition for the Scope entity, an OAuth2 scope
+// and the RBAC permissions it covers.
+type Scope struct {
+	ent.Schema
+}
+
+// Fields of the Scope.
+func (Scope) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").NotEmpty().Unique().Annotations(entproto.Field(2)),
+		// description maps language tags, such as "en" or "zh-CN", to the
+		// text shown on the consent screen.
+		field.JSON("description", map[string]string{}).Optional().Annotations(entproto.Field(3)),
+		// is_default scopes are granted when a request asks for no scope.
+		field.Bool("is_default").Default(false).Annotations(entproto.Field(4)),
+		// requires_consent scopes are shown on the consent screen.
+		field.Bool("requires_consent").Default(true).Annotations(entproto.Field(5)),
+	}
+}
+
+// Edges of the Scope.
+func (Scope) Edges() []ent.Edge {
+	return []ent.Edge{
+		// a scope is only granted to users holding all of its permissions
+		edge.To("permissions", ScopePermission.Type).
+			Annotations(entproto.Field(6), entsql.OnDelete(entsql.Cascade)),
+	}
+}
+
+// Mixin returns Scope mixed-in schema.
+func (Scope) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		uuidgql.MixinWithID(),
+	}
+}
+
+// Annotations returns Scope annotations.
+func (Scope) Annotations() []schema.Annotation {
+	return []schema.Annotation{}
+}
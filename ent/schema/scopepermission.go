@@ -0,0 +1,57 @@
+package schema
+
+import (
+	"entgo.io/contrib/entproto"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/byebyebymyai/oauth2-api/ent/schema/uuidgql"
+)
+
+// ScopePermission holds the schema definition for the ScopePermission
+// entity, an RBAC permission covered by a Scope. It matches the permissions
+// of the RBAC service with the same method, host and path.
+type ScopePermission struct {
+	ent.Schema
+}
+
+// Fields of the ScopePermission.
+func (ScopePermission) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("method").NotEmpty().Annotations(entproto.Field(2)),
+		field.String("host").NotEmpty().Annotations(entproto.Field(3)),
+		field.String("path").NotEmpty().Annotations(entproto.Field(4)),
+	}
+}
+
+// Edges of the ScopePermission.
+func (ScopePermission) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("scope", Scope.Type).
+			Ref("permissions").
+			Unique().
+			Required().
+			Annotations(entproto.Field(5)),
+	}
+}
+
+// Indexes of the ScopePermission.
+func (ScopePermission) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("method", "host", "path").Edges("scope").Unique(),
+	}
+}
+
+// Mixin returns ScopePermission mixed-in schema.
+func (ScopePermission) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		uuidgql.MixinWithID(),
+	}
+}
+
+// Annotations returns ScopePermission annotations.
+func (ScopePermission) Annotations() []schema.Annotation {
+	return []schema.Annotation{}
+}
@@ -0,0 +1,170 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/byebyebymyai/oauth2-api/ent/scope"
+	"github.com/google/uuid"
+)
+
+// Scope is the model entity for the Scope schema.
+type Scope struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Description holds the value of the "description" field.
+	Description map[string]string `json:"description,omitempty"`
+	// IsDefault holds the value of the "is_default" field.
+	IsDefault bool `json:"is_default,omitempty"`
+	// RequiresConsent holds the value of the "requires_consent" field.
+	RequiresConsent bool `json:"requires_consent,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ScopeQuery when eager-loading is set.
+	Edges        ScopeEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// ScopeEdges holds the relations/edges for other nodes in the graph.
+type ScopeEdges struct {
+	// Permissions holds the value of the permissions edge.
+	Permissions []*ScopePermission `json:"permissions,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// PermissionsOrErr returns the Permissions value or an error if the edge
+// was not loaded in eager-loading.
+func (e ScopeEdges) PermissionsOrErr() ([]*ScopePermission, error) {
+	if e.loadedTypes[0] {
+		return e.Permissions, nil
+	}
+	return nil, &NotLoadedError{edge: "permissions"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Scope) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case scope.FieldDescription:
+			values[i] = new([]byte)
+		case scope.FieldIsDefault, scope.FieldRequiresConsent:
+			values[i] = new(sql.NullBool)
+		case scope.FieldName:
+			values[i] = new(sql.NullString)
+		case scope.FieldID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Scope fields.
+func (s *Scope) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case scope.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				s.ID = *value
+			}
+		case scope.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				s.Name = value.String
+			}
+		case scope.FieldDescription:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &s.Description); err != nil {
+					return fmt.Errorf("unmarshal field description: %w", err)
+				}
+			}
+		case scope.FieldIsDefault:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field is_default", values[i])
+			} else if value.Valid {
+				s.IsDefault = value.Bool
+			}
+		case scope.FieldRequiresConsent:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field requires_consent", values[i])
+			} else if value.Valid {
+				s.RequiresConsent = value.Bool
+			}
+		default:
+			s.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Scope.
+// This includes values selected through modifiers, order, etc.
+func (s *Scope) Value(name string) (ent.Value, error) {
+	return s.selectValues.Get(name)
+}
+
+// QueryPermissions queries the "permissions" edge of the Scope entity.
+func (s *Scope) QueryPermissions() *ScopePermissionQuery {
+	return NewScopeClient(s.config).QueryPermissions(s)
+}
+
+// Update returns a builder for updating this Scope.
+// Note that you need to call Scope.Unwrap() before calling this method if this Scope
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (s *Scope) Update() *ScopeUpdateOne {
+	return NewScopeClient(s.config).UpdateOne(s)
+}
+
+// Unwrap unwraps the Scope entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (s *Scope) Unwrap() *Scope {
+	_tx, ok := s.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Scope is not a transactional entity")
+	}
+	s.config.driver = _tx.drv
+	return s
+}
+
+// String implements the fmt.Stringer.
+func (s *Scope) String() string {
+	var builder strings.Builder
+	builder.WriteString("Scope(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", s.ID))
+	builder.WriteString("name=")
+	builder.WriteString(s.Name)
+	builder.WriteString(", ")
+	builder.WriteString("description=")
+	builder.WriteString(fmt.Sprintf("%v", s.Description))
+	builder.WriteString(", ")
+	builder.WriteString("is_default=")
+	builder.WriteString(fmt.Sprintf("%v", s.IsDefault))
+	builder.WriteString(", ")
+	builder.WriteString("requires_consent=")
+	builder.WriteString(fmt.Sprintf("%v", s.RequiresConsent))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Scopes is a parsable slice of Scope.
+type Scopes []*Scope
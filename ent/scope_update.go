@@ -0,0 +1,503 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/byebyebymyai/oauth2-api/ent/predicate"
+	"github.com/byebyebymyai/oauth2-api/ent/scope"
+	"github.com/byebyebymyai/oauth2-api/ent/scopepermission"
+	"github.com/google/uuid"
+)
+
+// ScopeUpdate is the builder for updating Scope entities.
+type ScopeUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ScopeMutation
+}
+
+// Where appends a list predicates to the ScopeUpdate builder.
+func (su *ScopeUpdate) Where(ps ...predicate.Scope) *ScopeUpdate {
+	su.mutation.Where(ps...)
+	return su
+}
+
+// SetName sets the "name" field.
+func (su *ScopeUpdate) SetName(s string) *ScopeUpdate {
+	su.mutation.SetName(s)
+	return su
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (su *ScopeUpdate) SetNillableName(s *string) *ScopeUpdate {
+	if s != nil {
+		su.SetName(*s)
+	}
+	return su
+}
+
+// SetDescription sets the "description" field.
+func (su *ScopeUpdate) SetDescription(m map[string]string) *ScopeUpdate {
+	su.mutation.SetDescription(m)
+	return su
+}
+
+// ClearDescription clears the value of the "description" field.
+func (su *ScopeUpdate) ClearDescription() *ScopeUpdate {
+	su.mutation.ClearDescription()
+	return su
+}
+
+// SetIsDefault sets the "is_default" field.
+func (su *ScopeUpdate) SetIsDefault(b bool) *ScopeUpdate {
+	su.mutation.SetIsDefault(b)
+	return su
+}
+
+// SetNillableIsDefault sets the "is_default" field if the given value is not nil.
+func (su *ScopeUpdate) SetNillableIsDefault(b *bool) *ScopeUpdate {
+	if b != nil {
+		su.SetIsDefault(*b)
+	}
+	return su
+}
+
+// SetRequiresConsent sets the "requires_consent" field.
+func (su *ScopeUpdate) SetRequiresConsent(b bool) *ScopeUpdate {
+	su.mutation.SetRequiresConsent(b)
+	return su
+}
+
+// SetNillableRequiresConsent sets the "requires_consent" field if the given value is not nil.
+func (su *ScopeUpdate) SetNillableRequiresConsent(b *bool) *ScopeUpdate {
+	if b != nil {
+		su.SetRequiresConsent(*b)
+	}
+	return su
+}
+
+// AddPermissionIDs adds the "permissions" edge to the ScopePermission entity by IDs.
+func (su *ScopeUpdate) AddPermissionIDs(ids ...uuid.UUID) *ScopeUpdate {
+	su.mutation.AddPermissionIDs(ids...)
+	return su
+}
+
+// AddPermissions adds the "permissions" edges to the ScopePermission entity.
+func (su *ScopeUpdate) AddPermissions(s ...*ScopePermission) *ScopeUpdate {
+	ids := make([]uuid.UUID, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return su.AddPermissionIDs(ids...)
+}
+
+// Mutation returns the ScopeMutation object of the builder.
+func (su *ScopeUpdate) Mutation() *ScopeMutation {
+	return su.mutation
+}
+
+// ClearPermissions clears all "permissions" edges to the ScopePermission entity.
+func (su *ScopeUpdate) ClearPermissions() *ScopeUpdate {
+	su.mutation.ClearPermissions()
+	return su
+}
+
+// RemovePermissionIDs removes the "permissions" edge to ScopePermission entities by IDs.
+func (su *ScopeUpdate) RemovePermissionIDs(ids ...uuid.UUID) *ScopeUpdate {
+	su.mutation.RemovePermissionIDs(ids...)
+	return su
+}
+
+// RemovePermissions removes "permissions" edges to ScopePermission entities.
+func (su *ScopeUpdate) RemovePermissions(s ...*ScopePermission) *ScopeUpdate {
+	ids := make([]uuid.UUID, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return su.RemovePermissionIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (su *ScopeUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, su.sqlSave, su.mutation, su.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (su *ScopeUpdate) SaveX(ctx context.Context) int {
+	affected, err := su.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (su *ScopeUpdate) Exec(ctx context.Context) error {
+	_, err := su.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (su *ScopeUpdate) ExecX(ctx context.Context) {
+	if err := su.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (su *ScopeUpdate) check() error {
+	if v, ok := su.mutation.Name(); ok {
+		if err := scope.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Scope.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (su *ScopeUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := su.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(scope.Table, scope.Columns, sqlgraph.NewFieldSpec(scope.FieldID, field.TypeUUID))
+	if ps := su.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := su.mutation.Name(); ok {
+		_spec.SetField(scope.FieldName, field.TypeString, value)
+	}
+	if value, ok := su.mutation.Description(); ok {
+		_spec.SetField(scope.FieldDescription, field.TypeJSON, value)
+	}
+	if su.mutation.DescriptionCleared() {
+		_spec.ClearField(scope.FieldDescription, field.TypeJSON)
+	}
+	if value, ok := su.mutation.IsDefault(); ok {
+		_spec.SetField(scope.FieldIsDefault, field.TypeBool, value)
+	}
+	if value, ok := su.mutation.RequiresConsent(); ok {
+		_spec.SetField(scope.FieldRequiresConsent, field.TypeBool, value)
+	}
+	if su.mutation.PermissionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   scope.PermissionsTable,
+			Columns: []string{scope.PermissionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scopepermission.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := su.mutation.RemovedPermissionsIDs(); len(nodes) > 0 && !su.mutation.PermissionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   scope.PermissionsTable,
+			Columns: []string{scope.PermissionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scopepermission.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := su.mutation.PermissionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   scope.PermissionsTable,
+			Columns: []string{scope.PermissionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scopepermission.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, su.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{scope.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	su.mutation.done = true
+	return n, nil
+}
+
+// ScopeUpdateOne is the builder for updating a single Scope entity.
+type ScopeUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ScopeMutation
+}
+
+// SetName sets the "name" field.
+func (suo *ScopeUpdateOne) SetName(s string) *ScopeUpdateOne {
+	suo.mutation.SetName(s)
+	return suo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (suo *ScopeUpdateOne) SetNillableName(s *string) *ScopeUpdateOne {
+	if s != nil {
+		suo.SetName(*s)
+	}
+	return suo
+}
+
+// SetDescription sets the "description" field.
+func (suo *ScopeUpdateOne) SetDescription(m map[string]string) *ScopeUpdateOne {
+	suo.mutation.SetDescription(m)
+	return suo
+}
+
+// ClearDescription clears the value of the "description" field.
+func (suo *ScopeUpdateOne) ClearDescription() *ScopeUpdateOne {
+	suo.mutation.ClearDescription()
+	return suo
+}
+
+// SetIsDefault sets the "is_default" field.
+func (suo *ScopeUpdateOne) SetIsDefault(b bool) *ScopeUpdateOne {
+	suo.mutation.SetIsDefault(b)
+	return suo
+}
+
+// SetNillableIsDefault sets the "is_default" field if the given value is not nil.
+func (suo *ScopeUpdateOne) SetNillableIsDefault(b *bool) *ScopeUpdateOne {
+	if b != nil {
+		suo.SetIsDefault(*b)
+	}
+	return suo
+}
+
+// SetRequiresConsent sets the "requires_consent" field.
+func (suo *ScopeUpdateOne) SetRequiresConsent(b bool) *ScopeUpdateOne {
+	suo.mutation.SetRequiresConsent(b)
+	return suo
+}
+
+// SetNillableRequiresConsent sets the "requires_consent" field if the given value is not nil.
+func (suo *ScopeUpdateOne) SetNillableRequiresConsent(b *bool) *ScopeUpdateOne {
+	if b != nil {
+		suo.SetRequiresConsent(*b)
+	}
+	return suo
+}
+
+// AddPermissionIDs adds the "permissions" edge to the ScopePermission entity by IDs.
+func (suo *ScopeUpdateOne) AddPermissionIDs(ids ...uuid.UUID) *ScopeUpdateOne {
+	suo.mutation.AddPermissionIDs(ids...)
+	return suo
+}
+
+// AddPermissions adds the "permissions" edges to the ScopePermission entity.
+func (suo *ScopeUpdateOne) AddPermissions(s ...*ScopePermission) *ScopeUpdateOne {
+	ids := make([]uuid.UUID, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return suo.AddPermissionIDs(ids...)
+}
+
+// Mutation returns the ScopeMutation object of the builder.
+func (suo *ScopeUpdateOne) Mutation() *ScopeMutation {
+	return suo.mutation
+}
+
+// ClearPermissions clears all "permissions" edges to the ScopePermission entity.
+func (suo *ScopeUpdateOne) ClearPermissions() *ScopeUpdateOne {
+	suo.mutation.ClearPermissions()
+	return suo
+}
+
+// RemovePermissionIDs removes the "permissions" edge to ScopePermission entities by IDs.
+func (suo *ScopeUpdateOne) RemovePermissionIDs(ids ...uuid.UUID) *ScopeUpdateOne {
+	suo.mutation.RemovePermissionIDs(ids...)
+	return suo
+}
+
+// RemovePermissions removes "permissions" edges to ScopePermission entities.
+func (suo *ScopeUpdateOne) RemovePermissions(s ...*ScopePermission) *ScopeUpdateOne {
+	ids := make([]uuid.UUID, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return suo.RemovePermissionIDs(ids...)
+}
+
+// Where appends a list predicates to the ScopeUpdate builder.
+func (suo *ScopeUpdateOne) Where(ps ...predicate.Scope) *ScopeUpdateOne {
+	suo.mutation.Where(ps...)
+	return suo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (suo *ScopeUpdateOne) Select(field string, fields ...string) *ScopeUpdateOne {
+	suo.fields = append([]string{field}, fields...)
+	return suo
+}
+
+// Save executes the query and returns the updated Scope entity.
+func (suo *ScopeUpdateOne) Save(ctx context.Context) (*Scope, error) {
+	return withHooks(ctx, suo.sqlSave, suo.mutation, suo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (suo *ScopeUpdateOne) SaveX(ctx context.Context) *Scope {
+	node, err := suo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (suo *ScopeUpdateOne) Exec(ctx context.Context) error {
+	_, err := suo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (suo *ScopeUpdateOne) ExecX(ctx context.Context) {
+	if err := suo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (suo *ScopeUpdateOne) check() error {
+	if v, ok := suo.mutation.Name(); ok {
+		if err := scope.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Scope.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (suo *ScopeUpdateOne) sqlSave(ctx context.Context) (_node *Scope, err error) {
+	if err := suo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(scope.Table, scope.Columns, sqlgraph.NewFieldSpec(scope.FieldID, field.TypeUUID))
+	id, ok := suo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Scope.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := suo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, scope.FieldID)
+		for _, f := range fields {
+			if !scope.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != scope.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := suo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := suo.mutation.Name(); ok {
+		_spec.SetField(scope.FieldName, field.TypeString, value)
+	}
+	if value, ok := suo.mutation.Description(); ok {
+		_spec.SetField(scope.FieldDescription, field.TypeJSON, value)
+	}
+	if suo.mutation.DescriptionCleared() {
+		_spec.ClearField(scope.FieldDescription, field.TypeJSON)
+	}
+	if value, ok := suo.mutation.IsDefault(); ok {
+		_spec.SetField(scope.FieldIsDefault, field.TypeBool, value)
+	}
+	if value, ok := suo.mutation.RequiresConsent(); ok {
+		_spec.SetField(scope.FieldRequiresConsent, field.TypeBool, value)
+	}
+	if suo.mutation.PermissionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   scope.PermissionsTable,
+			Columns: []string{scope.PermissionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scopepermission.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := suo.mutation.RemovedPermissionsIDs(); len(nodes) > 0 && !suo.mutation.PermissionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   scope.PermissionsTable,
+			Columns: []string{scope.PermissionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scopepermission.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := suo.mutation.PermissionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   scope.PermissionsTable,
+			Columns: []string{scope.PermissionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scopepermission.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Scope{config: suo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, suo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{scope.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	suo.mutation.done = true
+	return _node, nil
+}
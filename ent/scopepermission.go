@@ -0,0 +1,165 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/byebyebymyai/oauth2-api/ent/scope"
+	"github.com/byebyebymyai/oauth2-api/ent/scopepermission"
+	"github.com/google/uuid"
+)
+
+// ScopePermission is the model entity for the ScopePermission schema.
+type ScopePermission struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Method holds the value of the "method" field.
+	Method string `json:"method,omitempty"`
+	// Host holds the value of the "host" field.
+	Host string `json:"host,omitempty"`
+	// Path holds the value of the "path" field.
+	Path string `json:"path,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ScopePermissionQuery when eager-loading is set.
+	Edges             ScopePermissionEdges `json:"edges"`
+	scope_permissions *uuid.UUID
+	selectValues      sql.SelectValues
+}
+
+// ScopePermissionEdges holds the relations/edges for other nodes in the graph.
+type ScopePermissionEdges struct {
+	// Scope holds the value of the scope edge.
+	Scope *Scope `json:"scope,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// ScopeOrErr returns the Scope value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ScopePermissionEdges) ScopeOrErr() (*Scope, error) {
+	if e.Scope != nil {
+		return e.Scope, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: scope.Label}
+	}
+	return nil, &NotLoadedError{edge: "scope"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ScopePermission) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case scopepermission.FieldMethod, scopepermission.FieldHost, scopepermission.FieldPath:
+			values[i] = new(sql.NullString)
+		case scopepermission.FieldID:
+			values[i] = new(uuid.UUID)
+		case scopepermission.ForeignKeys[0]: // scope_permissions
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ScopePermission fields.
+func (sp *ScopePermission) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case scopepermission.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				sp.ID = *value
+			}
+		case scopepermission.FieldMethod:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field method", values[i])
+			} else if value.Valid {
+				sp.Method = value.String
+			}
+		case scopepermission.FieldHost:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field host", values[i])
+			} else if value.Valid {
+				sp.Host = value.String
+			}
+		case scopepermission.FieldPath:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field path", values[i])
+			} else if value.Valid {
+				sp.Path = value.String
+			}
+		case scopepermission.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field scope_permissions", values[i])
+			} else if value.Valid {
+				sp.scope_permissions = new(uuid.UUID)
+				*sp.scope_permissions = *value.S.(*uuid.UUID)
+			}
+		default:
+			sp.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ScopePermission.
+// This includes values selected through modifiers, order, etc.
+func (sp *ScopePermission) Value(name string) (ent.Value, error) {
+	return sp.selectValues.Get(name)
+}
+
+// QueryScope queries the "scope" edge of the ScopePermission entity.
+func (sp *ScopePermission) QueryScope() *ScopeQuery {
+	return NewScopePermissionClient(sp.config).QueryScope(sp)
+}
+
+// Update returns a builder for updating this ScopePermission.
+// Note that you need to call ScopePermission.Unwrap() before calling this method if this ScopePermission
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (sp *ScopePermission) Update() *ScopePermissionUpdateOne {
+	return NewScopePermissionClient(sp.config).UpdateOne(sp)
+}
+
+// Unwrap unwraps the ScopePermission entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (sp *ScopePermission) Unwrap() *ScopePermission {
+	_tx, ok := sp.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ScopePermission is not a transactional entity")
+	}
+	sp.config.driver = _tx.drv
+	return sp
+}
+
+// String implements the fmt.Stringer.
+func (sp *ScopePermission) String() string {
+	var builder strings.Builder
+	builder.WriteString("ScopePermission(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", sp.ID))
+	builder.WriteString("method=")
+	builder.WriteString(sp.Method)
+	builder.WriteString(", ")
+	builder.WriteString("host=")
+	builder.WriteString(sp.Host)
+	builder.WriteString(", ")
+	builder.WriteString("path=")
+	builder.WriteString(sp.Path)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ScopePermissions is a parsable slice of ScopePermission.
+type ScopePermissions []*ScopePermission
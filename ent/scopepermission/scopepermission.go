@@ -0,0 +1,110 @@
+// Code generated by ent, DO NOT EDIT.
+
+package scopepermission
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the scopepermission type in the database.
+	Label = "scope_permission"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldMethod holds the string denoting the method field in the database.
+	FieldMethod = "method"
+	// FieldHost holds the string denoting the host field in the database.
+	FieldHost = "host"
+	// FieldPath holds the string denoting the path field in the database.
+	FieldPath = "path"
+	// EdgeScope holds the string denoting the scope edge name in mutations.
+	EdgeScope = "scope"
+	// Table holds the table name of the scopepermission in the database.
+	Table = "scope_permissions"
+	// ScopeTable is the table that holds the scope relation/edge.
+	ScopeTable = "scope_permissions"
+	// ScopeInverseTable is the table name for the Scope entity.
+	// It exists in this package in order to avoid circular dependency with the "scope" package.
+	ScopeInverseTable = "scopes"
+	// ScopeColumn is the table column denoting the scope relation/edge.
+	ScopeColumn = "scope_permissions"
+)
+
+// Columns holds all SQL columns for scopepermission fields.
+var Columns = []string{
+	FieldID,
+	FieldMethod,
+	FieldHost,
+	FieldPath,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "scope_permissions"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"scope_permissions",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// MethodValidator is a validator for the "method" field. It is called by the builders before save.
+	MethodValidator func(string) error
+	// HostValidator is a validator for the "host" field. It is called by the builders before save.
+	HostValidator func(string) error
+	// PathValidator is a validator for the "path" field. It is called by the builders before save.
+	PathValidator func(string) error
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the ScopePermission queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByMethod orders the results by the method field.
+func ByMethod(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMethod, opts...).ToFunc()
+}
+
+// ByHost orders the results by the host field.
+func ByHost(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHost, opts...).ToFunc()
+}
+
+// ByPath orders the results by the path field.
+func ByPath(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPath, opts...).ToFunc()
+}
+
+// ByScopeField orders the results by scope field.
+func ByScopeField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newScopeStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newScopeStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ScopeInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, ScopeTable, ScopeColumn),
+	)
+}
@@ -0,0 +1,286 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/byebyebymyai/oauth2-api/ent/scope"
+	"github.com/byebyebymyai/oauth2-api/ent/scopepermission"
+	"github.com/google/uuid"
+)
+
+// ScopePermissionCreate is the builder for creating a ScopePermission entity.
+type ScopePermissionCreate struct {
+	config
+	mutation *ScopePermissionMutation
+	hooks    []Hook
+}
+
+// SetMethod sets the "method" field.
+func (spc *ScopePermissionCreate) SetMethod(s string) *ScopePermissionCreate {
+	spc.mutation.SetMethod(s)
+	return spc
+}
+
+// SetHost sets the "host" field.
+func (spc *ScopePermissionCreate) SetHost(s string) *ScopePermissionCreate {
+	spc.mutation.SetHost(s)
+	return spc
+}
+
+// SetPath sets the "path" field.
+func (spc *ScopePermissionCreate) SetPath(s string) *ScopePermissionCreate {
+	spc.mutation.SetPath(s)
+	return spc
+}
+
+// SetID sets the "id" field.
+func (spc *ScopePermissionCreate) SetID(u uuid.UUID) *ScopePermissionCreate {
+	spc.mutation.SetID(u)
+	return spc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (spc *ScopePermissionCreate) SetNillableID(u *uuid.UUID) *ScopePermissionCreate {
+	if u != nil {
+		spc.SetID(*u)
+	}
+	return spc
+}
+
+// SetScopeID sets the "scope" edge to the Scope entity by ID.
+func (spc *ScopePermissionCreate) SetScopeID(id uuid.UUID) *ScopePermissionCreate {
+	spc.mutation.SetScopeID(id)
+	return spc
+}
+
+// SetScope sets the "scope" edge to the Scope entity.
+func (spc *ScopePermissionCreate) SetScope(s *Scope) *ScopePermissionCreate {
+	return spc.SetScopeID(s.ID)
+}
+
+// Mutation returns the ScopePermissionMutation object of the builder.
+func (spc *ScopePermissionCreate) Mutation() *ScopePermissionMutation {
+	return spc.mutation
+}
+
+// Save creates the ScopePermission in the database.
+func (spc *ScopePermissionCreate) Save(ctx context.Context) (*ScopePermission, error) {
+	spc.defaults()
+	return withHooks(ctx, spc.sqlSave, spc.mutation, spc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (spc *ScopePermissionCreate) SaveX(ctx context.Context) *ScopePermission {
+	v, err := spc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (spc *ScopePermissionCreate) Exec(ctx context.Context) error {
+	_, err := spc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (spc *ScopePermissionCreate) ExecX(ctx context.Context) {
+	if err := spc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (spc *ScopePermissionCreate) defaults() {
+	if _, ok := spc.mutation.ID(); !ok {
+		v := scopepermission.DefaultID()
+		spc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (spc *ScopePermissionCreate) check() error {
+	if _, ok := spc.mutation.Method(); !ok {
+		return &ValidationError{Name: "method", err: errors.New(`ent: missing required field "ScopePermission.method"`)}
+	}
+	if v, ok := spc.mutation.Method(); ok {
+		if err := scopepermission.MethodValidator(v); err != nil {
+			return &ValidationError{Name: "method", err: fmt.Errorf(`ent: validator failed for field "ScopePermission.method": %w`, err)}
+		}
+	}
+	if _, ok := spc.mutation.Host(); !ok {
+		return &ValidationError{Name: "host", err: errors.New(`ent: missing required field "ScopePermission.host"`)}
+	}
+	if v, ok := spc.mutation.Host(); ok {
+		if err := scopepermission.HostValidator(v); err != nil {
+			return &ValidationError{Name: "host", err: fmt.Errorf(`ent: validator failed for field "ScopePermission.host": %w`, err)}
+		}
+	}
+	if _, ok := spc.mutation.Path(); !ok {
+		return &ValidationError{Name: "path", err: errors.New(`ent: missing required field "ScopePermission.path"`)}
+	}
+	if v, ok := spc.mutation.Path(); ok {
+		if err := scopepermission.PathValidator(v); err != nil {
+			return &ValidationError{Name: "path", err: fmt.Errorf(`ent: validator failed for field "ScopePermission.path": %w`, err)}
+		}
+	}
+	if len(spc.mutation.ScopeIDs()) == 0 {
+		return &ValidationError{Name: "scope", err: errors.New(`ent: missing required edge "ScopePermission.scope"`)}
+	}
+	return nil
+}
+
+func (spc *ScopePermissionCreate) sqlSave(ctx context.Context) (*ScopePermission, error) {
+	if err := spc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := spc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, spc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	spc.mutation.id = &_node.ID
+	spc.mutation.done = true
+	return _node, nil
+}
+
+func (spc *ScopePermissionCreate) createSpec() (*ScopePermission, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ScopePermission{config: spc.config}
+		_spec = sqlgraph.NewCreateSpec(scopepermission.Table, sqlgraph.NewFieldSpec(scopepermission.FieldID, field.TypeUUID))
+	)
+	if id, ok := spc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := spc.mutation.Method(); ok {
+		_spec.SetField(scopepermission.FieldMethod, field.TypeString, value)
+		_node.Method = value
+	}
+	if value, ok := spc.mutation.Host(); ok {
+		_spec.SetField(scopepermission.FieldHost, field.TypeString, value)
+		_node.Host = value
+	}
+	if value, ok := spc.mutation.Path(); ok {
+		_spec.SetField(scopepermission.FieldPath, field.TypeString, value)
+		_node.Path = value
+	}
+	if nodes := spc.mutation.ScopeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   scopepermission.ScopeTable,
+			Columns: []string{scopepermission.ScopeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scope.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.scope_permissions = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ScopePermissionCreateBulk is the builder for creating many ScopePermission entities in bulk.
+type ScopePermissionCreateBulk struct {
+	config
+	err      error
+	builders []*ScopePermissionCreate
+}
+
+// Save creates the ScopePermission entities in the database.
+func (spcb *ScopePermissionCreateBulk) Save(ctx context.Context) ([]*ScopePermission, error) {
+	if spcb.err != nil {
+		return nil, spcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(spcb.builders))
+	nodes := make([]*ScopePermission, len(spcb.builders))
+	mutators := make([]Mutator, len(spcb.builders))
+	for i := range spcb.builders {
+		func(i int, root context.Context) {
+			builder := spcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ScopePermissionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, spcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, spcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, spcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (spcb *ScopePermissionCreateBulk) SaveX(ctx context.Context) []*ScopePermission {
+	v, err := spcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (spcb *ScopePermissionCreateBulk) Exec(ctx context.Context) error {
+	_, err := spcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (spcb *ScopePermissionCreateBulk) ExecX(ctx context.Context) {
+	if err := spcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/byebyebymyai/oauth2-api/ent/predicate"
+	"github.com/byebyebymyai/oauth2-api/ent/scopepermission"
+)
+
+// ScopePermissionDelete is the builder for deleting a ScopePermission entity.
+type ScopePermissionDelete struct {
+	config
+	hooks    []Hook
+	mutation *ScopePermissionMutation
+}
+
+// Where appends a list predicates to the ScopePermissionDelete builder.
+func (spd *ScopePermissionDelete) Where(ps ...predicate.ScopePermission) *ScopePermissionDelete {
+	spd.mutation.Where(ps...)
+	return spd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (spd *ScopePermissionDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, spd.sqlExec, spd.mutation, spd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (spd *ScopePermissionDelete) ExecX(ctx context.Context) int {
+	n, err := spd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (spd *ScopePermissionDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(scopepermission.Table, sqlgraph.NewFieldSpec(scopepermission.FieldID, field.TypeUUID))
+	if ps := spd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, spd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	spd.mutation.done = true
+	return affected, err
+}
+
+// ScopePermissionDeleteOne is the builder for deleting a single ScopePermission entity.
+type ScopePermissionDeleteOne struct {
+	spd *ScopePermissionDelete
+}
+
+// Where appends a list predicates to the ScopePermissionDelete builder.
+func (spdo *ScopePermissionDeleteOne) Where(ps ...predicate.ScopePermission) *ScopePermissionDeleteOne {
+	spdo.spd.mutation.Where(ps...)
+	return spdo
+}
+
+// Exec executes the deletion query.
+func (spdo *ScopePermissionDeleteOne) Exec(ctx context.Context) error {
+	n, err := spdo.spd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{scopepermission.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (spdo *ScopePermissionDeleteOne) ExecX(ctx context.Context) {
+	if err := spdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
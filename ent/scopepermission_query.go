@@ -0,0 +1,615 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/byebyebymyai/oauth2-api/ent/predicate"
+	"github.com/byebyebymyai/oauth2-api/ent/scope"
+	"github.com/byebyebymyai/oauth2-api/ent/scopepermission"
+	"github.com/google/uuid"
+)
+
+// ScopePermissionQuery is the builder for querying ScopePermission entities.
+type ScopePermissionQuery struct {
+	config
+	ctx        *QueryContext
+	order      []scopepermission.OrderOption
+	inters     []Interceptor
+	predicates []predicate.ScopePermission
+	withScope  *ScopeQuery
+	withFKs    bool
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ScopePermissionQuery builder.
+func (spq *ScopePermissionQuery) Where(ps ...predicate.ScopePermission) *ScopePermissionQuery {
+	spq.predicates = append(spq.predicates, ps...)
+	return spq
+}
+
+// Limit the number of records to be returned by this query.
+func (spq *ScopePermissionQuery) Limit(limit int) *ScopePermissionQuery {
+	spq.ctx.Limit = &limit
+	return spq
+}
+
+// Offset to start from.
+func (spq *ScopePermissionQuery) Offset(offset int) *ScopePermissionQuery {
+	spq.ctx.Offset = &offset
+	return spq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (spq *ScopePermissionQuery) Unique(unique bool) *ScopePermissionQuery {
+	spq.ctx.Unique = &unique
+	return spq
+}
+
+// Order specifies how the records should be ordered.
+func (spq *ScopePermissionQuery) Order(o ...scopepermission.OrderOption) *ScopePermissionQuery {
+	spq.order = append(spq.order, o...)
+	return spq
+}
+
+// QueryScope chains the current query on the "scope" edge.
+func (spq *ScopePermissionQuery) QueryScope() *ScopeQuery {
+	query := (&ScopeClient{config: spq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := spq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := spq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(scopepermission.Table, scopepermission.FieldID, selector),
+			sqlgraph.To(scope.Table, scope.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, scopepermission.ScopeTable, scopepermission.ScopeColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(spq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first ScopePermission entity from the query.
+// Returns a *NotFoundError when no ScopePermission was found.
+func (spq *ScopePermissionQuery) First(ctx context.Context) (*ScopePermission, error) {
+	nodes, err := spq.Limit(1).All(setContextOp(ctx, spq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{scopepermission.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (spq *ScopePermissionQuery) FirstX(ctx context.Context) *ScopePermission {
+	node, err := spq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first ScopePermission ID from the query.
+// Returns a *NotFoundError when no ScopePermission ID was found.
+func (spq *ScopePermissionQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = spq.Limit(1).IDs(setContextOp(ctx, spq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{scopepermission.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (spq *ScopePermissionQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := spq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single ScopePermission entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one ScopePermission entity is found.
+// Returns a *NotFoundError when no ScopePermission entities are found.
+func (spq *ScopePermissionQuery) Only(ctx context.Context) (*ScopePermission, error) {
+	nodes, err := spq.Limit(2).All(setContextOp(ctx, spq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{scopepermission.Label}
+	default:
+		return nil, &NotSingularError{scopepermission.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (spq *ScopePermissionQuery) OnlyX(ctx context.Context) *ScopePermission {
+	node, err := spq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only ScopePermission ID in the query.
+// Returns a *NotSingularError when more than one ScopePermission ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (spq *ScopePermissionQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = spq.Limit(2).IDs(setContextOp(ctx, spq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{scopepermission.Label}
+	default:
+		err = &NotSingularError{scopepermission.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (spq *ScopePermissionQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := spq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of ScopePermissions.
+func (spq *ScopePermissionQuery) All(ctx context.Context) ([]*ScopePermission, error) {
+	ctx = setContextOp(ctx, spq.ctx, ent.OpQueryAll)
+	if err := spq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*ScopePermission, *ScopePermissionQuery]()
+	return withInterceptors[[]*ScopePermission](ctx, spq, qr, spq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (spq *ScopePermissionQuery) AllX(ctx context.Context) []*ScopePermission {
+	nodes, err := spq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of ScopePermission IDs.
+func (spq *ScopePermissionQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if spq.ctx.Unique == nil && spq.path != nil {
+		spq.Unique(true)
+	}
+	ctx = setContextOp(ctx, spq.ctx, ent.OpQueryIDs)
+	if err = spq.Select(scopepermission.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (spq *ScopePermissionQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := spq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (spq *ScopePermissionQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, spq.ctx, ent.OpQueryCount)
+	if err := spq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, spq, querierCount[*ScopePermissionQuery](), spq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (spq *ScopePermissionQuery) CountX(ctx context.Context) int {
+	count, err := spq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (spq *ScopePermissionQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, spq.ctx, ent.OpQueryExist)
+	switch _, err := spq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (spq *ScopePermissionQuery) ExistX(ctx context.Context) bool {
+	exist, err := spq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ScopePermissionQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (spq *ScopePermissionQuery) Clone() *ScopePermissionQuery {
+	if spq == nil {
+		return nil
+	}
+	return &ScopePermissionQuery{
+		config:     spq.config,
+		ctx:        spq.ctx.Clone(),
+		order:      append([]scopepermission.OrderOption{}, spq.order...),
+		inters:     append([]Interceptor{}, spq.inters...),
+		predicates: append([]predicate.ScopePermission{}, spq.predicates...),
+		withScope:  spq.withScope.Clone(),
+		// clone intermediate query.
+		sql:  spq.sql.Clone(),
+		path: spq.path,
+	}
+}
+
+// WithScope tells the query-builder to eager-load the nodes that are connected to
+// the "scope" edge. The optional arguments are used to configure the query builder of the edge.
+func (spq *ScopePermissionQuery) WithScope(opts ...func(*ScopeQuery)) *ScopePermissionQuery {
+	query := (&ScopeClient{config: spq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	spq.withScope = query
+	return spq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Method string `json:"method,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.ScopePermission.Query().
+//		GroupBy(scopepermission.FieldMethod).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (spq *ScopePermissionQuery) GroupBy(field string, fields ...string) *ScopePermissionGroupBy {
+	spq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &ScopePermissionGroupBy{build: spq}
+	grbuild.flds = &spq.ctx.Fields
+	grbuild.label = scopepermission.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Method string `json:"method,omitempty"`
+//	}
+//
+//	client.ScopePermission.Query().
+//		Select(scopepermission.FieldMethod).
+//		Scan(ctx, &v)
+func (spq *ScopePermissionQuery) Select(fields ...string) *ScopePermissionSelect {
+	spq.ctx.Fields = append(spq.ctx.Fields, fields...)
+	sbuild := &ScopePermissionSelect{ScopePermissionQuery: spq}
+	sbuild.label = scopepermission.Label
+	sbuild.flds, sbuild.scan = &spq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a ScopePermissionSelect configured with the given aggregations.
+func (spq *ScopePermissionQuery) Aggregate(fns ...AggregateFunc) *ScopePermissionSelect {
+	return spq.Select().Aggregate(fns...)
+}
+
+func (spq *ScopePermissionQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range spq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, spq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range spq.ctx.Fields {
+		if !scopepermission.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if spq.path != nil {
+		prev, err := spq.path(ctx)
+		if err != nil {
+			return err
+		}
+		spq.sql = prev
+	}
+	return nil
+}
+
+func (spq *ScopePermissionQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*ScopePermission, error) {
+	var (
+		nodes       = []*ScopePermission{}
+		withFKs     = spq.withFKs
+		_spec       = spq.querySpec()
+		loadedTypes = [1]bool{
+			spq.withScope != nil,
+		}
+	)
+	if spq.withScope != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, scopepermission.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*ScopePermission).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &ScopePermission{config: spq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, spq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := spq.withScope; query != nil {
+		if err := spq.loadScope(ctx, query, nodes, nil,
+			func(n *ScopePermission, e *Scope) { n.Edges.Scope = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (spq *ScopePermissionQuery) loadScope(ctx context.Context, query *ScopeQuery, nodes []*ScopePermission, init func(*ScopePermission), assign func(*ScopePermission, *Scope)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*ScopePermission)
+	for i := range nodes {
+		if nodes[i].scope_permissions == nil {
+			continue
+		}
+		fk := *nodes[i].scope_permissions
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(scope.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "scope_permissions" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (spq *ScopePermissionQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := spq.querySpec()
+	_spec.Node.Columns = spq.ctx.Fields
+	if len(spq.ctx.Fields) > 0 {
+		_spec.Unique = spq.ctx.Unique != nil && *spq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, spq.driver, _spec)
+}
+
+func (spq *ScopePermissionQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(scopepermission.Table, scopepermission.Columns, sqlgraph.NewFieldSpec(scopepermission.FieldID, field.TypeUUID))
+	_spec.From = spq.sql
+	if unique := spq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if spq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := spq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, scopepermission.FieldID)
+		for i := range fields {
+			if fields[i] != scopepermission.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := spq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := spq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := spq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := spq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (spq *ScopePermissionQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(spq.driver.Dialect())
+	t1 := builder.Table(scopepermission.Table)
+	columns := spq.ctx.Fields
+	if len(columns) == 0 {
+		columns = scopepermission.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if spq.sql != nil {
+		selector = spq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if spq.ctx.Unique != nil && *spq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range spq.predicates {
+		p(selector)
+	}
+	for _, p := range spq.order {
+		p(selector)
+	}
+	if offset := spq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := spq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ScopePermissionGroupBy is the group-by builder for ScopePermission entities.
+type ScopePermissionGroupBy struct {
+	selector
+	build *ScopePermissionQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (spgb *ScopePermissionGroupBy) Aggregate(fns ...AggregateFunc) *ScopePermissionGroupBy {
+	spgb.fns = append(spgb.fns, fns...)
+	return spgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (spgb *ScopePermissionGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, spgb.build.ctx, ent.OpQueryGroupBy)
+	if err := spgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ScopePermissionQuery, *ScopePermissionGroupBy](ctx, spgb.build, spgb, spgb.build.inters, v)
+}
+
+func (spgb *ScopePermissionGroupBy) sqlScan(ctx context.Context, root *ScopePermissionQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(spgb.fns))
+	for _, fn := range spgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*spgb.flds)+len(spgb.fns))
+		for _, f := range *spgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*spgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := spgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// ScopePermissionSelect is the builder for selecting fields of ScopePermission entities.
+type ScopePermissionSelect struct {
+	*ScopePermissionQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (sps *ScopePermissionSelect) Aggregate(fns ...AggregateFunc) *ScopePermissionSelect {
+	sps.fns = append(sps.fns, fns...)
+	return sps
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (sps *ScopePermissionSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, sps.ctx, ent.OpQuerySelect)
+	if err := sps.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ScopePermissionQuery, *ScopePermissionSelect](ctx, sps.ScopePermissionQuery, sps, sps.inters, v)
+}
+
+func (sps *ScopePermissionSelect) sqlScan(ctx context.Context, root *ScopePermissionQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(sps.fns))
+	for _, fn := range sps.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*sps.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := sps.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,423 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/byebyebymyai/oauth2-api/ent/predicate"
+	"github.com/byebyebymyai/oauth2-api/ent/scope"
+	"github.com/byebyebymyai/oauth2-api/ent/scopepermission"
+	"github.com/google/uuid"
+)
+
+// ScopePermissionUpdate is the builder for updating ScopePermission entities.
+type ScopePermissionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ScopePermissionMutation
+}
+
+// Where appends a list predicates to the ScopePermissionUpdate builder.
+func (spu *ScopePermissionUpdate) Where(ps ...predicate.ScopePermission) *ScopePermissionUpdate {
+	spu.mutation.Where(ps...)
+	return spu
+}
+
+// SetMethod sets the "method" field.
+func (spu *ScopePermissionUpdate) SetMethod(s string) *ScopePermissionUpdate {
+	spu.mutation.SetMethod(s)
+	return spu
+}
+
+// SetNillableMethod sets the "method" field if the given value is not nil.
+func (spu *ScopePermissionUpdate) SetNillableMethod(s *string) *ScopePermissionUpdate {
+	if s != nil {
+		spu.SetMethod(*s)
+	}
+	return spu
+}
+
+// SetHost sets the "host" field.
+func (spu *ScopePermissionUpdate) SetHost(s string) *ScopePermissionUpdate {
+	spu.mutation.SetHost(s)
+	return spu
+}
+
+// SetNillableHost sets the "host" field if the given value is not nil.
+func (spu *ScopePermissionUpdate) SetNillableHost(s *string) *ScopePermissionUpdate {
+	if s != nil {
+		spu.SetHost(*s)
+	}
+	return spu
+}
+
+// SetPath sets the "path" field.
+func (spu *ScopePermissionUpdate) SetPath(s string) *ScopePermissionUpdate {
+	spu.mutation.SetPath(s)
+	return spu
+}
+
+// SetNillablePath sets the "path" field if the given value is not nil.
+func (spu *ScopePermissionUpdate) SetNillablePath(s *string) *ScopePermissionUpdate {
+	if s != nil {
+		spu.SetPath(*s)
+	}
+	return spu
+}
+
+// SetScopeID sets the "scope" edge to the Scope entity by ID.
+func (spu *ScopePermissionUpdate) SetScopeID(id uuid.UUID) *ScopePermissionUpdate {
+	spu.mutation.SetScopeID(id)
+	return spu
+}
+
+// SetScope sets the "scope" edge to the Scope entity.
+func (spu *ScopePermissionUpdate) SetScope(s *Scope) *ScopePermissionUpdate {
+	return spu.SetScopeID(s.ID)
+}
+
+// Mutation returns the ScopePermissionMutation object of the builder.
+func (spu *ScopePermissionUpdate) Mutation() *ScopePermissionMutation {
+	return spu.mutation
+}
+
+// ClearScope clears the "scope" edge to the Scope entity.
+func (spu *ScopePermissionUpdate) ClearScope() *ScopePermissionUpdate {
+	spu.mutation.ClearScope()
+	return spu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (spu *ScopePermissionUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, spu.sqlSave, spu.mutation, spu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (spu *ScopePermissionUpdate) SaveX(ctx context.Context) int {
+	affected, err := spu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (spu *ScopePermissionUpdate) Exec(ctx context.Context) error {
+	_, err := spu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (spu *ScopePermissionUpdate) ExecX(ctx context.Context) {
+	if err := spu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (spu *ScopePermissionUpdate) check() error {
+	if v, ok := spu.mutation.Method(); ok {
+		if err := scopepermission.MethodValidator(v); err != nil {
+			return &ValidationError{Name: "method", err: fmt.Errorf(`ent: validator failed for field "ScopePermission.method": %w`, err)}
+		}
+	}
+	if v, ok := spu.mutation.Host(); ok {
+		if err := scopepermission.HostValidator(v); err != nil {
+			return &ValidationError{Name: "host", err: fmt.Errorf(`ent: validator failed for field "ScopePermission.host": %w`, err)}
+		}
+	}
+	if v, ok := spu.mutation.Path(); ok {
+		if err := scopepermission.PathValidator(v); err != nil {
+			return &ValidationError{Name: "path", err: fmt.Errorf(`ent: validator failed for field "ScopePermission.path": %w`, err)}
+		}
+	}
+	if spu.mutation.ScopeCleared() && len(spu.mutation.ScopeIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ScopePermission.scope"`)
+	}
+	return nil
+}
+
+func (spu *ScopePermissionUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := spu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(scopepermission.Table, scopepermission.Columns, sqlgraph.NewFieldSpec(scopepermission.FieldID, field.TypeUUID))
+	if ps := spu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := spu.mutation.Method(); ok {
+		_spec.SetField(scopepermission.FieldMethod, field.TypeString, value)
+	}
+	if value, ok := spu.mutation.Host(); ok {
+		_spec.SetField(scopepermission.FieldHost, field.TypeString, value)
+	}
+	if value, ok := spu.mutation.Path(); ok {
+		_spec.SetField(scopepermission.FieldPath, field.TypeString, value)
+	}
+	if spu.mutation.ScopeCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   scopepermission.ScopeTable,
+			Columns: []string{scopepermission.ScopeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scope.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := spu.mutation.ScopeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   scopepermission.ScopeTable,
+			Columns: []string{scopepermission.ScopeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scope.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, spu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{scopepermission.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	spu.mutation.done = true
+	return n, nil
+}
+
+// ScopePermissionUpdateOne is the builder for updating a single ScopePermission entity.
+type ScopePermissionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ScopePermissionMutation
+}
+
+// SetMethod sets the "method" field.
+func (spuo *ScopePermissionUpdateOne) SetMethod(s string) *ScopePermissionUpdateOne {
+	spuo.mutation.SetMethod(s)
+	return spuo
+}
+
+// SetNillableMethod sets the "method" field if the given value is not nil.
+func (spuo *ScopePermissionUpdateOne) SetNillableMethod(s *string) *ScopePermissionUpdateOne {
+	if s != nil {
+		spuo.SetMethod(*s)
+	}
+	return spuo
+}
+
+// SetHost sets the "host" field.
+func (spuo *ScopePermissionUpdateOne) SetHost(s string) *ScopePermissionUpdateOne {
+	spuo.mutation.SetHost(s)
+	return spuo
+}
+
+// SetNillableHost sets the "host" field if the given value is not nil.
+func (spuo *ScopePermissionUpdateOne) SetNillableHost(s *string) *ScopePermissionUpdateOne {
+	if s != nil {
+		spuo.SetHost(*s)
+	}
+	return spuo
+}
+
+// SetPath sets the "path" field.
+func (spuo *ScopePermissionUpdateOne) SetPath(s string) *ScopePermissionUpdateOne {
+	spuo.mutation.SetPath(s)
+	return spuo
+}
+
+// SetNillablePath sets the "path" field if the given value is not nil.
+func (spuo *ScopePermissionUpdateOne) SetNillablePath(s *string) *ScopePermissionUpdateOne {
+	if s != nil {
+		spuo.SetPath(*s)
+	}
+	return spuo
+}
+
+// SetScopeID sets the "scope" edge to the Scope entity by ID.
+func (spuo *ScopePermissionUpdateOne) SetScopeID(id uuid.UUID) *ScopePermissionUpdateOne {
+	spuo.mutation.SetScopeID(id)
+	return spuo
+}
+
+// SetScope sets the "scope" edge to the Scope entity.
+func (spuo *ScopePermissionUpdateOne) SetScope(s *Scope) *ScopePermissionUpdateOne {
+	return spuo.SetScopeID(s.ID)
+}
+
+// Mutation returns the ScopePermissionMutation object of the builder.
+func (spuo *ScopePermissionUpdateOne) Mutation() *ScopePermissionMutation {
+	return spuo.mutation
+}
+
+// ClearScope clears the "scope" edge to the Scope entity.
+func (spuo *ScopePermissionUpdateOne) ClearScope() *ScopePermissionUpdateOne {
+	spuo.mutation.ClearScope()
+	return spuo
+}
+
+// Where appends a list predicates to the ScopePermissionUpdate builder.
+func (spuo *ScopePermissionUpdateOne) Where(ps ...predicate.ScopePermission) *ScopePermissionUpdateOne {
+	spuo.mutation.Where(ps...)
+	return spuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (spuo *ScopePermissionUpdateOne) Select(field string, fields ...string) *ScopePermissionUpdateOne {
+	spuo.fields = append([]string{field}, fields...)
+	return spuo
+}
+
+// Save executes the query and returns the updated ScopePermission entity.
+func (spuo *ScopePermissionUpdateOne) Save(ctx context.Context) (*ScopePermission, error) {
+	return withHooks(ctx, spuo.sqlSave, spuo.mutation, spuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (spuo *ScopePermissionUpdateOne) SaveX(ctx context.Context) *ScopePermission {
+	node, err := spuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (spuo *ScopePermissionUpdateOne) Exec(ctx context.Context) error {
+	_, err := spuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (spuo *ScopePermissionUpdateOne) ExecX(ctx context.Context) {
+	if err := spuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (spuo *ScopePermissionUpdateOne) check() error {
+	if v, ok := spuo.mutation.Method(); ok {
+		if err := scopepermission.MethodValidator(v); err != nil {
+			return &ValidationError{Name: "method", err: fmt.Errorf(`ent: validator failed for field "ScopePermission.method": %w`, err)}
+		}
+	}
+	if v, ok := spuo.mutation.Host(); ok {
+		if err := scopepermission.HostValidator(v); err != nil {
+			return &ValidationError{Name: "host", err: fmt.Errorf(`ent: validator failed for field "ScopePermission.host": %w`, err)}
+		}
+	}
+	if v, ok := spuo.mutation.Path(); ok {
+		if err := scopepermission.PathValidator(v); err != nil {
+			return &ValidationError{Name: "path", err: fmt.Errorf(`ent: validator failed for field "ScopePermission.path": %w`, err)}
+		}
+	}
+	if spuo.mutation.ScopeCleared() && len(spuo.mutation.ScopeIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ScopePermission.scope"`)
+	}
+	return nil
+}
+
+func (spuo *ScopePermissionUpdateOne) sqlSave(ctx context.Context) (_node *ScopePermission, err error) {
+	if err := spuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(scopepermission.Table, scopepermission.Columns, sqlgraph.NewFieldSpec(scopepermission.FieldID, field.TypeUUID))
+	id, ok := spuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ScopePermission.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := spuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, scopepermission.FieldID)
+		for _, f := range fields {
+			if !scopepermission.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != scopepermission.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := spuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := spuo.mutation.Method(); ok {
+		_spec.SetField(scopepermission.FieldMethod, field.TypeString, value)
+	}
+	if value, ok := spuo.mutation.Host(); ok {
+		_spec.SetField(scopepermission.FieldHost, field.TypeString, value)
+	}
+	if value, ok := spuo.mutation.Path(); ok {
+		_spec.SetField(scopepermission.FieldPath, field.TypeString, value)
+	}
+	if spuo.mutation.ScopeCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   scopepermission.ScopeTable,
+			Columns: []string{scopepermission.ScopeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scope.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := spuo.mutation.ScopeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   scopepermission.ScopeTable,
+			Columns: []string{scopepermission.ScopeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(scope.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &ScopePermission{config: spuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, spuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{scopepermission.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	spuo.mutation.done = true
+	return _node, nil
+}
@@ -12,8 +12,16 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// ClientSecret is the client for interacting with the ClientSecret builders.
+	ClientSecret *ClientSecretClient
+	// Consent is the client for interacting with the Consent builders.
+	Consent *ConsentClient
 	// Oauth2Client is the client for interacting with the Oauth2Client builders.
 	Oauth2Client *Oauth2ClientClient
+	// Scope is the client for interacting with the Scope builders.
+	Scope *ScopeClient
+	// ScopePermission is the client for interacting with the ScopePermission builders.
+	ScopePermission *ScopePermissionClient
 
 	// lazily loaded.
 	client     *Client
@@ -145,7 +153,11 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.ClientSecret = NewClientSecretClient(tx.config)
+	tx.Consent = NewConsentClient(tx.config)
 	tx.Oauth2Client = NewOauth2ClientClient(tx.config)
+	tx.Scope = NewScopeClient(tx.config)
+	tx.ScopePermission = NewScopePermissionClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
@@ -155,7 +167,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: Oauth2Client.QueryXXX(), the query will be executed
+// applies a query, for example: ClientSecret.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
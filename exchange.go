@@ -0,0 +1,153 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/go-oauth2/oauth2/v4"
+	"github.com/go-oauth2/oauth2/v4/errors"
+	"github.com/go-oauth2/oauth2/v4/manage"
+)
+
+// tokenExchangeGrantType is the token exchange grant (RFC 8693).
+const tokenExchangeGrantType = "urn:ietf:params:oauth:grant-type:token-exchange"
+
+const (
+	accessTokenType = "urn:ietf:params:oauth:token-type:access_token"
+	jwtTokenType    = "urn:ietf:params:oauth:token-type:jwt"
+)
+
+var ErrInvalidTarget = errors.New("invalid_target")
+
+func init() {
+	errors.Descriptions[ErrInvalidTarget] = "The requested audience is invalid, unknown, or not allowed for the client"
+	errors.StatusCodes[ErrInvalidTarget] = http.StatusBadRequest
+}
+
+// tokenExchangeGrant exchanges a subject token, and optionally an actor
+// token, for an access token aimed at another audience. The audiences and
+// scopes are limited by the exchange policy of the client, and the scopes
+// also by the subject token. The new token expires with the subject token at
+// the latest, and keeps its DPoP or certificate binding.
+func tokenExchangeGrant(w http.ResponseWriter, r *http.Request) {
+	client, err := authenticateClient(r)
+	if err != nil {
+		tokenError(w, err)
+		return
+	}
+	if client.IsPublic() || len(client.ExchangeAudiences) == 0 {
+		tokenError(w, errors.ErrUnauthorizedClient)
+		return
+	}
+
+	ctx := r.Context()
+	subject, err := loadExchangeToken(r, r.FormValue("subject_token"), r.FormValue("subject_token_type"))
+	if err != nil {
+		tokenError(w, err)
+		return
+	}
+	if !sameTokenBinding(ctx, subject) {
+		tokenError(w, errors.ErrInvalidGrant)
+		return
+	}
+
+	var actor oauth2.TokenInfo
+	if r.FormValue("actor_token") != "" {
+		actor, err = loadExchangeToken(r, r.FormValue("actor_token"), r.FormValue("actor_token_type"))
+		if err != nil {
+			tokenError(w, err)
+			return
+		}
+	}
+
+	if tt := r.FormValue("requested_token_type"); tt != "" && tt != accessTokenType {
+		tokenError(w, errors.ErrInvalidRequest)
+		return
+	}
+
+	audiences := r.Form["audience"]
+	if len(audiences) == 0 {
+		tokenError(w, ErrInvalidTarget)
+		return
+	}
+	for _, aud := range audiences {
+		if !slices.Contains(client.ExchangeAudiences, aud) {
+			tokenError(w, ErrInvalidTarget)
+			return
+		}
+	}
+
+	scope := subject.GetScope()
+	if s := r.FormValue("scope"); s != "" {
+		scope = s
+	}
+	granted := strings.Fields(subject.GetScope())
+	for _, s := range strings.Fields(scope) {
+		if !slices.Contains(granted, s) || !slices.Contains(client.ExchangeScopes, s) {
+			tokenError(w, errors.ErrInvalidScope)
+			return
+		}
+	}
+
+	if actor != nil {
+		act := map[string]interface{}{"client_id": actor.GetClientID()}
+		if actor.GetUserID() != "" {
+			act["sub"] = actor.GetUserID()
+		} else {
+			act["sub"] = actor.GetClientID()
+		}
+		ctx = contextWithTokenClaims(ctx, map[string]interface{}{"act": act})
+	}
+
+	_, clientSecret := clientCredentials(r)
+	tgr := &oauth2.TokenGenerateRequest{
+		ClientID:     client.GetID(),
+		ClientSecret: clientSecret,
+		UserID:       subject.GetUserID(),
+		Scope:        scope,
+		Request:      r,
+	}
+	if exp := subject.GetAccessExpiresIn(); exp > 0 {
+		tgr.AccessTokenExp = min(time.Until(subject.GetAccessCreateAt().Add(exp)), manage.DefaultPasswordTokenCfg.AccessTokenExp)
+	}
+	ti, err := generateExtensionToken(ctx, tokenExchangeGrantType, tgr, false)
+	if err != nil {
+		tokenError(w, err)
+		return
+	}
+
+	logger.Info("[tokenExchange]", "msg", "token exchanged", "clientID", client.ID, "userID", subject.GetUserID(), "audience", audiences)
+	data := tokenData(ctx, ti)
+	data["issued_token_type"] = accessTokenType
+	writeJSON(w, data, nil, http.StatusOK)
+}
+
+// sameTokenBinding reports whether the token request of ctx is bound to the
+// DPoP key and the certificate ti is bound to, so that the exchanged token is
+// bound to them as well.
+func sameTokenBinding(ctx context.Context, ti oauth2.TokenInfo) bool {
+	bound, _ := accessTokenClaims(ti)["cnf"].(map[string]interface{})
+	requested, _ := tokenClaimsFromContext(ctx)["cnf"].(map[string]interface{})
+	for _, k := range []string{"jkt", "x5t#S256"} {
+		if v, ok := bound[k]; ok && requested[k] != v {
+			return false
+		}
+	}
+	return true
+}
+
+// loadExchangeToken validates a subject or actor token. Only access tokens
+// issued by this server can be exchanged.
+func loadExchangeToken(r *http.Request, token, tokenType string) (oauth2.TokenInfo, error) {
+	if token == "" || (tokenType != accessTokenType && tokenType != jwtTokenType) {
+		return nil, errors.ErrInvalidRequest
+	}
+	ti, err := srv.Manager.LoadAccessToken(r.Context(), token)
+	if err != nil {
+		return nil, errors.ErrInvalidGrant
+	}
+	return ti, nil
+}
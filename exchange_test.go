@@ -0,0 +1,179 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/go-oauth2/oauth2/v4"
+	"github.com/go-oauth2/oauth2/v4/models"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+
+	"github.com/byebyebymyai/oauth2-api/ent"
+)
+
+func TestTokenExchangeGrant(t *testing.T) {
+	client := addTestClient(&ent.Oauth2Client{
+		Secret:            "secret",
+		ExchangeAudiences: []string{"https://api.example.com"},
+		ExchangeScopes:    []string{"read"},
+	})
+	subject := storeTestToken(t, &models.Token{
+		ClientID:        client.GetID(),
+		UserID:          uuid.NewString(),
+		Scope:           "read write",
+		Access:          uuid.NewString(),
+		AccessCreateAt:  time.Now(),
+		AccessExpiresIn: time.Hour,
+	})
+	actor := addTestToken(t, client, uuid.NewString(), "")
+
+	exchange := func(form url.Values) (int, map[string]interface{}) {
+		t.Helper()
+		form.Set("grant_type", tokenExchangeGrantType)
+		form.Set("client_id", client.GetID())
+		form.Set("client_secret", "secret")
+		if form.Get("subject_token") == "" {
+			form.Set("subject_token", subject.Access)
+		}
+		form.Set("subject_token_type", accessTokenType)
+		return postTestForm(t, tokenHandler, "/token", form)
+	}
+
+	t.Run("exchange", func(t *testing.T) {
+		status, data := exchange(url.Values{
+			"audience":         {"https://api.example.com"},
+			"scope":            {"read"},
+			"actor_token":      {actor.Access},
+			"actor_token_type": {accessTokenType},
+		})
+		if status != http.StatusOK || data["issued_token_type"] != accessTokenType || data["scope"] != "read" {
+			t.Fatalf("status = %d, body %v", status, data)
+		}
+
+		claims := jwt.MapClaims{}
+		if _, _, err := jwt.NewParser().ParseUnverified(data["access_token"].(string), claims); err != nil {
+			t.Fatal(err)
+		}
+		aud, _ := claims.GetAudience()
+		if claims["sub"] != subject.UserID || len(aud) != 2 || aud[1] != "https://api.example.com" {
+			t.Errorf("claims = %v", claims)
+		}
+		if act, _ := claims["act"].(map[string]interface{}); act["sub"] != actor.UserID {
+			t.Errorf("act = %v", claims["act"])
+		}
+	})
+
+	tests := []struct {
+		name string
+		form url.Values
+		want string
+	}{
+		{name: "audience missing", form: url.Values{}, want: "invalid_target"},
+		{name: "audience not allowed", form: url.Values{"audience": {"https://other.example.com"}}, want: "invalid_target"},
+		{name: "scope not allowed", form: url.Values{"audience": {"https://api.example.com"}, "scope": {"write"}}, want: "invalid_scope"},
+		{name: "unknown subject", form: url.Values{"audience": {"https://api.example.com"}, "subject_token": {"unknown"}}, want: "invalid_grant"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, data := exchange(tt.form); data["error"] != tt.want {
+				t.Errorf("error = %v, want %s", data["error"], tt.want)
+			}
+		})
+	}
+
+	t.Run("subject about to expire", func(t *testing.T) {
+		expiring := storeTestToken(t, &models.Token{
+			ClientID:        client.GetID(),
+			UserID:          uuid.NewString(),
+			Scope:           "read",
+			Access:          uuid.NewString(),
+			AccessCreateAt:  time.Now().Add(-55 * time.Minute),
+			AccessExpiresIn: time.Hour,
+		})
+		status, data := exchange(url.Values{"audience": {"https://api.example.com"}, "subject_token": {expiring.Access}})
+		if exp, _ := data["expires_in"].(float64); status != http.StatusOK || exp <= 0 || exp > 300 {
+			t.Errorf("status = %d, expires_in %v, want the subject's remaining 300s at most", status, data["expires_in"])
+		}
+	})
+
+	t.Run("client without policy", func(t *testing.T) {
+		other := addTestClient(&ent.Oauth2Client{Secret: "secret"})
+		_, data := postTestForm(t, tokenHandler, "/token", url.Values{
+			"grant_type":         {tokenExchangeGrantType},
+			"client_id":          {other.GetID()},
+			"client_secret":      {"secret"},
+			"subject_token":      {subject.Access},
+			"subject_token_type": {accessTokenType},
+			"audience":           {"https://api.example.com"},
+		})
+		if data["error"] != "unauthorized_client" {
+			t.Errorf("error = %v", data["error"])
+		}
+	})
+}
+
+func TestProxyTokenServiceAudience(t *testing.T) {
+	var req tokenGenerationRequest
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		json.NewDecoder(r.Body).Decode(&req)
+		w.Header().Set("Authorization", "Bearer access")
+	}))
+	defer ts.Close()
+
+	client := addTestClient(&ent.Oauth2Client{})
+	r := httptest.NewRequest("POST", "/token", nil)
+	r.Form = url.Values{"audience": {"https://api.example.com"}}
+	svc := makeProxyTokenService(context.Background(), ts.URL)(nil)
+	access, _, err := svc.Token(context.Background(), &oauth2.GenerateBasic{
+		Client:    client,
+		UserID:    uuid.NewString(),
+		Request:   r,
+		TokenInfo: &models.Token{AccessExpiresIn: time.Hour},
+	}, false)
+	if err != nil || access != "access" {
+		t.Fatalf("Token() = %q, %v", access, err)
+	}
+	if want := []string{client.GetID(), "https://api.example.com"}; !slices.Equal(req.Aud, want) {
+		t.Errorf("aud = %q, want %q", req.Aud, want)
+	}
+}
+
+func TestSameTokenBinding(t *testing.T) {
+	bound := func(cnf map[string]interface{}) oauth2.TokenInfo {
+		access, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{"cnf": cnf}).SignedString([]byte("key"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return &models.Token{Access: access}
+	}
+	request := func(cnf map[string]interface{}) context.Context {
+		return contextWithTokenClaims(context.Background(), map[string]interface{}{"cnf": cnf})
+	}
+
+	tests := []struct {
+		name    string
+		subject oauth2.TokenInfo
+		ctx     context.Context
+		want    bool
+	}{
+		{name: "unbound", subject: &models.Token{Access: uuid.NewString()}, ctx: context.Background(), want: true},
+		{name: "unbound exchanged with DPoP", subject: &models.Token{Access: uuid.NewString()}, ctx: request(map[string]interface{}{"jkt": "a"}), want: true},
+		{name: "DPoP without proof", subject: bound(map[string]interface{}{"jkt": "a"}), ctx: context.Background()},
+		{name: "DPoP other key", subject: bound(map[string]interface{}{"jkt": "a"}), ctx: request(map[string]interface{}{"jkt": "b"})},
+		{name: "DPoP same key", subject: bound(map[string]interface{}{"jkt": "a"}), ctx: request(map[string]interface{}{"jkt": "a"}), want: true},
+		{name: "certificate missing", subject: bound(map[string]interface{}{"x5t#S256": "c"}), ctx: request(map[string]interface{}{"jkt": "a"})},
+		{name: "same certificate", subject: bound(map[string]interface{}{"x5t#S256": "c"}), ctx: request(map[string]interface{}{"x5t#S256": "c"}), want: true},
+	}
+	for _, tt := range tests {
+		if got := sameTokenBinding(tt.ctx, tt.subject); got != tt.want {
+			t.Errorf("%s: sameTokenBinding() = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,172 @@
+package main
+
+import (
+	"net"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// forwardedRequest is the request a reverse proxy asks /forward-auth about.
+type forwardedRequest struct {
+	Protocol string
+	Host     string
+	Port     string
+	Method   string
+	Path     string
+}
+
+// newForwardedRequest reads the original request from the X-Forwarded-*
+// headers of the reverse proxy. The path is decoded and cleaned, so that
+// "/api/../admin" is matched as "/admin". It fails when X-Forwarded-Uri is
+// not a request URI.
+func newForwardedRequest(r *http.Request) (forwardedRequest, error) {
+	fr := forwardedRequest{
+		Protocol: r.Header.Get("X-Forwarded-Proto"),
+		Host:     r.Header.Get("X-Forwarded-Host"),
+		Port:     r.Header.Get("X-Forwarded-Port"),
+		Method:   r.Header.Get("X-Forwarded-Method"),
+		Path:     r.Header.Get("X-Forwarded-Uri"),
+	}
+	if fr.Protocol == "" {
+		fr.Protocol = "http"
+	}
+	if host, port, err := net.SplitHostPort(fr.Host); err == nil {
+		fr.Host, fr.Port = host, port
+	}
+	if fr.Port == "" {
+		fr.Port = defaultPort(fr.Protocol)
+	}
+	u, err := url.ParseRequestURI(fr.Path)
+	if err != nil {
+		return fr, err
+	}
+	fr.Path = path.Clean(u.Path)
+	if strings.HasSuffix(u.Path, "/") && fr.Path != "/" {
+		fr.Path += "/"
+	}
+	return fr, nil
+}
+
+// url returns the URL of the request without its query, as a DPoP proof for
+// the request holds it in htu.
+func (fr forwardedRequest) url() string {
+	host := fr.Host
+	if fr.Port != defaultPort(fr.Protocol) {
+		host = net.JoinHostPort(host, fr.Port)
+	}
+	u := url.URL{Scheme: strings.ToLower(fr.Protocol), Host: host, Path: fr.Path}
+	return u.String()
+}
+
+// defaultPort returns the port of the protocol when the URL has none.
+func defaultPort(protocol string) string {
+	if strings.EqualFold(protocol, "https") {
+		return "443"
+	}
+	return "80"
+}
+
+// matchWildcard reports whether value matches pattern, in which "*" matches
+// any sequence of characters. An empty pattern matches anything.
+func matchWildcard(pattern, value string) bool {
+	if pattern == "" || pattern == "*" {
+		return true
+	}
+	parts := strings.Split(pattern, "*")
+	if len(parts) == 1 {
+		return pattern == value
+	}
+	if !strings.HasPrefix(value, parts[0]) {
+		return false
+	}
+	value = value[len(parts[0]):]
+	last := parts[len(parts)-1]
+	for _, part := range parts[1 : len(parts)-1] {
+		i := strings.Index(value, part)
+		if i < 0 {
+			return false
+		}
+		value = value[i+len(part):]
+	}
+	return strings.HasSuffix(value, last)
+}
+
+// allows reports whether the permission covers the request. Protocol, host
+// and method are compared case-insensitively.
+func (p *Permission) allows(fr forwardedRequest) bool {
+	return matchWildcard(strings.ToLower(p.Protocol), strings.ToLower(fr.Protocol)) &&
+		matchWildcard(strings.ToLower(p.Host), strings.ToLower(fr.Host)) &&
+		matchWildcard(p.Port, fr.Port) &&
+		matchWildcard(strings.ToUpper(p.Method), strings.ToUpper(fr.Method)) &&
+		matchWildcard(p.Path, fr.Path)
+}
+
+// userPermissions returns the permissions of the user and of its roles.
+func userPermissions(user User) []*Permission {
+	permissions := user.Permission
+	for _, role := range userRoles(user) {
+		permissions = append(permissions, role.Edges.Permissions...)
+	}
+	return permissions
+}
+
+// forwardAuthHandler authorizes requests for reverse proxies: nginx
+// auth_request, Traefik ForwardAuth and Caddy forward_auth. The bearer token
+// of the original request must belong to a user whose RBAC permissions cover
+// the request in the X-Forwarded-* headers, and a DPoP proof must be made for
+// that request. The response to an allowed request carries the identity of
+// the user in X-User-* headers.
+func forwardAuthHandler(w http.ResponseWriter, r *http.Request) {
+	fr, err := newForwardedRequest(r)
+	if err != nil {
+		logger.Info("[forwardAuthHandle]", "msg", "invalid forwarded uri", "uri", r.Header.Get("X-Forwarded-Uri"))
+		http.Error(w, http.StatusText(http.StatusForbidden), http.StatusForbidden)
+		return
+	}
+
+	ti, err := validateAccessTokenFor(w, r, fr.Method, fr.url())
+	var userID uuid.UUID
+	if err == nil {
+		userID, err = uuid.Parse(ti.GetUserID())
+	}
+	if err != nil {
+		w.Header().Set("WWW-Authenticate", `Bearer error="invalid_token"`)
+		tokenError(w, ErrInvalidToken)
+		return
+	}
+
+	user, err := makeProxyUserService(r.Context(), rbac)(&defaultUserService{}).Get(userID)
+	if err != nil {
+		errorLogger.Error("[forwardAuthHandle]", "error", err.Error())
+		tokenError(w, err)
+		return
+	}
+
+	allowed := false
+	for _, p := range userPermissions(user) {
+		if p.allows(fr) {
+			allowed = true
+			break
+		}
+	}
+	if !allowed {
+		logger.Info("[forwardAuthHandle]", "msg", "request denied", "userID", userID, "method", fr.Method, "host", fr.Host, "path", fr.Path)
+		http.Error(w, http.StatusText(http.StatusForbidden), http.StatusForbidden)
+		return
+	}
+
+	roles := userRoles(user)
+	names := make([]string, 0, len(roles))
+	for _, role := range roles {
+		names = append(names, role.Name)
+	}
+	w.Header().Set("X-User-Id", userID.String())
+	w.Header().Set("X-User-Name", user.Username)
+	w.Header().Set("X-User-Roles", strings.Join(names, ","))
+	w.Header().Set("X-Client-Id", ti.GetClientID())
+	w.WriteHeader(http.StatusOK)
+}
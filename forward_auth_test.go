@@ -0,0 +1,267 @@
+package main
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+
+	"github.com/byebyebymyai/oauth2-api/ent"
+)
+
+func TestMatchWildcard(t *testing.T) {
+	tests := []struct {
+		pattern string
+		value   string
+		want    bool
+	}{
+		{"", "anything", true},
+		{"*", "anything", true},
+		{"/orders", "/orders", true},
+		{"/orders", "/orders/1", false},
+		{"/orders/*", "/orders/1", true},
+		{"/orders/*", "/orders/", true},
+		{"/orders/*", "/orders", false},
+		{"/orders/*", "/users/1", false},
+		{"*.example.com", "api.example.com", true},
+		{"*.example.com", "example.com", false},
+		{"*.example.com", "api.example.com.evil.com", false},
+		{"/api/*/items/*", "/api/v1/items/2", true},
+		{"/api/*/items/*", "/api/v1/orders/2", false},
+		{"/a*a", "/a", false},
+		{"/a*a", "/aa", true},
+	}
+	for _, tt := range tests {
+		if got := matchWildcard(tt.pattern, tt.value); got != tt.want {
+			t.Errorf("matchWildcard(%q, %q) = %v, want %v", tt.pattern, tt.value, got, tt.want)
+		}
+	}
+}
+
+func TestNewForwardedRequest(t *testing.T) {
+	tests := []struct {
+		name    string
+		headers map[string]string
+		want    forwardedRequest
+		wantErr bool
+	}{
+		{
+			name:    "defaults",
+			headers: map[string]string{"X-Forwarded-Host": "api.example.com", "X-Forwarded-Method": "GET", "X-Forwarded-Uri": "/orders/1?x=1"},
+			want:    forwardedRequest{Protocol: "http", Host: "api.example.com", Port: "80", Method: "GET", Path: "/orders/1"},
+		},
+		{
+			name:    "https with port in host",
+			headers: map[string]string{"X-Forwarded-Proto": "https", "X-Forwarded-Host": "api.example.com:8443", "X-Forwarded-Uri": "/"},
+			want:    forwardedRequest{Protocol: "https", Host: "api.example.com", Port: "8443", Path: "/"},
+		},
+		{
+			name:    "https default port",
+			headers: map[string]string{"X-Forwarded-Proto": "https", "X-Forwarded-Host": "api.example.com", "X-Forwarded-Uri": "/"},
+			want:    forwardedRequest{Protocol: "https", Host: "api.example.com", Port: "443", Path: "/"},
+		},
+		{
+			name:    "dot segments",
+			headers: map[string]string{"X-Forwarded-Uri": "/api/../admin"},
+			want:    forwardedRequest{Protocol: "http", Port: "80", Path: "/admin"},
+		},
+		{
+			name:    "encoded dot segments",
+			headers: map[string]string{"X-Forwarded-Uri": "/api/%2e%2e/admin"},
+			want:    forwardedRequest{Protocol: "http", Port: "80", Path: "/admin"},
+		},
+		{
+			name:    "above the root",
+			headers: map[string]string{"X-Forwarded-Uri": "/../../admin"},
+			want:    forwardedRequest{Protocol: "http", Port: "80", Path: "/admin"},
+		},
+		{
+			name:    "double slashes and trailing slash",
+			headers: map[string]string{"X-Forwarded-Uri": "/api//orders/./"},
+			want:    forwardedRequest{Protocol: "http", Port: "80", Path: "/api/orders/"},
+		},
+		{
+			name:    "relative uri",
+			headers: map[string]string{"X-Forwarded-Uri": "admin"},
+			wantErr: true,
+		},
+		{
+			name:    "missing uri",
+			headers: map[string]string{},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest("GET", "/forward-auth", nil)
+			for k, v := range tt.headers {
+				r.Header.Set(k, v)
+			}
+			got, err := newForwardedRequest(r)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("newForwardedRequest() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && got != tt.want {
+				t.Errorf("newForwardedRequest() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPermissionAllows(t *testing.T) {
+	p := &Permission{Protocol: "https", Host: "*.example.com", Method: "get", Path: "/api/*"}
+	tests := []struct {
+		name string
+		fr   forwardedRequest
+		want bool
+	}{
+		{"allowed", forwardedRequest{Protocol: "https", Host: "api.example.com", Port: "443", Method: "GET", Path: "/api/orders"}, true},
+		{"case insensitive", forwardedRequest{Protocol: "HTTPS", Host: "API.example.com", Port: "443", Method: "get", Path: "/api/orders"}, true},
+		{"protocol", forwardedRequest{Protocol: "http", Host: "api.example.com", Port: "80", Method: "GET", Path: "/api/orders"}, false},
+		{"host", forwardedRequest{Protocol: "https", Host: "api.other.com", Port: "443", Method: "GET", Path: "/api/orders"}, false},
+		{"method", forwardedRequest{Protocol: "https", Host: "api.example.com", Port: "443", Method: "DELETE", Path: "/api/orders"}, false},
+		{"path", forwardedRequest{Protocol: "https", Host: "api.example.com", Port: "443", Method: "GET", Path: "/admin"}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := p.allows(tt.fr); got != tt.want {
+				t.Errorf("allows(%+v) = %v, want %v", tt.fr, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestForwardedRequestPathTraversal(t *testing.T) {
+	p := &Permission{Method: "GET", Path: "/api/*"}
+	for _, uri := range []string{"/api/../admin", "/api/%2e%2e/admin", "/api/%2E%2E/admin", "/api/./../admin"} {
+		r := httptest.NewRequest("GET", "/forward-auth", nil)
+		r.Header.Set("X-Forwarded-Method", "GET")
+		r.Header.Set("X-Forwarded-Uri", uri)
+		fr, err := newForwardedRequest(r)
+		if err != nil {
+			t.Fatalf("newForwardedRequest(%q) error = %v", uri, err)
+		}
+		if p.allows(fr) {
+			t.Errorf("%q is allowed by %q as %q", uri, p.Path, fr.Path)
+		}
+	}
+}
+
+func TestForwardAuthHandler(t *testing.T) {
+	userID := uuid.New()
+	serveTestUser(t, User{
+		ID:         &userID,
+		Username:   "jane",
+		Permission: []*Permission{{Method: "DELETE", Host: "api.example.com", Path: "/orders/*"}},
+		Roles: []*Role{{Name: "viewer", Edges: RoleEdges{Permissions: []*Permission{
+			{Method: "GET", Host: "api.example.com", Path: "/orders/*"},
+		}}}},
+	})
+	client := addTestClient(&ent.Oauth2Client{})
+	ti := addTestToken(t, client, uuid.NewString(), "")
+	ti.UserID = userID.String()
+	storeTestToken(t, ti)
+
+	tests := []struct {
+		name   string
+		token  string
+		method string
+		status int
+	}{
+		{name: "role permission", token: ti.Access, method: "GET", status: http.StatusOK},
+		{name: "user permission", token: ti.Access, method: "DELETE", status: http.StatusOK},
+		{name: "not permitted", token: ti.Access, method: "POST", status: http.StatusForbidden},
+		{name: "no token", method: "GET", status: http.StatusUnauthorized},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest("GET", "/forward-auth", nil)
+			r.Header.Set("X-Forwarded-Host", "api.example.com")
+			r.Header.Set("X-Forwarded-Method", tt.method)
+			r.Header.Set("X-Forwarded-Uri", "/orders/1")
+			if tt.token != "" {
+				r.Header.Set("Authorization", "Bearer "+tt.token)
+			}
+			w := httptest.NewRecorder()
+			forwardAuthHandler(w, r)
+			if w.Code != tt.status {
+				t.Fatalf("status = %d, want %d", w.Code, tt.status)
+			}
+			if w.Code == http.StatusOK && (w.Header().Get("X-User-Id") != userID.String() || w.Header().Get("X-User-Roles") != "viewer" || w.Header().Get("X-Client-Id") != client.GetID()) {
+				t.Errorf("headers = %v", w.Header())
+			}
+		})
+	}
+}
+
+func TestForwardedRequestURL(t *testing.T) {
+	tests := []struct {
+		fr   forwardedRequest
+		want string
+	}{
+		{forwardedRequest{Protocol: "https", Host: "api.example.com", Port: "443", Path: "/orders/1"}, "https://api.example.com/orders/1"},
+		{forwardedRequest{Protocol: "HTTP", Host: "api.example.com", Port: "80", Path: "/"}, "http://api.example.com/"},
+		{forwardedRequest{Protocol: "https", Host: "api.example.com", Port: "8443", Path: "/a b"}, "https://api.example.com:8443/a%20b"},
+	}
+	for _, tt := range tests {
+		if got := tt.fr.url(); got != tt.want {
+			t.Errorf("url() = %q, want %q", got, tt.want)
+		}
+	}
+}
+
+func TestForwardAuthHandlerDPoP(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	jwk, _ := newJSONWebKey(key.Public())
+	jkt, _ := jwk.Thumbprint()
+	userID := uuid.New()
+	serveTestUser(t, User{ID: &userID, Permission: []*Permission{{Method: "GET", Host: "api.example.com", Path: "/orders/*"}}})
+
+	access, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"cnf": map[string]interface{}{"jkt": jkt},
+	}).SignedString([]byte("key"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	ti := addTestToken(t, addTestClient(&ent.Oauth2Client{}), access, "")
+	ti.UserID = userID.String()
+	storeTestToken(t, ti)
+
+	for htu, want := range map[string]int{
+		"https://api.example.com/orders/1":      http.StatusOK,
+		"https://as.example.com/forward-auth":   http.StatusUnauthorized,
+		"https://api.example.com:8443/orders/1": http.StatusUnauthorized,
+	} {
+		sum := sha256.Sum256([]byte(access))
+		proof := newTestDPoPProof(t, key, "dpop+jwt", jwt.MapClaims{
+			"htm": "GET",
+			"htu": htu,
+			"iat": time.Now().Unix(),
+			"jti": uuid.NewString(),
+			"ath": base64.RawURLEncoding.EncodeToString(sum[:]),
+		})
+		r := httptest.NewRequest("GET", "/forward-auth", nil)
+		r.Header.Set("X-Forwarded-Proto", "https")
+		r.Header.Set("X-Forwarded-Host", "api.example.com")
+		r.Header.Set("X-Forwarded-Method", "GET")
+		r.Header.Set("X-Forwarded-Uri", "/orders/1?x=1")
+		r.Header.Set("Authorization", "DPoP "+access)
+		r.Header.Set("DPoP", proof)
+		w := httptest.NewRecorder()
+		forwardAuthHandler(w, r)
+		if w.Code != want {
+			t.Errorf("htu %s: status = %d, want %d", htu, w.Code, want)
+		}
+	}
+}
@@ -2,7 +2,10 @@ module github.com/byebyebymyai/oauth2-api
 
 go 1.23.1
 
-require entgo.io/contrib v0.6.0
+require (
+	entgo.io/contrib v0.6.0
+	github.com/mattn/go-sqlite3 v1.14.16
+)
 
 require (
 	filippo.io/edwards25519 v1.1.0 // indirect
@@ -40,7 +43,7 @@ require (
 	github.com/mitchellh/mapstructure v1.5.0 // indirect
 	github.com/sosodev/duration v1.3.1 // indirect
 	github.com/tidwall/btree v1.7.0 // indirect
-	github.com/tidwall/buntdb v1.3.2 // indirect
+	github.com/tidwall/buntdb v1.3.2
 	github.com/tidwall/gjson v1.17.3 // indirect
 	github.com/tidwall/grect v0.1.4 // indirect
 	github.com/tidwall/match v1.1.1 // indirect
@@ -0,0 +1,133 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"slices"
+
+	"github.com/go-oauth2/oauth2/v4"
+	"github.com/go-oauth2/oauth2/v4/errors"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// introspectHandler implements token introspection (RFC 7662). The caller
+// must authenticate as a confidential client and can only introspect tokens
+// whose audience includes it.
+func introspectHandler(w http.ResponseWriter, r *http.Request) {
+	client, err := authenticateClient(r)
+	if err != nil {
+		tokenError(w, err)
+		return
+	}
+	if client.IsPublic() {
+		tokenError(w, errors.ErrInvalidClient)
+		return
+	}
+
+	token := r.FormValue("token")
+	if token == "" {
+		tokenError(w, errors.ErrInvalidRequest)
+		return
+	}
+
+	ti, isRefresh := loadToken(r.Context(), token, r.FormValue("token_type_hint"))
+	if ti == nil {
+		writeJSON(w, map[string]interface{}{"active": false}, nil, http.StatusOK)
+		return
+	}
+
+	aud := tokenAudience(ti)
+	if !slices.Contains(aud, client.GetID()) {
+		logger.Info("[introspectHandle]", "msg", "client is not in the token audience", "clientID", client.GetID())
+		writeJSON(w, map[string]interface{}{"active": false}, nil, http.StatusOK)
+		return
+	}
+
+	data := map[string]interface{}{
+		"active":     true,
+		"client_id":  ti.GetClientID(),
+		"token_type": srv.Config.TokenType,
+		"aud":        aud,
+	}
+	if scope := ti.GetScope(); scope != "" {
+		data["scope"] = scope
+	}
+	if userID := ti.GetUserID(); userID != "" {
+		data["sub"] = userID
+	}
+	if isRefresh {
+		delete(data, "token_type")
+		data["iat"] = ti.GetRefreshCreateAt().Unix()
+		if exp := ti.GetRefreshExpiresIn(); exp > 0 {
+			data["exp"] = ti.GetRefreshCreateAt().Add(exp).Unix()
+		}
+	} else {
+		data["iat"] = ti.GetAccessCreateAt().Unix()
+		if exp := ti.GetAccessExpiresIn(); exp > 0 {
+			data["exp"] = ti.GetAccessCreateAt().Add(exp).Unix()
+		}
+		// the confirmation lets resource servers check the sender
+		if cnf, ok := accessTokenClaims(ti)["cnf"]; ok {
+			data["cnf"] = cnf
+		}
+		if tokenJKT(ti) != "" {
+			data["token_type"] = "DPoP"
+		}
+		// the RBAC claims in full, which large tokens only refer to
+		if err := setIntrospectionRBACClaims(r.Context(), ti, data); err != nil {
+			errorLogger.Error("[introspectHandle]", "error", err.Error())
+			tokenError(w, err)
+			return
+		}
+	}
+	writeJSON(w, data, nil, http.StatusOK)
+}
+
+// loadToken looks token up in the token store, trying the type named by hint
+// first. It returns nil when the token is unknown, expired or revoked.
+func loadToken(ctx context.Context, token, hint string) (ti oauth2.TokenInfo, isRefresh bool) {
+	lookups := []bool{false, true}
+	if hint == "refresh_token" {
+		lookups = []bool{true, false}
+	}
+
+	for _, refresh := range lookups {
+		var err error
+		if refresh {
+			ti, err = srv.Manager.LoadRefreshToken(ctx, token)
+		} else {
+			ti, err = srv.Manager.LoadAccessToken(ctx, token)
+		}
+		if err == nil && ti != nil {
+			return ti, refresh
+		}
+	}
+	return nil, false
+}
+
+// tokenAudience returns the audience of a stored token: the client it was
+// issued to plus the aud claim of its access token when that is a JWT.
+func tokenAudience(ti oauth2.TokenInfo) []string {
+	aud := []string{ti.GetClientID()}
+
+	claims, err := accessTokenClaims(ti).GetAudience()
+	if err != nil {
+		return aud
+	}
+	for _, v := range claims {
+		if !slices.Contains(aud, v) {
+			aud = append(aud, v)
+		}
+	}
+	return aud
+}
+
+// accessTokenClaims returns the claims of a stored access token when it is a
+// JWT. The token comes from the store, so it is not verified again.
+func accessTokenClaims(ti oauth2.TokenInfo) jwt.MapClaims {
+	claims := jwt.MapClaims{}
+	if _, _, err := jwt.NewParser().ParseUnverified(ti.GetAccess(), claims); err != nil {
+		return jwt.MapClaims{}
+	}
+	return claims
+}
@@ -0,0 +1,150 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-oauth2/oauth2/v4/models"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+
+	"github.com/byebyebymyai/oauth2-api/ent"
+)
+
+// addTestToken stores a token issued to client with the given access and
+// refresh values.
+func addTestToken(t *testing.T, client *ent.Oauth2Client, access, refresh string) *models.Token {
+	t.Helper()
+	return storeTestToken(t, &models.Token{
+		ClientID:         client.GetID(),
+		UserID:           uuid.NewString(),
+		Scope:            "openid",
+		Access:           access,
+		AccessCreateAt:   time.Now(),
+		AccessExpiresIn:  time.Hour,
+		Refresh:          refresh,
+		RefreshCreateAt:  time.Now(),
+		RefreshExpiresIn: 24 * time.Hour,
+	})
+}
+
+// storeTestToken adds ti to the token store of the test server.
+func storeTestToken(t *testing.T, ti *models.Token) *models.Token {
+	t.Helper()
+	if err := testTokens.Create(context.Background(), ti); err != nil {
+		t.Fatal(err)
+	}
+	return ti
+}
+
+func introspect(t *testing.T, client *ent.Oauth2Client, secret string, form url.Values) map[string]interface{} {
+	t.Helper()
+	r := httptest.NewRequest("POST", "/introspect", strings.NewReader(form.Encode()))
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	r.SetBasicAuth(client.GetID(), secret)
+	w := httptest.NewRecorder()
+	introspectHandler(w, r)
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d, body %s", w.Code, w.Body)
+	}
+	var data map[string]interface{}
+	if err := json.Unmarshal(w.Body.Bytes(), &data); err != nil {
+		t.Fatal(err)
+	}
+	return data
+}
+
+func TestIntrospectHandler(t *testing.T) {
+	client := addTestClient(&ent.Oauth2Client{Secret: "secret"})
+	other := addTestClient(&ent.Oauth2Client{Secret: "secret"})
+	ti := addTestToken(t, client, uuid.NewString(), uuid.NewString())
+
+	t.Run("access token", func(t *testing.T) {
+		data := introspect(t, client, "secret", url.Values{"token": {ti.Access}})
+		if data["active"] != true || data["client_id"] != client.GetID() || data["sub"] != ti.UserID {
+			t.Errorf("introspection = %v", data)
+		}
+		if data["exp"] != float64(ti.AccessCreateAt.Add(ti.AccessExpiresIn).Unix()) {
+			t.Errorf("exp = %v", data["exp"])
+		}
+	})
+	t.Run("refresh token", func(t *testing.T) {
+		data := introspect(t, client, "secret", url.Values{"token": {ti.Refresh}, "token_type_hint": {"refresh_token"}})
+		if data["active"] != true || data["token_type"] != nil {
+			t.Errorf("introspection = %v", data)
+		}
+	})
+	t.Run("unknown token", func(t *testing.T) {
+		data := introspect(t, client, "secret", url.Values{"token": {"unknown"}})
+		if data["active"] != false || len(data) != 1 {
+			t.Errorf("introspection = %v", data)
+		}
+	})
+	t.Run("other client", func(t *testing.T) {
+		data := introspect(t, other, "secret", url.Values{"token": {ti.Access}})
+		if data["active"] != false {
+			t.Errorf("introspection = %v", data)
+		}
+	})
+}
+
+func TestIntrospectHandlerAuthentication(t *testing.T) {
+	client := addTestClient(&ent.Oauth2Client{Secret: "secret"})
+	public := addTestClient(&ent.Oauth2Client{})
+	ti := addTestToken(t, client, uuid.NewString(), "")
+
+	tests := []struct {
+		name   string
+		client *ent.Oauth2Client
+		secret string
+	}{
+		{name: "wrong secret", client: client, secret: "wrong"},
+		{name: "public client", client: public},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			form := url.Values{"token": {ti.Access}}
+			r := httptest.NewRequest("POST", "/introspect", strings.NewReader(form.Encode()))
+			r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+			r.SetBasicAuth(tt.client.GetID(), tt.secret)
+			w := httptest.NewRecorder()
+			introspectHandler(w, r)
+			if w.Code != http.StatusUnauthorized {
+				t.Errorf("status = %d, want %d", w.Code, http.StatusUnauthorized)
+			}
+		})
+	}
+}
+
+func TestTokenAudience(t *testing.T) {
+	access, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"aud": []string{"client", "api"},
+	}).SignedString([]byte("key"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name   string
+		access string
+		want   []string
+	}{
+		{name: "opaque", access: "opaque", want: []string{"client"}},
+		{name: "jwt", access: access, want: []string{"client", "api"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tokenAudience(&models.Token{ClientID: "client", Access: tt.access})
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("tokenAudience() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
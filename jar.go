@@ -0,0 +1,144 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"slices"
+	"time"
+
+	"github.com/go-oauth2/oauth2/v4/errors"
+	"github.com/golang-jwt/jwt/v5"
+
+	"github.com/byebyebymyai/oauth2-api/ent"
+)
+
+// requestObjectMaxLifetime is how long a request object may be valid, both
+// from its iat and from now.
+const requestObjectMaxLifetime = time.Hour
+
+var ErrInvalidRequestObject = errors.New("invalid_request_object")
+
+func init() {
+	errors.Descriptions[ErrInvalidRequestObject] = "The request object is invalid"
+	errors.StatusCodes[ErrInvalidRequestObject] = http.StatusBadRequest
+}
+
+// requestObjectClaims are the JWT claims of a request object that are not
+// authorization request parameters.
+var requestObjectClaims = []string{"iss", "aud", "exp", "nbf", "iat", "jti", "sub"}
+
+// resolveRequestObject merges the signed request object of an authorization
+// request (RFC 9101) into its parameters. The object is passed by value in
+// request or by reference in request_uri, which must be registered in the
+// client's request_uris. Parameters of the object take precedence.
+func resolveRequestObject(r *http.Request) error {
+	object := r.FormValue("request")
+	requestURI := r.FormValue("request_uri")
+	if object == "" && requestURI == "" {
+		return nil
+	}
+	if object != "" && requestURI != "" {
+		return errors.ErrInvalidRequest
+	}
+
+	ctx := r.Context()
+	client, err := getOauth2Client(ctx, r.FormValue("client_id"))
+	if err != nil {
+		return err
+	}
+	if requestURI != "" {
+		if !slices.Contains(client.RequestUris, requestURI) {
+			return ErrInvalidRequestURI
+		}
+		object, err = fetchRequestObject(ctx, requestURI)
+		if err != nil {
+			errorLogger.Error("[resolveRequestObject]", "error", err.Error(), "clientID", client.ID, "requestURI", requestURI)
+			return ErrInvalidRequestURI
+		}
+	}
+
+	claims, err := verifyRequestObject(ctx, client, object, issuer)
+	if err != nil {
+		errorLogger.Error("[resolveRequestObject]", "error", err.Error(), "clientID", client.ID)
+		return ErrInvalidRequestObject
+	}
+
+	params := url.Values{}
+	for k, v := range r.Form {
+		params[k] = v
+	}
+	params.Del("request")
+	params.Del("request_uri")
+	for k, v := range claims {
+		if slices.Contains(requestObjectClaims, k) {
+			continue
+		}
+		switch v := v.(type) {
+		case string:
+			params.Set(k, v)
+		default:
+			// e.g. the claims parameter, which is JSON anyway
+			b, err := json.Marshal(v)
+			if err != nil {
+				return ErrInvalidRequestObject
+			}
+			params.Set(k, string(b))
+		}
+	}
+	r.Form = params
+	return nil
+}
+
+// verifyRequestObject verifies the signature of a request object with the
+// client's keys and checks that it was made by the client for this server. It
+// must expire within requestObjectMaxLifetime.
+func verifyRequestObject(ctx context.Context, client *ent.Oauth2Client, object string, issuer string) (jwt.MapClaims, error) {
+	keyfunc, err := clientKeyfunc(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+
+	claims := jwt.MapClaims{}
+	if _, err := jwt.ParseWithClaims(object, claims, keyfunc, jwt.WithValidMethods(assertionAlgorithms), jwt.WithExpirationRequired()); err != nil {
+		return nil, err
+	}
+	exp, err := claims.GetExpirationTime()
+	if err != nil {
+		return nil, err
+	}
+	if time.Until(exp.Time) > requestObjectMaxLifetime {
+		return nil, errors.New("request object lifetime too long")
+	}
+	if iat, err := claims.GetIssuedAt(); err == nil && iat != nil && exp.Sub(iat.Time) > requestObjectMaxLifetime {
+		return nil, errors.New("request object lifetime too long")
+	}
+	if clientID, _ := claims["client_id"].(string); clientID != client.GetID() {
+		return nil, errors.New("client_id mismatch")
+	}
+	if iss, ok := claims["iss"]; ok && iss != client.GetID() {
+		return nil, errors.New("iss mismatch")
+	}
+	if _, ok := claims["aud"]; ok {
+		aud, err := claims.GetAudience()
+		if err != nil || !slices.Contains(aud, issuer) {
+			return nil, jwt.ErrTokenInvalidAudience
+		}
+	}
+	return claims, nil
+}
+
+// fetchRequestObject fetches a request object from its request_uri.
+func fetchRequestObject(ctx context.Context, requestURI string) (string, error) {
+	u, err := url.Parse(requestURI)
+	if err != nil {
+		return "", err
+	}
+	u.Fragment = ""
+	res, err := requestObjectEndpoint(ctx, u)(ctx, nil)
+	if err != nil {
+		return "", err
+	}
+	return res.(string), nil
+}
@@ -0,0 +1,132 @@
+package main
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/go-oauth2/oauth2/v4/errors"
+	"github.com/golang-jwt/jwt/v5"
+
+	"github.com/byebyebymyai/oauth2-api/ent"
+)
+
+func TestResolveRequestObject(t *testing.T) {
+	key, jwks := newTestIssuer(t)
+	other, _ := newTestIssuer(t)
+	client := addTestClient(&ent.Oauth2Client{
+		Domain:      "https://app.example.com",
+		Jwks:        jwks,
+		RequestUris: []string{"https://app.example.com/request.jwt"},
+	})
+
+	sign := func(key *ecdsa.PrivateKey, claims jwt.MapClaims) string {
+		token := jwt.NewWithClaims(jwt.SigningMethodES256, claims)
+		token.Header["kid"] = "issuer-key"
+		object, err := token.SignedString(key)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return object
+	}
+	claims := func(extra jwt.MapClaims) jwt.MapClaims {
+		claims := jwt.MapClaims{
+			"iss":           client.GetID(),
+			"aud":           "https://as.example.com",
+			"exp":           time.Now().Add(time.Minute).Unix(),
+			"client_id":     client.GetID(),
+			"response_type": "code",
+			"state":         "signed",
+			"claims":        map[string]interface{}{"userinfo": map[string]interface{}{"name": nil}},
+		}
+		for k, v := range extra {
+			if v == nil {
+				delete(claims, k)
+				continue
+			}
+			claims[k] = v
+		}
+		return claims
+	}
+
+	tests := []struct {
+		name   string
+		params url.Values
+		want   error
+	}{
+		{name: "request", params: url.Values{"request": {sign(key, claims(nil))}, "state": {"plain"}}},
+		{name: "wrong key", params: url.Values{"request": {sign(other, claims(nil))}}, want: ErrInvalidRequestObject},
+		{name: "client_id mismatch", params: url.Values{"request": {sign(key, claims(jwt.MapClaims{"client_id": "other"}))}}, want: ErrInvalidRequestObject},
+		{name: "wrong audience", params: url.Values{"request": {sign(key, claims(jwt.MapClaims{"aud": "https://other.example.com"}))}}, want: ErrInvalidRequestObject},
+		{name: "expired", params: url.Values{"request": {sign(key, claims(jwt.MapClaims{"exp": time.Now().Add(-time.Minute).Unix()}))}}, want: ErrInvalidRequestObject},
+		{name: "no exp", params: url.Values{"request": {sign(key, claims(jwt.MapClaims{"exp": nil}))}}, want: ErrInvalidRequestObject},
+		{name: "exp too late", params: url.Values{"request": {sign(key, claims(jwt.MapClaims{"exp": time.Now().Add(2 * time.Hour).Unix()}))}}, want: ErrInvalidRequestObject},
+		{name: "lifetime too long", params: url.Values{"request": {sign(key, claims(jwt.MapClaims{"iat": time.Now().Add(-2 * time.Hour).Unix()}))}}, want: ErrInvalidRequestObject},
+		{name: "request and request_uri", params: url.Values{"request": {sign(key, claims(nil))}, "request_uri": {"https://app.example.com/request.jwt"}}, want: errors.ErrInvalidRequest},
+		{name: "unregistered request_uri", params: url.Values{"request_uri": {"https://evil.example.com/request.jwt"}}, want: ErrInvalidRequestURI},
+		{name: "no request object", params: url.Values{"state": {"plain"}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.params.Set("client_id", client.GetID())
+			r := httptest.NewRequest("GET", "/authorize?"+tt.params.Encode(), nil)
+			if err := resolveRequestObject(r); err != tt.want {
+				t.Fatalf("resolveRequestObject() error = %v, want %v", err, tt.want)
+			}
+			if tt.want != nil || !tt.params.Has("request") {
+				return
+			}
+			if r.FormValue("state") != "signed" || r.FormValue("request") != "" || r.FormValue("iss") != "" {
+				t.Errorf("parameters = %v", r.Form)
+			}
+			if got := r.FormValue("claims"); got != `{"userinfo":{"name":null}}` {
+				t.Errorf("claims = %q", got)
+			}
+		})
+	}
+}
+
+func TestVerifyRequestObjectJWKSURI(t *testing.T) {
+	key, jwks := newTestIssuer(t)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write(jwks)
+	}))
+	defer ts.Close()
+	client := externalHTTPClient
+	externalHTTPClient = ts.Client()
+	t.Cleanup(func() { externalHTTPClient = client })
+
+	c := addTestClient(&ent.Oauth2Client{JwksURI: ts.URL})
+	token := jwt.NewWithClaims(jwt.SigningMethodES256, jwt.MapClaims{"client_id": c.GetID(), "exp": time.Now().Add(time.Minute).Unix()})
+	token.Header["kid"] = "issuer-key"
+	object, err := token.SignedString(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := verifyRequestObject(context.Background(), c, object, "https://as.example.com"); err != nil {
+		t.Errorf("verifyRequestObject() = %v", err)
+	}
+}
+
+func TestFetchRequestObject(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("object"))
+	}))
+	defer ts.Close()
+
+	ctx := context.Background()
+	if _, err := fetchRequestObject(ctx, ts.URL); err == nil {
+		t.Error("fetchRequestObject() fetched from a loopback address")
+	}
+
+	client := externalHTTPClient
+	externalHTTPClient = ts.Client()
+	t.Cleanup(func() { externalHTTPClient = client })
+	if object, err := fetchRequestObject(ctx, ts.URL); err != nil || object != "object" {
+		t.Errorf("fetchRequestObject() = %q, %v", object, err)
+	}
+}
@@ -0,0 +1,81 @@
+package main
+
+import (
+	"net/http"
+
+	"github.com/go-oauth2/oauth2/v4"
+	"github.com/go-oauth2/oauth2/v4/errors"
+)
+
+// jwtBearerGrantType is the JWT bearer authorization grant (RFC 7523).
+const jwtBearerGrantType = "urn:ietf:params:oauth:grant-type:jwt-bearer"
+
+// jwtBearerGrant issues an access token for the subject of a JWT assertion
+// signed by one of the issuers the client trusts.
+func jwtBearerGrant(w http.ResponseWriter, r *http.Request) {
+	client, err := authenticateClient(r)
+	if err != nil {
+		tokenError(w, err)
+		return
+	}
+
+	ctx := r.Context()
+	assertion := r.FormValue("assertion")
+	if assertion == "" {
+		tokenError(w, errors.ErrInvalidRequest)
+		return
+	}
+	unverified, err := parseUnverifiedAssertion(assertion)
+	if err != nil {
+		tokenError(w, errors.ErrInvalidGrant)
+		return
+	}
+	jwks, ok := client.JwtBearerIssuers[unverified.Issuer]
+	if !ok {
+		tokenError(w, errors.ErrInvalidGrant)
+		return
+	}
+	keys, err := parseJSONWebKeySet(jwks)
+	if err != nil {
+		errorLogger.Error("[jwtBearerGrant]", "error", err.Error(), "clientID", client.ID, "issuer", unverified.Issuer)
+		tokenError(w, errors.ErrServerError)
+		return
+	}
+
+	claims, err := verifyAssertion(assertion, keys.Keyfunc(), assertionAlgorithms, assertionAudiences(r))
+	if err != nil {
+		errorLogger.Error("[jwtBearerGrant]", "error", err.Error(), "clientID", client.ID, "issuer", unverified.Issuer)
+		tokenError(w, errors.ErrInvalidGrant)
+		return
+	}
+	if claims.Subject == "" {
+		tokenError(w, errors.ErrInvalidGrant)
+		return
+	}
+	replayed, err := useAssertionID(ctx, claims)
+	if err != nil {
+		tokenError(w, err)
+		return
+	}
+	if replayed {
+		errorLogger.Error("[jwtBearerGrant]", "error", "assertion replayed", "clientID", client.ID, "issuer", claims.Issuer, "jti", claims.ID)
+		tokenError(w, errors.ErrInvalidGrant)
+		return
+	}
+
+	_, clientSecret := clientCredentials(r)
+	ti, err := generateExtensionToken(ctx, jwtBearerGrantType, &oauth2.TokenGenerateRequest{
+		ClientID:     client.GetID(),
+		ClientSecret: clientSecret,
+		UserID:       claims.Subject,
+		Scope:        r.FormValue("scope"),
+		Request:      r,
+	}, false)
+	if err != nil {
+		tokenError(w, err)
+		return
+	}
+
+	logger.Info("[jwtBearerGrant]", "msg", "assertion exchanged", "clientID", client.ID, "issuer", claims.Issuer, "sub", claims.Subject)
+	writeJSON(w, tokenData(ctx, ti), nil, http.StatusOK)
+}
@@ -0,0 +1,121 @@
+package main
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+
+	"github.com/byebyebymyai/oauth2-api/ent"
+)
+
+// newTestIssuer creates an ES256 key of an assertion issuer and its JWKS.
+func newTestIssuer(t *testing.T) (*ecdsa.PrivateKey, json.RawMessage) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	jwk, err := newJSONWebKey(key.Public())
+	if err != nil {
+		t.Fatal(err)
+	}
+	jwk.Kid = "issuer-key"
+	jwks, err := json.Marshal(JSONWebKeySet{Keys: []*JSONWebKey{jwk}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return key, jwks
+}
+
+func TestJWTBearerGrant(t *testing.T) {
+	key, jwks := newTestIssuer(t)
+	client := addTestClient(&ent.Oauth2Client{
+		Secret:           "secret",
+		JwtBearerIssuers: map[string]json.RawMessage{"https://idp.example.com": jwks},
+	})
+	other, _ := newTestIssuer(t)
+
+	sign := func(key *ecdsa.PrivateKey, claims jwt.MapClaims) string {
+		token := jwt.NewWithClaims(jwt.SigningMethodES256, claims)
+		token.Header["kid"] = "issuer-key"
+		assertion, err := token.SignedString(key)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return assertion
+	}
+	claims := func(iss, sub, jti string) jwt.MapClaims {
+		return jwt.MapClaims{
+			"iss": iss,
+			"sub": sub,
+			"aud": "https://as.example.com/token",
+			"exp": time.Now().Add(time.Minute).Unix(),
+			"iat": time.Now().Unix(),
+			"jti": jti,
+		}
+	}
+	replayed := uuid.NewString()
+
+	tests := []struct {
+		name      string
+		assertion string
+		want      string
+	}{
+		{name: "valid", assertion: sign(key, claims("https://idp.example.com", "user", uuid.NewString()))},
+		{name: "first use", assertion: sign(key, claims("https://idp.example.com", "user", replayed))},
+		{name: "replayed", assertion: sign(key, claims("https://idp.example.com", "user", replayed)), want: "invalid_grant"},
+		{name: "untrusted issuer", assertion: sign(key, claims("https://other.example.com", "user", uuid.NewString())), want: "invalid_grant"},
+		{name: "wrong key", assertion: sign(other, claims("https://idp.example.com", "user", uuid.NewString())), want: "invalid_grant"},
+		{name: "subject missing", assertion: sign(key, claims("https://idp.example.com", "", uuid.NewString())), want: "invalid_grant"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status, data := postTestForm(t, tokenHandler, "/token", url.Values{
+				"grant_type":    {jwtBearerGrantType},
+				"assertion":     {tt.assertion},
+				"client_id":     {client.GetID()},
+				"client_secret": {"secret"},
+			})
+			if tt.want != "" {
+				if data["error"] != tt.want {
+					t.Errorf("error = %v, want %s", data["error"], tt.want)
+				}
+				return
+			}
+			if status != http.StatusOK || data["access_token"] == nil {
+				t.Fatalf("status = %d, body %v", status, data)
+			}
+		})
+	}
+}
+
+func TestJSONWebKeyPublicKey(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	jwk, err := newJSONWebKey(key.Public())
+	if err != nil {
+		t.Fatal(err)
+	}
+	pub, err := jwk.PublicKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !key.PublicKey.Equal(pub) {
+		t.Error("PublicKey() does not match the encoded key")
+	}
+
+	jwk.Y = jwk.X
+	if _, err := jwk.PublicKey(); err == nil {
+		t.Error("PublicKey() accepted a point off the curve")
+	}
+}
@@ -0,0 +1,288 @@
+package main
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// signingKey is a private key used to sign JWTs, identified by the
+// thumbprint of its public key.
+type signingKey struct {
+	kid    string
+	method jwt.SigningMethod
+	key    crypto.Signer
+}
+
+// Sign signs claims as a compact JWS with the given typ header.
+func (k *signingKey) Sign(claims jwt.Claims, typ string) (string, error) {
+	token := jwt.NewWithClaims(k.method, claims)
+	token.Header["kid"] = k.kid
+	if typ != "" {
+		token.Header["typ"] = typ
+	}
+	return token.SignedString(k.key)
+}
+
+// newSigningKey picks the signing method for a private key: RS256 for RSA,
+// ES256 for P-256 and EdDSA for Ed25519 keys.
+func newSigningKey(key crypto.Signer) (*signingKey, error) {
+	var method jwt.SigningMethod
+	switch k := key.(type) {
+	case *rsa.PrivateKey:
+		method = jwt.SigningMethodRS256
+	case *ecdsa.PrivateKey:
+		if k.Curve != elliptic.P256() {
+			return nil, fmt.Errorf("unsupported curve %s", k.Curve.Params().Name)
+		}
+		method = jwt.SigningMethodES256
+	case ed25519.PrivateKey:
+		method = jwt.SigningMethodEdDSA
+	default:
+		return nil, errors.New("unsupported private key type")
+	}
+
+	jwk, err := newJSONWebKey(key.Public())
+	if err != nil {
+		return nil, err
+	}
+	kid, err := jwk.Thumbprint()
+	if err != nil {
+		return nil, err
+	}
+	return &signingKey{kid: kid, method: method, key: key}, nil
+}
+
+// parseSigningKey parses a PEM encoded PKCS #8, PKCS #1 or SEC 1 private key.
+func parseSigningKey(data []byte) (*signingKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("no PEM block found")
+	}
+
+	var key interface{}
+	var err error
+	switch block.Type {
+	case "RSA PRIVATE KEY":
+		key, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "EC PRIVATE KEY":
+		key, err = x509.ParseECPrivateKey(block.Bytes)
+	default:
+		key, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	signer, ok := key.(crypto.Signer)
+	if !ok {
+		return nil, errors.New("unsupported private key type")
+	}
+	return newSigningKey(signer)
+}
+
+// loadSigningKeys reads the signing keys from a comma separated list of PEM
+// files and from every *.pem file in dir, in reverse name order so that keys
+// named by date put the newest one first. The first key is the active one.
+func loadSigningKeys(files string, dir string) ([]*signingKey, error) {
+	var paths []string
+	for _, f := range strings.Split(files, ",") {
+		if f = strings.TrimSpace(f); f != "" {
+			paths = append(paths, f)
+		}
+	}
+	if dir != "" {
+		matches, err := filepath.Glob(filepath.Join(dir, "*.pem"))
+		if err != nil {
+			return nil, err
+		}
+		sort.Sort(sort.Reverse(sort.StringSlice(matches)))
+		paths = append(paths, matches...)
+	}
+
+	var keys []*signingKey
+	for _, path := range paths {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		key, err := parseSigningKey(data)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
+
+// generateSigningKey creates an ephemeral RS256 key, for running without
+// configured keys in development.
+func generateSigningKey() (*signingKey, error) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		return nil, err
+	}
+	return newSigningKey(key)
+}
+
+// publicJWK returns the public JWK of the key.
+func (k *signingKey) publicJWK() (*JSONWebKey, error) {
+	jwk, err := newJSONWebKey(k.key.Public())
+	if err != nil {
+		return nil, err
+	}
+	jwk.Kid = k.kid
+	jwk.Use = "sig"
+	jwk.Alg = k.method.Alg()
+	return jwk, nil
+}
+
+// keySet holds the signing keys read from the configuration. Keys that are
+// removed from the configuration are kept as retiring keys, still published
+// but no longer signing, until the tokens they signed have expired.
+type keySet struct {
+	mu        sync.RWMutex
+	files     string
+	dir       string
+	retention time.Duration
+	keys      []*signingKey
+	retiring  map[string]retiringKey
+}
+
+type retiringKey struct {
+	key   *signingKey
+	until time.Time
+}
+
+// newKeySet creates a key set holding keys, which are reloaded from files and
+// dir. Retiring keys are published for retention after their removal.
+func newKeySet(files, dir string, retention time.Duration, keys []*signingKey) *keySet {
+	return &keySet{
+		files:     files,
+		dir:       dir,
+		retention: retention,
+		keys:      keys,
+		retiring:  make(map[string]retiringKey),
+	}
+}
+
+// Active returns the key that signs new tokens, or nil if there is none.
+func (s *keySet) Active() *signingKey {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if len(s.keys) == 0 {
+		return nil
+	}
+	return s.keys[0]
+}
+
+// Keys returns the public keys of the configured and retiring keys.
+func (s *keySet) Keys() ([]*JSONWebKey, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	keys := make([]*JSONWebKey, 0, len(s.keys)+len(s.retiring))
+	for _, k := range s.keys {
+		jwk, err := k.publicJWK()
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, jwk)
+	}
+	now := time.Now()
+	for _, r := range s.retiring {
+		if r.until.Before(now) {
+			continue
+		}
+		jwk, err := r.key.publicJWK()
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, jwk)
+	}
+	return keys, nil
+}
+
+// Reload reads the keys from the configuration again. An empty configuration
+// leaves the set unchanged.
+func (s *keySet) Reload() error {
+	keys, err := loadSigningKeys(s.files, s.dir)
+	if err != nil {
+		return err
+	}
+	if len(keys) == 0 {
+		return nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	current := make(map[string]bool, len(keys))
+	for _, k := range keys {
+		current[k.kid] = true
+		delete(s.retiring, k.kid)
+	}
+	for _, k := range s.keys {
+		if !current[k.kid] {
+			s.retiring[k.kid] = retiringKey{key: k, until: now.Add(s.retention)}
+		}
+	}
+	for kid, r := range s.retiring {
+		if r.until.Before(now) {
+			delete(s.retiring, kid)
+		}
+	}
+	s.keys = keys
+	return nil
+}
+
+// Watch reloads the keys every interval until ctx is done.
+func (s *keySet) Watch(ctx context.Context, interval time.Duration) {
+	if s.files == "" && s.dir == "" {
+		return
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := s.Reload(); err != nil {
+				errorLogger.Error("[keySet]", "msg", "failed reloading signing keys", "error", err.Error())
+			}
+		}
+	}
+}
+
+// jwksHandler publishes the public keys that verify the issued tokens.
+func jwksHandler(w http.ResponseWriter, r *http.Request) {
+	keys, err := keyService.Keys()
+	if err != nil {
+		errorLogger.Error("[jwksHandle]", "error", err.Error())
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json;charset=UTF-8")
+	w.Header().Set("Cache-Control", "public, max-age=300")
+	json.NewEncoder(w).Encode(map[string]interface{}{"keys": keys})
+}
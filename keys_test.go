@@ -0,0 +1,219 @@
+package main
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/go-oauth2/oauth2/v4"
+	"github.com/go-oauth2/oauth2/v4/models"
+	"github.com/golang-jwt/jwt/v5"
+
+	"github.com/byebyebymyai/oauth2-api/ent"
+)
+
+func TestParseSigningKey(t *testing.T) {
+	rsaKey, _ := rsa.GenerateKey(rand.Reader, 2048)
+	p256Key, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	p384Key, _ := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	_, edKey, _ := ed25519.GenerateKey(rand.Reader)
+	pkcs8 := func(key crypto.Signer) []byte {
+		der, err := x509.MarshalPKCS8PrivateKey(key)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})
+	}
+	sec1, _ := x509.MarshalECPrivateKey(p256Key)
+
+	tests := []struct {
+		name    string
+		data    []byte
+		want    jwt.SigningMethod
+		wantErr bool
+	}{
+		{name: "PKCS #1 RSA", data: pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(rsaKey)}), want: jwt.SigningMethodRS256},
+		{name: "PKCS #8 RSA", data: pkcs8(rsaKey), want: jwt.SigningMethodRS256},
+		{name: "SEC 1 P-256", data: pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: sec1}), want: jwt.SigningMethodES256},
+		{name: "PKCS #8 Ed25519", data: pkcs8(edKey), want: jwt.SigningMethodEdDSA},
+		{name: "P-384", data: pkcs8(p384Key), wantErr: true},
+		{name: "not PEM", data: []byte("key"), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			key, err := parseSigningKey(tt.data)
+			if tt.wantErr {
+				if err == nil {
+					t.Error("parseSigningKey() succeeded, want error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if key.method != tt.want || key.kid == "" {
+				t.Errorf("parseSigningKey() = %s %q, want %s", key.method.Alg(), key.kid, tt.want.Alg())
+			}
+		})
+	}
+}
+
+func TestJSONWebKeyThumbprint(t *testing.T) {
+	// Example from RFC 7638, section 3.1.
+	key := &JSONWebKey{
+		Kty: "RSA",
+		E:   "AQAB",
+		N:   "0vx7agoebGcQSuuPiLJXZptN9nndrQmbXEps2aiAFbWhM78LhWx4cbbfAAtVT86zwu1RK7aPFFxuhDR1L6tSoc_BJECPebWKRXjBZCiFV4n3oknjhMstn64tZ_2W-5JsGY4Hc5n9yBXArwl93lqt7_RN5w6Cf0h4QyQ5v-65YGjQR0_FDW2QvzqY368QQMicAtaSqzs8KJZgnYb9c7d0zgdAZHzu6qMQvRL5hajrn1n91CbOpbISD08qNLyrdkt-bFTWhAI4vMQFh6WeZu0fM4lFd2NcRwr3XPksINHaQ-G_xBniIqbw0Ls1jF44-csFCur-kEgU8awapJzKnqDKgw",
+	}
+	got, err := key.Thumbprint()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "NzbLsXh8uDCcd-6MNwXF4W_7noWXFZAfHkxZsRGC9Xs"; got != want {
+		t.Errorf("Thumbprint() = %q, want %q", got, want)
+	}
+}
+
+func TestDefaultTokenService(t *testing.T) {
+	key, err := generateSigningKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	svc := defaultTokenService{issuer: "https://as.example.com", keys: newKeySet("", "", 0, []*signingKey{key})}
+	client := addTestClient(&ent.Oauth2Client{})
+	now := time.Now()
+
+	access, refresh, err := svc.Token(context.Background(), &oauth2.GenerateBasic{
+		Client:   client,
+		UserID:   "user",
+		CreateAt: now,
+		TokenInfo: &models.Token{
+			Scope:           "openid profile",
+			AccessCreateAt:  now,
+			AccessExpiresIn: time.Hour,
+		},
+	}, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if refresh == "" {
+		t.Error("no refresh token generated")
+	}
+
+	claims := jwt.MapClaims{}
+	token, err := jwt.ParseWithClaims(access, claims, func(*jwt.Token) (interface{}, error) {
+		return key.key.Public(), nil
+	}, jwt.WithIssuer(svc.issuer), jwt.WithAudience(client.GetID()), jwt.WithExpirationRequired())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if token.Header["kid"] != key.kid || token.Header["typ"] != "at+jwt" {
+		t.Errorf("header = %v", token.Header)
+	}
+	if claims["sub"] != "user" || claims["scope"] != "openid profile" || claims["client_id"] != client.GetID() {
+		t.Errorf("claims = %v", claims)
+	}
+}
+
+func writeTestKey(t *testing.T, dir, name string) *signingKey {
+	t.Helper()
+	key, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data := pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})
+	if err := os.WriteFile(filepath.Join(dir, name), data, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	sk, err := parseSigningKey(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return sk
+}
+
+func keyIDs(t *testing.T, s *keySet) []string {
+	t.Helper()
+	keys, err := s.Keys()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var kids []string
+	for _, k := range keys {
+		kids = append(kids, k.Kid)
+	}
+	return kids
+}
+
+func TestKeySetReload(t *testing.T) {
+	dir := t.TempDir()
+	old := writeTestKey(t, dir, "2026-01-01.pem")
+	keys, err := loadSigningKeys("", dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := newKeySet("", dir, time.Hour, keys)
+
+	newer := writeTestKey(t, dir, "2026-02-01.pem")
+	if err := s.Reload(); err != nil {
+		t.Fatal(err)
+	}
+	if s.Active().kid != newer.kid {
+		t.Error("newest key is not active")
+	}
+
+	if err := os.Remove(filepath.Join(dir, "2026-01-01.pem")); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Reload(); err != nil {
+		t.Fatal(err)
+	}
+	if kids := keyIDs(t, s); len(kids) != 2 || kids[1] != old.kid {
+		t.Errorf("Keys() = %v, want the removed key retiring", kids)
+	}
+
+	s.retiring[old.kid] = retiringKey{key: old, until: time.Now().Add(-time.Second)}
+	for _, kid := range keyIDs(t, s) {
+		if kid == old.kid {
+			t.Error("expired retiring key is still published")
+		}
+	}
+}
+
+func TestJWKSHandler(t *testing.T) {
+	key, err := generateSigningKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyService = defaultKeyService{newKeySet("", "", 0, []*signingKey{key})}
+	t.Cleanup(func() { keyService = nil })
+
+	w := httptest.NewRecorder()
+	jwksHandler(w, httptest.NewRequest("GET", "/.well-known/jwks.json", nil))
+
+	var jwks struct {
+		Keys []JSONWebKey `json:"keys"`
+	}
+	if err := json.Unmarshal(w.Body.Bytes(), &jwks); err != nil {
+		t.Fatal(err)
+	}
+	if len(jwks.Keys) != 1 {
+		t.Fatalf("keys = %v", jwks.Keys)
+	}
+	if k := jwks.Keys[0]; k.Kid != key.kid || k.Alg != "RS256" || k.Use != "sig" || k.N == "" {
+		t.Errorf("key = %+v", k)
+	}
+}
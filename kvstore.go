@@ -0,0 +1,161 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/tidwall/buntdb"
+)
+
+// errKeyNotFound is returned by KVStore.Get for missing or expired keys.
+var errKeyNotFound = errors.New("key not found")
+
+// KVStore is an expiring key/value store for the server state that does not
+// fit into the oauth2.TokenStore. Like the tokens it lives in Redis or in
+// memory.
+type KVStore interface {
+	Set(ctx context.Context, key string, value []byte, ttl time.Duration) error
+	// SetNX sets key only if it does not exist and reports whether it did.
+	SetNX(ctx context.Context, key string, value []byte, ttl time.Duration) (bool, error)
+	Get(ctx context.Context, key string) ([]byte, error)
+	// GetDel gets and deletes key at once, so that only one caller gets it.
+	GetDel(ctx context.Context, key string) ([]byte, error)
+	Del(ctx context.Context, key string) error
+}
+
+// newKVStore returns the Redis store when Redis is enabled and an in-memory
+// store otherwise.
+func newKVStore() (KVStore, error) {
+	if redisClient != nil {
+		return &redisKVStore{cli: redisClient, ns: "oauth2:"}, nil
+	}
+	db, err := buntdb.Open(":memory:")
+	if err != nil {
+		return nil, err
+	}
+	return &memoryKVStore{db: db}, nil
+}
+
+// setJSON stores v as JSON under key.
+func setJSON(ctx context.Context, s KVStore, key string, v interface{}, ttl time.Duration) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	return s.Set(ctx, key, b, ttl)
+}
+
+// getJSON decodes the JSON stored under key into v.
+func getJSON(ctx context.Context, s KVStore, key string, v interface{}) error {
+	b, err := s.Get(ctx, key)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(b, v)
+}
+
+// getDelJSON decodes the JSON stored under key into v and deletes it.
+func getDelJSON(ctx context.Context, s KVStore, key string, v interface{}) error {
+	b, err := s.GetDel(ctx, key)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(b, v)
+}
+
+type redisKVStore struct {
+	cli *redis.Client
+	ns  string
+}
+
+func (s *redisKVStore) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	return s.cli.Set(ctx, s.ns+key, value, ttl).Err()
+}
+
+func (s *redisKVStore) SetNX(ctx context.Context, key string, value []byte, ttl time.Duration) (bool, error) {
+	return s.cli.SetNX(ctx, s.ns+key, value, ttl).Result()
+}
+
+func (s *redisKVStore) Get(ctx context.Context, key string) ([]byte, error) {
+	b, err := s.cli.Get(ctx, s.ns+key).Bytes()
+	if err == redis.Nil {
+		return nil, errKeyNotFound
+	}
+	return b, err
+}
+
+func (s *redisKVStore) GetDel(ctx context.Context, key string) ([]byte, error) {
+	b, err := s.cli.GetDel(ctx, s.ns+key).Bytes()
+	if err == redis.Nil {
+		return nil, errKeyNotFound
+	}
+	return b, err
+}
+
+func (s *redisKVStore) Del(ctx context.Context, key string) error {
+	return s.cli.Del(ctx, s.ns+key).Err()
+}
+
+type memoryKVStore struct {
+	db *buntdb.DB
+}
+
+func (s *memoryKVStore) Set(_ context.Context, key string, value []byte, ttl time.Duration) error {
+	return s.db.Update(func(tx *buntdb.Tx) error {
+		_, _, err := tx.Set(key, string(value), &buntdb.SetOptions{Expires: ttl > 0, TTL: ttl})
+		return err
+	})
+}
+
+func (s *memoryKVStore) SetNX(_ context.Context, key string, value []byte, ttl time.Duration) (bool, error) {
+	set := false
+	err := s.db.Update(func(tx *buntdb.Tx) error {
+		if _, err := tx.Get(key); err != buntdb.ErrNotFound {
+			return err
+		}
+		_, _, err := tx.Set(key, string(value), &buntdb.SetOptions{Expires: ttl > 0, TTL: ttl})
+		set = err == nil
+		return err
+	})
+	return set, err
+}
+
+func (s *memoryKVStore) Get(_ context.Context, key string) ([]byte, error) {
+	var value string
+	err := s.db.View(func(tx *buntdb.Tx) error {
+		v, err := tx.Get(key)
+		value = v
+		return err
+	})
+	if err == buntdb.ErrNotFound {
+		return nil, errKeyNotFound
+	}
+	return []byte(value), err
+}
+
+func (s *memoryKVStore) GetDel(_ context.Context, key string) ([]byte, error) {
+	var value string
+	err := s.db.Update(func(tx *buntdb.Tx) error {
+		v, err := tx.Delete(key)
+		value = v
+		return err
+	})
+	if err == buntdb.ErrNotFound {
+		return nil, errKeyNotFound
+	}
+	return []byte(value), err
+}
+
+func (s *memoryKVStore) Del(_ context.Context, key string) error {
+	err := s.db.Update(func(tx *buntdb.Tx) error {
+		_, err := tx.Delete(key)
+		return err
+	})
+	if err == buntdb.ErrNotFound {
+		return nil
+	}
+	return err
+}
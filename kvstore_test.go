@@ -0,0 +1,35 @@
+package main
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestKVStoreGetDel(t *testing.T) {
+	ctx := context.Background()
+	if err := stateStore.Set(ctx, "getdel", []byte("value"), time.Minute); err != nil {
+		t.Fatal(err)
+	}
+
+	var got atomic.Int32
+	var wg sync.WaitGroup
+	for range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if v, err := stateStore.GetDel(ctx, "getdel"); err == nil && string(v) == "value" {
+				got.Add(1)
+			}
+		}()
+	}
+	wg.Wait()
+	if got.Load() != 1 {
+		t.Errorf("GetDel() succeeded %d times, want once", got.Load())
+	}
+	if _, err := stateStore.Get(ctx, "getdel"); err != errKeyNotFound {
+		t.Errorf("Get() after GetDel() = %v, want %v", err, errKeyNotFound)
+	}
+}
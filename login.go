@@ -0,0 +1,484 @@
+package main
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"html/template"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/go-oauth2/oauth2/v4/errors"
+	"golang.org/x/crypto/bcrypt"
+)
+
+const (
+	// sessionCookieName is the cookie holding the signed login session id.
+	sessionCookieName = "oauth2_session"
+	// csrfCookieName is the cookie holding the token that the login and
+	// logout forms send back as csrf_token, so that other sites cannot
+	// submit them.
+	csrfCookieName = "oauth2_csrf"
+	// loginRequestExp is how long the user has to log in.
+	loginRequestExp = 30 * time.Minute
+)
+
+// loginSession is the login of a user in the browser. It expires after
+// sessionIdleTimeout without use, and sessionAbsoluteTimeout after the login.
+type loginSession struct {
+	UserID   string `json:"user_id"`
+	AuthTime int64  `json:"auth_time"`
+}
+
+func loginSessionKey(id string) string {
+	return "login:" + id
+}
+
+// loginSessionContextKey holds the login session started by a request.
+type loginSessionContextKey struct{}
+
+// loginRequest is a request waiting for the user to log in. Authorization
+// requests are kept with their resolved parameters and handled once the user
+// has logged in; other pages are returned to at ReturnTo.
+type loginRequest struct {
+	ReturnTo  string     `json:"return_to,omitempty"`
+	Authorize url.Values `json:"authorize,omitempty"`
+}
+
+func loginRequestKey(id string) string {
+	return "login-request:" + id
+}
+
+// ttl returns how long the session stays valid after being used now.
+func (s *loginSession) ttl() time.Duration {
+	ttl := time.Until(time.Unix(s.AuthTime, 0).Add(sessionAbsoluteTimeout))
+	if ttl > sessionIdleTimeout {
+		ttl = sessionIdleTimeout
+	}
+	return ttl
+}
+
+// signSessionID returns the cookie value for the session id.
+func signSessionID(id string) string {
+	mac := hmac.New(sha256.New, sessionSecret)
+	mac.Write([]byte(id))
+	return id + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// verifySessionCookie returns the session id of a cookie value, or false if
+// its signature does not match.
+func verifySessionCookie(value string) (string, bool) {
+	id, _, ok := strings.Cut(value, ".")
+	if !ok || !hmac.Equal([]byte(signSessionID(id)), []byte(value)) {
+		return "", false
+	}
+	return id, true
+}
+
+// currentLoginSession returns the login session of the request cookie, or the
+// one the request started, and extends its idle timeout. It returns nil if
+// there is none.
+func currentLoginSession(r *http.Request) (*loginSession, error) {
+	if session, ok := r.Context().Value(loginSessionContextKey{}).(*loginSession); ok {
+		return session, nil
+	}
+	cookie, err := r.Cookie(sessionCookieName)
+	if err != nil {
+		return nil, nil
+	}
+	id, ok := verifySessionCookie(cookie.Value)
+	if !ok {
+		return nil, nil
+	}
+
+	ctx := r.Context()
+	var session loginSession
+	if err := getJSON(ctx, stateStore, loginSessionKey(id), &session); err != nil {
+		if err == errKeyNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	ttl := session.ttl()
+	if ttl <= 0 {
+		stateStore.Del(ctx, loginSessionKey(id))
+		return nil, nil
+	}
+	if err := setJSON(ctx, stateStore, loginSessionKey(id), session, ttl); err != nil {
+		return nil, err
+	}
+	return &session, nil
+}
+
+// startLoginSession stores a new session for userID and sets its cookie. It
+// returns r with the session, for handling the rest of the request as the
+// user.
+func startLoginSession(w http.ResponseWriter, r *http.Request, userID string) (*http.Request, error) {
+	id, err := randomString(32)
+	if err != nil {
+		return nil, err
+	}
+	session := &loginSession{UserID: userID, AuthTime: time.Now().Unix()}
+	if err := setJSON(r.Context(), stateStore, loginSessionKey(id), session, session.ttl()); err != nil {
+		return nil, err
+	}
+	http.SetCookie(w, &http.Cookie{
+		Name:     sessionCookieName,
+		Value:    signSessionID(id),
+		Path:     "/",
+		MaxAge:   int(sessionAbsoluteTimeout.Seconds()),
+		Secure:   r.TLS != nil || strings.HasPrefix(issuer, "https://"),
+		HttpOnly: true,
+		SameSite: http.SameSiteLaxMode,
+	})
+	return r.WithContext(context.WithValue(r.Context(), loginSessionContextKey{}, session)), nil
+}
+
+// endLoginSession deletes the session of the request cookie and expires the
+// cookie.
+func endLoginSession(w http.ResponseWriter, r *http.Request) error {
+	if cookie, err := r.Cookie(sessionCookieName); err == nil {
+		if id, ok := verifySessionCookie(cookie.Value); ok {
+			if err := stateStore.Del(r.Context(), loginSessionKey(id)); err != nil {
+				return err
+			}
+		}
+	}
+	http.SetCookie(w, &http.Cookie{
+		Name:     sessionCookieName,
+		Path:     "/",
+		MaxAge:   -1,
+		Secure:   r.TLS != nil || strings.HasPrefix(issuer, "https://"),
+		HttpOnly: true,
+		SameSite: http.SameSiteLaxMode,
+	})
+	return nil
+}
+
+// csrfToken returns the token of the CSRF cookie, setting a new cookie when
+// the request has none.
+func csrfToken(w http.ResponseWriter, r *http.Request) (string, error) {
+	if cookie, err := r.Cookie(csrfCookieName); err == nil && cookie.Value != "" {
+		return cookie.Value, nil
+	}
+	token, err := randomString(32)
+	if err != nil {
+		return "", err
+	}
+	http.SetCookie(w, &http.Cookie{
+		Name:     csrfCookieName,
+		Value:    token,
+		Path:     "/",
+		Secure:   r.TLS != nil || strings.HasPrefix(issuer, "https://"),
+		HttpOnly: true,
+		SameSite: http.SameSiteStrictMode,
+	})
+	return token, nil
+}
+
+// validCSRFToken reports whether the csrf_token of the posted form matches
+// the CSRF cookie.
+func validCSRFToken(r *http.Request) bool {
+	cookie, err := r.Cookie(csrfCookieName)
+	if err != nil || cookie.Value == "" {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(cookie.Value), []byte(r.PostFormValue("csrf_token"))) == 1
+}
+
+// authenticateUser checks the password of the user with username against its
+// bcrypt hash and returns the user id.
+func authenticateUser(ctx context.Context, username, password string) (string, error) {
+	users, err := makeProxyUserService(ctx, rbac)(&defaultUserService{}).All(User{
+		Username: username,
+	})
+	if err != nil {
+		return "", err
+	}
+	if len(users) == 0 {
+		return "", errors.New("User not found")
+	}
+	err = bcrypt.CompareHashAndPassword(users[0].Password, []byte(password))
+	if err != nil {
+		return "", errors.New("Invalid password")
+	}
+	return users[0].ID.String(), nil
+}
+
+// resumeURL returns the path and parameters that repeat the request.
+func resumeURL(r *http.Request) string {
+	query := r.URL.Query()
+	for k, v := range r.PostForm {
+		query[k] = v
+	}
+	if len(query) == 0 {
+		return r.URL.Path
+	}
+	return r.URL.Path + "?" + query.Encode()
+}
+
+// userAuthorizationHandler returns the user of an authorization request:
+// the user of the login session, or of an access token this server issued,
+// never of a JWT that was not checked against the token store. Browsers
+// without either are redirected to the login page, and the user is empty.
+func userAuthorizationHandler(w http.ResponseWriter, r *http.Request) (string, error) {
+	session, err := currentLoginSession(r)
+	if err != nil {
+		return "", err
+	}
+	if session != nil {
+		return session.UserID, nil
+	}
+
+	if r.Header.Get("Authorization") == "" {
+		// the request is resumed after the login
+		return "", redirectToLogin(w, r)
+	}
+	ti, err := validateAccessToken(w, r)
+	if err != nil {
+		return "", err
+	}
+	if ti.GetUserID() == "" {
+		return "", errors.ErrInvalidAccessToken
+	}
+	return ti.GetUserID(), nil
+}
+
+// redirectToLogin sends the browser to the login page and keeps the request
+// until the user has logged in: an authorization request with its resolved
+// parameters, as a pushed request_uri can be used only once, and other pages
+// by their path.
+func redirectToLogin(w http.ResponseWriter, r *http.Request) error {
+	if err := r.ParseForm(); err != nil {
+		return err
+	}
+	lr := loginRequest{ReturnTo: resumeURL(r)}
+	if r.URL.Path == "/authorize" {
+		lr = loginRequest{Authorize: r.Form}
+	}
+	id, err := randomString(32)
+	if err != nil {
+		return err
+	}
+	if err := setJSON(r.Context(), stateStore, loginRequestKey(id), lr, loginRequestExp); err != nil {
+		return err
+	}
+	http.Redirect(w, r, "/login?login_id="+url.QueryEscape(id), http.StatusFound)
+	return nil
+}
+
+var loginTemplate = template.Must(template.New("login").Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>Login</title>
+</head>
+<body>
+{{if .Message}}<p>{{.Message}}</p>{{end}}
+<form method="post" action="/login">
+<input type="hidden" name="login_id" value="{{.LoginID}}">
+<input type="hidden" name="csrf_token" value="{{.CSRFToken}}">
+<label>Username <input name="username" value="{{.Username}}" autocomplete="username" required></label>
+<label>Password <input name="password" type="password" autocomplete="current-password" required></label>
+<button>Log in</button>
+</form>
+</body>
+</html>
+`))
+
+type loginPage struct {
+	LoginID   string
+	CSRFToken string
+	Username  string
+	Message   string
+}
+
+func renderLoginPage(w http.ResponseWriter, r *http.Request, statusCode int, page loginPage) {
+	token, err := csrfToken(w, r)
+	if err != nil {
+		errorLogger.Error("[loginHandle]", "error", err.Error())
+		http.Error(w, "Something went wrong, please try again.", http.StatusInternalServerError)
+		return
+	}
+	page.CSRFToken = token
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.Header().Set("Cache-Control", "no-store")
+	w.Header().Set("X-Frame-Options", "DENY")
+	w.WriteHeader(statusCode)
+	if err := loginTemplate.Execute(w, page); err != nil {
+		errorLogger.Error("[loginHandle]", "error", err.Error())
+	}
+}
+
+// loginHandler is the login page. It checks the password of the user, starts
+// a login session and continues with the request that asked for the login,
+// usually the authorization request. The form is only accepted with its CSRF
+// token, so that another site cannot log the browser in to its own account.
+func loginHandler(w http.ResponseWriter, r *http.Request) {
+	loginID := r.FormValue("login_id")
+	if r.Method != http.MethodPost {
+		renderLoginPage(w, r, http.StatusOK, loginPage{LoginID: loginID})
+		return
+	}
+	if !validCSRFToken(r) {
+		logger.Info("[loginHandle]", "msg", "login form without a valid CSRF token")
+		renderLoginPage(w, r, http.StatusForbidden, loginPage{LoginID: loginID, Message: "The login page expired, please try again."})
+		return
+	}
+
+	username := r.PostFormValue("username")
+	userID, err := authenticateUser(r.Context(), username, r.PostFormValue("password"))
+	if err != nil {
+		logger.Info("[loginHandle]", "msg", "login failed", "username", username, "error", err.Error())
+		renderLoginPage(w, r, http.StatusUnauthorized, loginPage{LoginID: loginID, Username: username, Message: "Invalid username or password."})
+		return
+	}
+	var lr loginRequest
+	if loginID != "" {
+		err = getDelJSON(r.Context(), stateStore, loginRequestKey(loginID), &lr)
+		if err == errKeyNotFound {
+			err = nil
+		}
+	}
+	if err == nil {
+		r, err = startLoginSession(w, r, userID)
+	}
+	if err != nil {
+		errorLogger.Error("[loginHandle]", "error", err.Error())
+		renderLoginPage(w, r, http.StatusInternalServerError, loginPage{LoginID: loginID, Username: username, Message: "Something went wrong, please try again."})
+		return
+	}
+
+	logger.Info("[loginHandle]", "msg", "user logged in", "userID", userID)
+	if lr.Authorize != nil {
+		resumeAuthorizeRequest(w, r, lr.Authorize)
+		return
+	}
+	if lr.ReturnTo == "" {
+		lr.ReturnTo = "/"
+	}
+	http.Redirect(w, r, lr.ReturnTo, http.StatusFound)
+}
+
+var logoutTemplate = template.Must(template.New("logout").Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>{{if .CSRFToken}}Log out{{else}}Logged out{{end}}</title>
+</head>
+<body>
+{{if .CSRFToken}}<form method="post" action="/logout">
+<input type="hidden" name="csrf_token" value="{{.CSRFToken}}">
+{{if .ClientID}}<input type="hidden" name="client_id" value="{{.ClientID}}">{{end}}
+{{if .PostLogoutRedirectURI}}<input type="hidden" name="post_logout_redirect_uri" value="{{.PostLogoutRedirectURI}}">{{end}}
+{{if .State}}<input type="hidden" name="state" value="{{.State}}">{{end}}
+<p>Do you want to log out?</p>
+<button>Log out</button>
+</form>{{else}}<p>You have been logged out.</p>{{end}}
+</body>
+</html>
+`))
+
+// logoutPage asks the user to confirm the logout when CSRFToken is set, and
+// otherwise tells that the user has been logged out.
+type logoutPage struct {
+	CSRFToken             string
+	ClientID              string
+	PostLogoutRedirectURI string
+	State                 string
+}
+
+func renderLogoutPage(w http.ResponseWriter, page logoutPage) {
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.Header().Set("Cache-Control", "no-store")
+	w.Header().Set("X-Frame-Options", "DENY")
+	if err := logoutTemplate.Execute(w, page); err != nil {
+		errorLogger.Error("[logoutHandle]", "error", err.Error())
+	}
+}
+
+// logoutHandler ends the login session (OpenID Connect RP-Initiated Logout).
+// The browser is sent back to post_logout_redirect_uri, with the state, if it
+// is one of the post_logout_redirect_uris of the client_id client. Without an
+// id_token_hint of the logged in user, the user confirms the logout with a
+// form carrying a CSRF token, so that other sites cannot log the user out.
+func logoutHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	clientID := r.FormValue("client_id")
+	hinted := false
+	if hint := r.FormValue("id_token_hint"); hint != "" {
+		claims, err := verifyIDTokenHint(hint)
+		if err == nil && clientID != "" && !slices.Contains(claims.Audience, clientID) {
+			err = errors.New("id_token_hint of another client")
+		}
+		if err == nil {
+			var session *loginSession
+			session, err = currentLoginSession(r)
+			if err == nil && session != nil && session.UserID != claims.Subject {
+				err = errors.New("id_token_hint of another user")
+			}
+		}
+		if err != nil {
+			logger.Info("[logoutHandle]", "msg", "invalid id_token_hint", "error", err.Error())
+			http.Error(w, "The ID token hint is invalid.", http.StatusBadRequest)
+			return
+		}
+		if clientID == "" && len(claims.Audience) > 0 {
+			clientID = claims.Audience[0]
+		}
+		hinted = true
+	}
+
+	var redirectURI *url.URL
+	if uri := r.FormValue("post_logout_redirect_uri"); uri != "" {
+		client, err := getOauth2Client(ctx, clientID)
+		if err != nil || !slices.Contains(client.PostLogoutRedirectUris, uri) {
+			http.Error(w, "The post logout redirect URI is invalid.", http.StatusBadRequest)
+			return
+		}
+		redirectURI, err = url.Parse(uri)
+		if err != nil {
+			http.Error(w, "The post logout redirect URI is invalid.", http.StatusBadRequest)
+			return
+		}
+		if state := r.FormValue("state"); state != "" {
+			query := redirectURI.Query()
+			query.Set("state", state)
+			redirectURI.RawQuery = query.Encode()
+		}
+	}
+
+	if !hinted && !(r.Method == http.MethodPost && validCSRFToken(r)) {
+		token, err := csrfToken(w, r)
+		if err != nil {
+			errorLogger.Error("[logoutHandle]", "error", err.Error())
+			http.Error(w, "Something went wrong, please try again.", http.StatusInternalServerError)
+			return
+		}
+		renderLogoutPage(w, logoutPage{
+			CSRFToken:             token,
+			ClientID:              clientID,
+			PostLogoutRedirectURI: r.FormValue("post_logout_redirect_uri"),
+			State:                 r.FormValue("state"),
+		})
+		return
+	}
+
+	if err := endLoginSession(w, r); err != nil {
+		errorLogger.Error("[logoutHandle]", "error", err.Error())
+		http.Error(w, "Something went wrong, please try again.", http.StatusInternalServerError)
+		return
+	}
+	logger.Info("[logoutHandle]", "msg", "user logged out", "clientID", clientID)
+
+	if redirectURI != nil {
+		http.Redirect(w, r, redirectURI.String(), http.StatusFound)
+		return
+	}
+	renderLogoutPage(w, logoutPage{})
+}
@@ -0,0 +1,283 @@
+package main
+
+import (
+	"context"
+	"maps"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/byebyebymyai/oauth2-api/ent"
+)
+
+func TestVerifySessionCookie(t *testing.T) {
+	value := signSessionID("session-id")
+	if id, ok := verifySessionCookie(value); !ok || id != "session-id" {
+		t.Errorf("verifySessionCookie(signed) = %q, %v", id, ok)
+	}
+	for _, value := range []string{"session-id", "session-id.", "other-id" + value[len("session-id"):]} {
+		if _, ok := verifySessionCookie(value); ok {
+			t.Errorf("verifySessionCookie(%q) succeeded", value)
+		}
+	}
+}
+
+func TestLoginHandler(t *testing.T) {
+	hash, err := bcrypt.GenerateFromPassword([]byte("password"), bcrypt.MinCost)
+	if err != nil {
+		t.Fatal(err)
+	}
+	userID := uuid.New()
+	serveTestUser(t, User{ID: &userID, Username: "jane", Password: hash})
+
+	loginID := "login-id"
+	if err := setJSON(context.Background(), stateStore, loginRequestKey(loginID), loginRequest{ReturnTo: "/device?user_code=x"}, loginRequestExp); err != nil {
+		t.Fatal(err)
+	}
+	login := func(password, csrf string) *httptest.ResponseRecorder {
+		form := url.Values{"username": {"jane"}, "password": {password}, "login_id": {loginID}, "csrf_token": {csrf}}
+		r := httptest.NewRequest("POST", "/login", strings.NewReader(form.Encode()))
+		r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		r.AddCookie(&http.Cookie{Name: csrfCookieName, Value: "csrf-token"})
+		w := httptest.NewRecorder()
+		loginHandler(w, r)
+		return w
+	}
+
+	if w := login("wrong", "csrf-token"); w.Code != http.StatusUnauthorized || len(w.Result().Cookies()) != 0 {
+		t.Errorf("wrong password: status = %d, cookies %v", w.Code, w.Result().Cookies())
+	}
+	for _, csrf := range []string{"", "other-token"} {
+		if w := login("password", csrf); w.Code != http.StatusForbidden || len(w.Result().Cookies()) != 0 {
+			t.Errorf("CSRF token %q: status = %d, cookies %v", csrf, w.Code, w.Result().Cookies())
+		}
+	}
+
+	w := login("password", "csrf-token")
+	if w.Code != http.StatusFound || w.Header().Get("Location") != "/device?user_code=x" {
+		t.Fatalf("status = %d, Location %q", w.Code, w.Header().Get("Location"))
+	}
+	r := httptest.NewRequest("GET", "/authorize", nil)
+	for _, c := range w.Result().Cookies() {
+		r.AddCookie(c)
+	}
+	session, err := currentLoginSession(r)
+	if err != nil || session == nil || session.UserID != userID.String() {
+		t.Errorf("currentLoginSession() = %+v, %v", session, err)
+	}
+}
+
+func TestRedirectToLogin(t *testing.T) {
+	r := httptest.NewRequest("GET", "/authorize?client_id=x&state=y", nil)
+	w := httptest.NewRecorder()
+	redirectToLogin(w, r)
+
+	u, err := url.Parse(w.Header().Get("Location"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var lr loginRequest
+	if u.Path != "/login" || getJSON(context.Background(), stateStore, loginRequestKey(u.Query().Get("login_id")), &lr) != nil {
+		t.Fatalf("Location = %q", u)
+	}
+	if lr.ReturnTo != "" || lr.Authorize.Get("state") != "y" {
+		t.Errorf("login request = %+v, want the authorization request", lr)
+	}
+
+	r = httptest.NewRequest("GET", "/device?user_code=x", nil)
+	w = httptest.NewRecorder()
+	redirectToLogin(w, r)
+	u, _ = url.Parse(w.Header().Get("Location"))
+	lr = loginRequest{}
+	if err := getJSON(context.Background(), stateStore, loginRequestKey(u.Query().Get("login_id")), &lr); err != nil || lr.ReturnTo != "/device?user_code=x" {
+		t.Errorf("login request = %+v, %v, want the page", lr, err)
+	}
+}
+
+func TestLoginHandlerAuthorize(t *testing.T) {
+	hash, err := bcrypt.GenerateFromPassword([]byte("password"), bcrypt.MinCost)
+	if err != nil {
+		t.Fatal(err)
+	}
+	userID := uuid.New()
+	serveTestUser(t, User{ID: &userID, Username: "jane", Password: hash})
+	client := addTestClient(&ent.Oauth2Client{Secret: "secret", Domain: "https://app.example.com", RedirectUris: []string{"https://app.example.com/cb"}, FirstParty: true})
+
+	// the pushed request is gone, only the login request keeps the parameters
+	r := httptest.NewRequest("GET", "/authorize", nil)
+	r.Form = url.Values{"client_id": {client.GetID()}, "response_type": {"code"}, "state": {"xyz"}}
+	w := httptest.NewRecorder()
+	if _, err := userAuthorizationHandler(w, r); err != nil {
+		t.Fatal(err)
+	}
+	u, _ := url.Parse(w.Header().Get("Location"))
+	loginID := u.Query().Get("login_id")
+
+	login := func() *httptest.ResponseRecorder {
+		form := url.Values{"username": {"jane"}, "password": {"password"}, "login_id": {loginID}, "csrf_token": {"csrf-token"}}
+		r := httptest.NewRequest("POST", "/login", strings.NewReader(form.Encode()))
+		r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		r.AddCookie(&http.Cookie{Name: csrfCookieName, Value: "csrf-token"})
+		w := httptest.NewRecorder()
+		loginHandler(w, r)
+		return w
+	}
+	w = login()
+	u, err = url.Parse(w.Header().Get("Location"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if w.Code != http.StatusFound || u.Host != "app.example.com" || u.Query().Get("code") == "" || u.Query().Get("state") != "xyz" {
+		t.Fatalf("status = %d, Location %q, want the code", w.Code, u)
+	}
+	if w := login(); w.Header().Get("Location") != "/" {
+		t.Errorf("login request used twice: Location = %q", w.Header().Get("Location"))
+	}
+}
+
+func TestLoginPageCSRFToken(t *testing.T) {
+	w := httptest.NewRecorder()
+	loginHandler(w, httptest.NewRequest("GET", "/login?login_id=x", nil))
+	cookies := w.Result().Cookies()
+	if w.Code != http.StatusOK || len(cookies) != 1 || cookies[0].Name != csrfCookieName || !cookies[0].HttpOnly {
+		t.Fatalf("status = %d, cookies %v", w.Code, cookies)
+	}
+	if !strings.Contains(w.Body.String(), `name="csrf_token" value="`+cookies[0].Value+`"`) {
+		t.Errorf("login page without the CSRF token: %s", w.Body)
+	}
+}
+
+func TestLogoutHandler(t *testing.T) {
+	client := addTestClient(&ent.Oauth2Client{PostLogoutRedirectUris: []string{"https://app.example.com/logged-out"}})
+	userID := uuid.NewString()
+	idToken := func(sub, aud string) string {
+		token, err := signingKeys.Active().Sign(jwt.MapClaims{
+			"iss": issuer,
+			"sub": sub,
+			"aud": aud,
+			// an expired ID token is still a valid hint
+			"exp": time.Now().Add(-time.Hour).Unix(),
+		}, "JWT")
+		if err != nil {
+			t.Fatal(err)
+		}
+		return token
+	}
+	forged, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{"iss": issuer, "sub": userID, "aud": client.GetID()}).SignedString([]byte("key"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	redirect := url.Values{"client_id": {client.GetID()}, "post_logout_redirect_uri": {"https://app.example.com/logged-out"}, "state": {"xyz"}}
+	with := func(params url.Values, k, v string) url.Values {
+		params = maps.Clone(params)
+		params.Set(k, v)
+		return params
+	}
+
+	tests := []struct {
+		name   string
+		method string
+		params url.Values
+		csrf   string
+		// status is the response status; the user is logged out on 302.
+		status int
+	}{
+		{name: "confirmation", method: "GET", params: redirect, status: http.StatusOK},
+		{name: "POST without CSRF token", method: "POST", params: redirect, status: http.StatusOK},
+		{name: "POST with wrong CSRF token", method: "POST", params: redirect, csrf: "other-token", status: http.StatusOK},
+		{name: "confirmed", method: "POST", params: redirect, csrf: "csrf-token", status: http.StatusFound},
+		{name: "id_token_hint", method: "GET", params: with(redirect, "id_token_hint", idToken(userID, client.GetID())), status: http.StatusFound},
+		{name: "id_token_hint without client_id", method: "GET", params: url.Values{"id_token_hint": {idToken(userID, client.GetID())}, "post_logout_redirect_uri": {"https://app.example.com/logged-out"}, "state": {"xyz"}}, status: http.StatusFound},
+		{name: "id_token_hint of another user", method: "GET", params: with(redirect, "id_token_hint", idToken(uuid.NewString(), client.GetID())), status: http.StatusBadRequest},
+		{name: "id_token_hint of another client", method: "GET", params: with(redirect, "id_token_hint", idToken(userID, uuid.NewString())), status: http.StatusBadRequest},
+		{name: "forged id_token_hint", method: "GET", params: with(redirect, "id_token_hint", forged), status: http.StatusBadRequest},
+		{name: "unregistered redirect URI", method: "POST", params: with(redirect, "post_logout_redirect_uri", "https://evil.example.com/"), csrf: "csrf-token", status: http.StatusBadRequest},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			login := httptest.NewRecorder()
+			if _, err := startLoginSession(login, httptest.NewRequest("POST", "/login", nil), userID); err != nil {
+				t.Fatal(err)
+			}
+			withCookies := func(r *http.Request) *http.Request {
+				for _, c := range login.Result().Cookies() {
+					r.AddCookie(c)
+				}
+				r.AddCookie(&http.Cookie{Name: csrfCookieName, Value: "csrf-token"})
+				return r
+			}
+
+			var r *http.Request
+			if tt.method == "GET" {
+				r = httptest.NewRequest("GET", "/logout?"+tt.params.Encode(), nil)
+			} else {
+				params := maps.Clone(tt.params)
+				if tt.csrf != "" {
+					params.Set("csrf_token", tt.csrf)
+				}
+				r = httptest.NewRequest("POST", "/logout", strings.NewReader(params.Encode()))
+				r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+			}
+			w := httptest.NewRecorder()
+			logoutHandler(w, withCookies(r))
+			if w.Code != tt.status {
+				t.Fatalf("status = %d, want %d, body %s", w.Code, tt.status, w.Body)
+			}
+			if w.Code == http.StatusFound && w.Header().Get("Location") != "https://app.example.com/logged-out?state=xyz" {
+				t.Errorf("Location = %q", w.Header().Get("Location"))
+			}
+			if w.Code == http.StatusOK && !strings.Contains(w.Body.String(), `name="csrf_token" value="csrf-token"`) {
+				t.Errorf("confirmation page without the CSRF token: %s", w.Body)
+			}
+
+			session, err := currentLoginSession(withCookies(httptest.NewRequest("GET", "/authorize", nil)))
+			if err != nil || (session == nil) != (tt.status == http.StatusFound) {
+				t.Errorf("currentLoginSession() after logout = %+v, %v", session, err)
+			}
+		})
+	}
+}
+
+func TestUserAuthorizationHandler(t *testing.T) {
+	client := addTestClient(&ent.Oauth2Client{})
+	ti := addTestToken(t, client, uuid.NewString(), "")
+	forged, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{"sub": uuid.NewString()}).SignedString([]byte("key"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		auth    string
+		want    string
+		wantErr bool
+	}{
+		{name: "access token", auth: "Bearer " + ti.Access, want: ti.UserID},
+		{name: "unknown token", auth: "Bearer unknown", wantErr: true},
+		{name: "unverified JWT", auth: "Bearer " + forged, wantErr: true},
+		{name: "login redirect"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest("GET", "/authorize?client_id=x", nil)
+			if tt.auth != "" {
+				r.Header.Set("Authorization", tt.auth)
+			}
+			w := httptest.NewRecorder()
+			got, err := userAuthorizationHandler(w, r)
+			if (err != nil) != tt.wantErr || got != tt.want {
+				t.Errorf("userAuthorizationHandler() = %q, %v, want %q", got, err, tt.want)
+			}
+			if tt.auth == "" && w.Code != http.StatusFound {
+				t.Errorf("status = %d, want a redirect to the login page", w.Code)
+			}
+		})
+	}
+}
@@ -2,22 +2,24 @@ package main
 
 import (
 	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
 	"fmt"
 	"log/slog"
 	"net/http"
 	"os"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/felixge/httpsnoop"
 	"github.com/go-oauth2/oauth2/v4/errors"
+	"github.com/go-oauth2/oauth2/v4/generates"
 	"github.com/go-oauth2/oauth2/v4/manage"
 	"github.com/go-oauth2/oauth2/v4/server"
 	"github.com/go-oauth2/oauth2/v4/store"
 	redisStore "github.com/go-oauth2/redis/v4"
-	"golang.org/x/crypto/bcrypt"
-
-	"github.com/golang-jwt/jwt/v5"
 
 	"github.com/go-redis/redis/v8"
 	_ "github.com/go-sql-driver/mysql"
@@ -29,6 +31,29 @@ import (
 var jose string
 var rbac string
 
+var issuer string
+var jwtKeyFile string
+var jwtKeysDir string
+
+var tlsCertFile string
+var tlsKeyFile string
+var tlsClientCAFile string
+
+var dpopNonceRequired bool
+
+var sessionSecret []byte
+var sessionIdleTimeout time.Duration
+var sessionAbsoluteTimeout time.Duration
+
+var consentExpiration time.Duration
+
+var tokenClaimsMaxSize int
+
+var clientSecretKey []byte
+
+var registrationInitialAccessTokens []string
+var softwareStatementIssuers map[string]string
+
 var dsn string
 
 var redisOptions *redis.Options
@@ -41,6 +66,13 @@ var errorLogger *slog.Logger
 // oauth2
 var srv *server.Server
 
+var signingKeys *keySet
+var keyService KeyService
+
+var stateStore KVStore
+
+var entClient *ent.Client
+
 func main() {
 	logHandler := slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{
 		Level: slog.LevelDebug,
@@ -61,6 +93,9 @@ func main() {
 	if err := client.Schema.Create(context.Background(), migrate.WithGlobalUniqueID(true)); err != nil {
 		logger.Error("[main]", "msg", "failed creating schema resources", "err", err)
 	}
+	if err := migrateClientSecrets(context.Background(), client); err != nil {
+		logger.Error("[main]", "msg", "failed hashing client secrets", "err", err)
+	}
 
 	if os.Getenv("REDIS_ENABLED") == "true" {
 		redisClient = redis.NewClient(redisOptions)
@@ -74,26 +109,73 @@ func main() {
 
 	mux := http.NewServeMux()
 
-	mux.HandleFunc("/authorize", loggerMiddleware(func(w http.ResponseWriter, r *http.Request) {
-		err := srv.HandleAuthorizeRequest(w, r)
-		if err != nil {
-			errorLogger.Error("[authorizeHandle]", "error", err.Error())
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		}
-	}))
+	mux.HandleFunc("/authorize", loggerMiddleware(authorizeHandler))
 
-	mux.HandleFunc("/token", loggerMiddleware(func(w http.ResponseWriter, r *http.Request) {
-		err := srv.HandleTokenRequest(w, r)
-		if err != nil {
-			errorLogger.Error("[tokenHandle]", "error", err.Error())
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-	}))
+	mux.HandleFunc("/login", loggerMiddleware(loginHandler))
+
+	mux.HandleFunc("/logout", loggerMiddleware(logoutHandler))
+
+	mux.HandleFunc("/consent", loggerMiddleware(consentHandler))
+
+	mux.HandleFunc("GET /consents", loggerMiddleware(consentsHandler))
+
+	mux.HandleFunc("DELETE /consents/{id}", loggerMiddleware(revokeConsentHandler))
+
+	mux.HandleFunc("POST /par", loggerMiddleware(parHandler))
+
+	mux.HandleFunc("/token", loggerMiddleware(tokenHandler))
+
+	mux.HandleFunc("POST /device_authorization", loggerMiddleware(deviceAuthorizationHandler))
+
+	mux.HandleFunc("/device", loggerMiddleware(deviceHandler))
+
+	mux.HandleFunc("POST /register", loggerMiddleware(registerHandler))
+
+	mux.HandleFunc("GET /register/{client_id}", loggerMiddleware(registrationHandler))
+
+	mux.HandleFunc("PUT /register/{client_id}", loggerMiddleware(registrationHandler))
+
+	mux.HandleFunc("DELETE /register/{client_id}", loggerMiddleware(registrationHandler))
+
+	mux.HandleFunc("GET /register/{client_id}/secrets", loggerMiddleware(clientSecretsHandler))
+
+	mux.HandleFunc("POST /register/{client_id}/secrets", loggerMiddleware(clientSecretsHandler))
+
+	mux.HandleFunc("DELETE /register/{client_id}/secrets/{id}", loggerMiddleware(revokeClientSecretHandler))
+
+	mux.HandleFunc("POST /introspect", loggerMiddleware(introspectHandler))
+
+	mux.HandleFunc("POST /revoke", loggerMiddleware(revokeHandler))
+
+	mux.HandleFunc("GET /userinfo", loggerMiddleware(userinfoHandler))
+
+	mux.HandleFunc("POST /userinfo", loggerMiddleware(userinfoHandler))
+
+	mux.HandleFunc("GET /forward-auth", loggerMiddleware(forwardAuthHandler))
+
+	mux.HandleFunc("GET /.well-known/jwks.json", loggerMiddleware(jwksHandler))
+
+	mux.HandleFunc("GET /.well-known/oauth-authorization-server", loggerMiddleware(authorizationServerMetadataHandler))
+
+	mux.HandleFunc("GET /.well-known/openid-configuration", loggerMiddleware(openIDConfigurationHandler))
 
 	mux.HandleFunc("GET /health", func(w http.ResponseWriter, r *http.Request) {
 		w.WriteHeader(http.StatusOK)
 	})
 
+	if tlsCertFile != "" {
+		tlsServer, err := newTLSServer(":"+tlsPort, mux, tlsClientCAFile)
+		if err != nil {
+			panic(err)
+		}
+		go func() {
+			logger.Info("[main]", "message", "starting TLS server", "port", tlsPort)
+			if err := tlsServer.ListenAndServeTLS(tlsCertFile, tlsKeyFile); err != nil {
+				errorLogger.Error("[main]", "msg", "TLS server stopped", "error", err.Error())
+			}
+		}()
+	}
+
 	logger.Info("[main]", "message", "starting server", "port", 8080)
 	http.ListenAndServe(":8080", mux)
 
@@ -107,11 +189,70 @@ func loggerMiddleware(next func(w http.ResponseWriter, r *http.Request)) http.Ha
 	}
 }
 
+// tokenError writes err as an OAuth2 error response, the same way the server
+// does for errors raised inside HandleTokenRequest.
+func tokenError(w http.ResponseWriter, err error) {
+	data, statusCode, header := srv.GetErrorData(err)
+	writeJSON(w, data, header, statusCode)
+}
+
+// writeJSON writes data as a non-cacheable JSON response.
+func writeJSON(w http.ResponseWriter, data interface{}, header http.Header, statusCode int) {
+	w.Header().Set("Content-Type", "application/json;charset=UTF-8")
+	w.Header().Set("Cache-Control", "no-store")
+	w.Header().Set("Pragma", "no-cache")
+	for key := range header {
+		w.Header().Set(key, header.Get(key))
+	}
+	w.WriteHeader(statusCode)
+	if err := json.NewEncoder(w).Encode(data); err != nil {
+		errorLogger.Error("[writeJSON]", "error", err.Error())
+	}
+}
+
 func init() {
 
 	jose = os.Getenv("JOSE_URL")
 	rbac = os.Getenv("RBAC_URL")
 
+	issuer = strings.TrimSuffix(os.Getenv("ISSUER_URL"), "/")
+	jwtKeyFile = os.Getenv("JWT_KEY_FILE")
+	jwtKeysDir = os.Getenv("JWT_KEYS_DIR")
+
+	tlsCertFile = os.Getenv("TLS_CERT_FILE")
+	tlsKeyFile = os.Getenv("TLS_KEY_FILE")
+	tlsClientCAFile = os.Getenv("TLS_CLIENT_CA_FILE")
+
+	dpopNonceRequired = os.Getenv("DPOP_NONCE_REQUIRED") == "true"
+
+	sessionSecret = []byte(os.Getenv("SESSION_SECRET"))
+	sessionIdleTimeout = durationEnv("SESSION_IDLE_TIMEOUT", 30*time.Minute)
+	sessionAbsoluteTimeout = durationEnv("SESSION_ABSOLUTE_TIMEOUT", 12*time.Hour)
+
+	consentExpiration = durationEnv("CONSENT_EXPIRATION", 0)
+
+	tokenClaimsMaxSize = intEnv("TOKEN_CLAIMS_MAX_SIZE", 4096)
+
+	if v := os.Getenv("CLIENT_SECRET_KEY"); v != "" {
+		key, err := base64.StdEncoding.DecodeString(v)
+		if err != nil || len(key) != 32 {
+			panic("CLIENT_SECRET_KEY must be 32 bytes encoded in base64")
+		}
+		clientSecretKey = key
+	}
+
+	for _, t := range strings.Split(os.Getenv("REGISTRATION_INITIAL_ACCESS_TOKENS"), ",") {
+		if t = strings.TrimSpace(t); t != "" {
+			registrationInitialAccessTokens = append(registrationInitialAccessTokens, t)
+		}
+	}
+	softwareStatementIssuers = make(map[string]string)
+	for _, pair := range strings.Split(os.Getenv("SOFTWARE_STATEMENT_ISSUERS"), ",") {
+		if iss, jwksURI, ok := strings.Cut(strings.TrimSpace(pair), "="); ok {
+			softwareStatementIssuers[iss] = jwksURI
+		}
+	}
+
 	username := os.Getenv("DB_USER")
 	password := os.Getenv("DB_PASS")
 	hostname := os.Getenv("DB_HOST")
@@ -129,11 +270,82 @@ func init() {
 
 }
 
+// durationEnv parses the environment variable name as a time.Duration, or
+// returns def when it is not set.
+func durationEnv(name string, def time.Duration) time.Duration {
+	v := os.Getenv(name)
+	if v == "" {
+		return def
+	}
+	d, err := time.ParseDuration(v)
+	if err != nil {
+		panic(fmt.Sprintf("%s: %s", name, err))
+	}
+	return d
+}
+
+// intEnv parses the environment variable name as an int, or returns def when
+// it is not set.
+func intEnv(name string, def int) int {
+	v := os.Getenv(name)
+	if v == "" {
+		return def
+	}
+	i, err := strconv.Atoi(v)
+	if err != nil {
+		panic(fmt.Sprintf("%s: %s", name, err))
+	}
+	return i
+}
+
 func initOAuth2(ctx context.Context, client *ent.Client) {
+	// the issuer of the tokens and of the metadata, which must not depend on
+	// the Host of a request
+	if issuer == "" {
+		panic("ISSUER_URL is not set")
+	}
+
 	// token store
 	manager := manage.NewDefaultManager()
 	manager.SetAuthorizeCodeTokenCfg(manage.DefaultAuthorizeCodeTokenCfg)
-	manager.MapAccessGenerate(makeProxyTokenService(ctx, jose)(&defaultTokenService{}))
+	// rotate the refresh token on every use, see trackRefreshToken
+	manager.SetRefreshTokenCfg(manage.DefaultRefreshTokenCfg)
+	manager.MapAuthorizeGenerate(sessionAuthorizeGenerate{generates.NewAuthorizeGenerate()})
+	// authorizeHandler checks the redirect URI against the client, and the
+	// code is bound to it
+	manager.SetValidateURIHandler(func(baseURI, redirectURI string) error { return nil })
+
+	// signing keys of the built-in token generator
+	keys, err := loadSigningKeys(jwtKeyFile, jwtKeysDir)
+	if err != nil {
+		panic(err)
+	}
+	if len(keys) == 0 {
+		logger.Warn("[initOAuth2]", "msg", "no signing key configured, using an ephemeral key")
+		key, err := generateSigningKey()
+		if err != nil {
+			panic(err)
+		}
+		keys = append(keys, key)
+	}
+	if len(sessionSecret) == 0 {
+		logger.Warn("[initOAuth2]", "msg", "no session secret configured, login sessions end with a restart")
+		secret := make([]byte, 32)
+		if _, err := rand.Read(secret); err != nil {
+			panic(err)
+		}
+		sessionSecret = secret
+	}
+
+	// retired keys stay published for the lifetime of the tokens they signed
+	signingKeys = newKeySet(jwtKeyFile, jwtKeysDir, manage.DefaultAuthorizeCodeTokenCfg.AccessTokenExp, keys)
+	go signingKeys.Watch(ctx, time.Minute)
+
+	manager.MapAccessGenerate(scopedAccessGenerate{makeProxyTokenService(ctx, jose)(&defaultTokenService{
+		issuer: issuer,
+		keys:   signingKeys,
+	})})
+	keyService = makeProxyKeyService(ctx, jose)(&defaultKeyService{signingKeys})
 
 	if os.Getenv("REDIS_ENABLED") == "true" {
 		// token redis store
@@ -143,18 +355,30 @@ func initOAuth2(ctx context.Context, client *ent.Client) {
 		manager.MustTokenStorage(store.NewMemoryTokenStore())
 	}
 
+	// state store for nonces, device codes, replay caches...
+	stateStore, err = newKVStore()
+	if err != nil {
+		panic(err)
+	}
+
 	// init client store
+	entClient = client
 	manager.MapClientStorage(&ClientStorage{
 		ctx:    ctx,
 		client: client,
 	})
 
 	// oauth2 server setting
-	srv = server.NewServer(server.NewConfig(), manager)
-	// check the client allows to use this authorization grant type
+	cfg := server.NewConfig()
+	cfg.AllowedGrantTypes = append(cfg.AllowedGrantTypes, deviceCodeGrantType, tokenExchangeGrantType, jwtBearerGrantType)
+	srv = server.NewServer(cfg, manager)
 	srv.SetAllowGetAccessRequest(true)
+	// check the client allows to use this authorization grant type and scope
+	srv.SetClientAuthorizedHandler(clientAuthorizedHandler)
+	srv.SetClientScopeHandler(clientScopeHandler)
+	srv.SetRefreshingScopeHandler(refreshingScopeHandler)
 	// get client info from request
-	srv.SetClientInfoHandler(server.ClientFormHandler)
+	srv.SetClientInfoHandler(clientInfoHandler)
 
 	srv.SetInternalErrorHandler(func(err error) (re *errors.Response) {
 		errorLogger.Error("[internalError]", "error", err.Error())
@@ -166,51 +390,9 @@ func initOAuth2(ctx context.Context, client *ent.Client) {
 	})
 
 	srv.SetPasswordAuthorizationHandler(func(ctx context.Context, clientID, username, password string) (userID string, err error) {
-		users, err := makeProxyUserService(ctx, rbac)(&defaultUserService{}).All(User{
-			Username: username,
-		})
-		if err != nil {
-			return "", err
-		}
-		if len(users) == 0 {
-			return "", errors.New("User not found")
-		}
-		err = bcrypt.CompareHashAndPassword(users[0].Password, []byte(password))
-		if err != nil {
-			return "", errors.New("Invalid password")
-		}
-		return users[0].ID.String(), nil
+		return authenticateUser(ctx, username, password)
 	})
 
 	// get user id from request authorization
-	srv.SetUserAuthorizationHandler(func(w http.ResponseWriter, r *http.Request) (userID string, err error) {
-		authHeader := r.Header.Get("Authorization")
-		if authHeader == "" {
-			return "", errors.New("Authorization header is missing")
-		}
-
-		tokenParts := strings.Split(authHeader, " ")
-		if len(tokenParts) != 2 || tokenParts[0] != "Bearer" {
-			return "", errors.New("Invalid authorization header format")
-		}
-
-		token, _, err := new(jwt.Parser).ParseUnverified(tokenParts[1], jwt.MapClaims{})
-		if err != nil {
-			return "", errors.New("Invalid token")
-		}
-
-		claims, ok := token.Claims.(jwt.MapClaims)
-		if !ok {
-			return "", errors.New("Invalid token claims")
-		}
-
-		userID, ok = claims["sub"].(string)
-		if !ok {
-			return "", errors.New("Sub claim is missing in token")
-		}
-		if userID == "" {
-			return "", errors.New("UserID is empty")
-		}
-		return userID, nil
-	})
+	srv.SetUserAuthorizationHandler(userAuthorizationHandler)
 }
@@ -0,0 +1,121 @@
+package main
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"os"
+	"testing"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"github.com/go-oauth2/oauth2/v4"
+	"github.com/go-oauth2/oauth2/v4/errors"
+	"github.com/go-oauth2/oauth2/v4/generates"
+	"github.com/go-oauth2/oauth2/v4/manage"
+	"github.com/go-oauth2/oauth2/v4/server"
+	"github.com/go-oauth2/oauth2/v4/store"
+	"github.com/google/uuid"
+	_ "github.com/mattn/go-sqlite3"
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/byebyebymyai/oauth2-api/ent"
+)
+
+// testClients are the clients of the test server, in place of the database.
+var testClients = testClientStore{}
+
+type testClientStore map[string]*ent.Oauth2Client
+
+func (s testClientStore) GetByID(ctx context.Context, id string) (oauth2.ClientInfo, error) {
+	client, ok := s[id]
+	if !ok {
+		return nil, errors.ErrInvalidClient
+	}
+	if authenticatedClientFromContext(ctx) == id {
+		return authenticatedClient{client}, nil
+	}
+	return client, nil
+}
+
+// testTokens is the token store of the test server.
+var testTokens oauth2.TokenStore
+
+// addTestClient gives client a new id and adds it to testClients. A secret
+// is hashed, and encrypted for client_secret_jwt, like migrateClientSecrets
+// does.
+func addTestClient(client *ent.Oauth2Client) *ent.Oauth2Client {
+	client.ID = uuid.New()
+	if client.Secret != "" && hashedSecretMethod(client.TokenEndpointAuthMethod) {
+		hash, err := bcrypt.GenerateFromPassword([]byte(client.Secret), bcrypt.MinCost)
+		if err != nil {
+			panic(err)
+		}
+		secret := &ent.ClientSecret{Hash: string(hash)}
+		if encryptedSecretMethod(client.TokenEndpointAuthMethod) {
+			if secret.Ciphertext, err = encryptClientSecret(client.Secret); err != nil {
+				panic(err)
+			}
+		}
+		client.Secret = ""
+		if client.TokenEndpointAuthMethod == "" {
+			client.TokenEndpointAuthMethod = "client_secret_basic"
+		}
+		client.Edges.Secrets = []*ent.ClientSecret{secret}
+	}
+	testClients[client.GetID()] = client
+	return client
+}
+
+// TestMain sets up the server like initOAuth2, with the tokens in memory and
+// the clients from testClients.
+func TestMain(m *testing.M) {
+	logger = slog.New(slog.NewTextHandler(io.Discard, nil))
+	errorLogger = logger
+	issuer = "https://as.example.com"
+
+	key, err := generateSigningKey()
+	if err != nil {
+		panic(err)
+	}
+	signingKeys = newKeySet("", "", 0, []*signingKey{key})
+
+	manager := manage.NewDefaultManager()
+	manager.SetAuthorizeCodeTokenCfg(manage.DefaultAuthorizeCodeTokenCfg)
+	manager.MapAuthorizeGenerate(sessionAuthorizeGenerate{generates.NewAuthorizeGenerate()})
+	manager.SetValidateURIHandler(func(baseURI, redirectURI string) error { return nil })
+	manager.MapAccessGenerate(scopedAccessGenerate{&defaultTokenService{issuer: issuer, keys: signingKeys}})
+	testTokens, _ = store.NewMemoryTokenStore()
+	manager.MapTokenStorage(testTokens)
+	manager.MapClientStorage(testClients)
+
+	sessionSecret = []byte("session secret")
+	clientSecretKey = []byte("client secret key of 32 bytes...")
+	sessionIdleTimeout = 30 * time.Minute
+	sessionAbsoluteTimeout = 12 * time.Hour
+
+	// the database in memory, for the scopes and consents
+	entClient, err = ent.Open(dialect.SQLite, "file:ent?mode=memory&cache=shared&_fk=1")
+	if err != nil {
+		panic(err)
+	}
+	if err := entClient.Schema.Create(context.Background()); err != nil {
+		panic(err)
+	}
+
+	stateStore, err = newKVStore()
+	if err != nil {
+		panic(err)
+	}
+
+	cfg := server.NewConfig()
+	cfg.AllowedGrantTypes = append(cfg.AllowedGrantTypes, deviceCodeGrantType, tokenExchangeGrantType, jwtBearerGrantType)
+	srv = server.NewServer(cfg, manager)
+	srv.SetClientAuthorizedHandler(clientAuthorizedHandler)
+	srv.SetClientScopeHandler(clientScopeHandler)
+	srv.SetRefreshingScopeHandler(refreshingScopeHandler)
+	srv.SetClientInfoHandler(clientInfoHandler)
+	srv.SetUserAuthorizationHandler(userAuthorizationHandler)
+
+	os.Exit(m.Run())
+}
@@ -0,0 +1,35 @@
+package middleware
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/byebyebymyai/oauth2-api/endpoint"
+)
+
+// CachingMiddleware caches the last successful response of an endpoint for
+// ttl. The request is ignored, so it only suits endpoints without arguments.
+func CachingMiddleware(ttl time.Duration) endpoint.Middleware {
+	return func(next endpoint.Endpoint) endpoint.Endpoint {
+		var (
+			mu       sync.Mutex
+			response interface{}
+			expires  time.Time
+		)
+		return func(ctx context.Context, request interface{}) (interface{}, error) {
+			mu.Lock()
+			defer mu.Unlock()
+			if response != nil && time.Now().Before(expires) {
+				return response, nil
+			}
+
+			res, err := next(ctx, request)
+			if err != nil {
+				return nil, err
+			}
+			response, expires = res, time.Now().Add(ttl)
+			return response, nil
+		}
+	}
+}
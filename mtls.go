@@ -0,0 +1,143 @@
+package main
+
+import (
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"slices"
+	"time"
+
+	"github.com/go-oauth2/oauth2/v4/errors"
+
+	"github.com/byebyebymyai/oauth2-api/ent"
+)
+
+// clientCAs verifies the client certificates of tls_client_auth.
+var clientCAs *x509.CertPool
+
+// tlsPort is the port of the TLS listener.
+const tlsPort = "8443"
+
+// mtlsEnabled reports whether the TLS listener runs with client CAs, so that
+// clients can authenticate and bind tokens with certificates.
+func mtlsEnabled() bool {
+	return tlsCertFile != "" && tlsClientCAFile != ""
+}
+
+// mtlsBaseURL returns the issuer URL on the TLS listener, the base of the
+// mutual TLS endpoint aliases (RFC 8705).
+func mtlsBaseURL() string {
+	u, err := url.Parse(issuer)
+	if err != nil {
+		return issuer
+	}
+	u.Scheme = "https"
+	u.Host = net.JoinHostPort(u.Hostname(), tlsPort)
+	return u.String()
+}
+
+// newTLSServer returns a server that asks for, but does not require, client
+// certificates. They are verified per client by verifyClientCertificate, as
+// self-signed certificates are accepted too.
+func newTLSServer(addr string, handler http.Handler, clientCAFile string) (*http.Server, error) {
+	if clientCAFile != "" {
+		pem, err := os.ReadFile(clientCAFile)
+		if err != nil {
+			return nil, err
+		}
+		clientCAs = x509.NewCertPool()
+		if !clientCAs.AppendCertsFromPEM(pem) {
+			return nil, errors.New("no certificate found in " + clientCAFile)
+		}
+	}
+	return &http.Server{
+		Addr:    addr,
+		Handler: handler,
+		TLSConfig: &tls.Config{
+			MinVersion: tls.VersionTLS12,
+			ClientAuth: tls.RequestClientCert,
+		},
+	}, nil
+}
+
+// clientCertificate returns the certificate the client presented on the TLS
+// connection, or nil.
+func clientCertificate(r *http.Request) *x509.Certificate {
+	if r.TLS == nil || len(r.TLS.PeerCertificates) == 0 {
+		return nil
+	}
+	return r.TLS.PeerCertificates[0]
+}
+
+// certificateThumbprint returns the x5t#S256 confirmation of a certificate
+// (RFC 8705).
+func certificateThumbprint(cert *x509.Certificate) string {
+	sum := sha256.Sum256(cert.Raw)
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+// verifyClientCertificate authenticates a client by its TLS certificate:
+// with tls_client_auth the certificate must chain to a client CA and carry
+// the registered subject DN, with self_signed_tls_client_auth its public key
+// must be one of the registered keys.
+func verifyClientCertificate(r *http.Request, client *ent.Oauth2Client) error {
+	cert := clientCertificate(r)
+	if cert == nil {
+		return errors.ErrInvalidClient
+	}
+
+	switch client.TokenEndpointAuthMethod {
+	case "tls_client_auth":
+		if clientCAs == nil || client.TLSClientAuthSubjectDn == "" {
+			return errors.ErrInvalidClient
+		}
+		intermediates := x509.NewCertPool()
+		for _, c := range r.TLS.PeerCertificates[1:] {
+			intermediates.AddCert(c)
+		}
+		if _, err := cert.Verify(x509.VerifyOptions{
+			Roots:         clientCAs,
+			Intermediates: intermediates,
+			KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		}); err != nil {
+			errorLogger.Error("[verifyClientCertificate]", "error", err.Error(), "clientID", client.ID)
+			return errors.ErrInvalidClient
+		}
+		if cert.Subject.String() != client.TLSClientAuthSubjectDn {
+			errorLogger.Error("[verifyClientCertificate]", "error", "subject DN mismatch", "clientID", client.ID, "subject", cert.Subject.String())
+			return errors.ErrInvalidClient
+		}
+	case "self_signed_tls_client_auth":
+		now := time.Now()
+		if now.Before(cert.NotBefore) || now.After(cert.NotAfter) {
+			return errors.ErrInvalidClient
+		}
+		keys, err := clientKeys(r.Context(), client)
+		if err != nil {
+			errorLogger.Error("[verifyClientCertificate]", "error", err.Error(), "clientID", client.ID)
+			return errors.ErrInvalidClient
+		}
+		jwk, err := newJSONWebKey(cert.PublicKey)
+		if err != nil {
+			return errors.ErrInvalidClient
+		}
+		thumbprint, err := jwk.Thumbprint()
+		if err != nil {
+			return errors.ErrInvalidClient
+		}
+		if !slices.ContainsFunc(keys.Keys, func(k *JSONWebKey) bool {
+			t, err := k.Thumbprint()
+			return err == nil && t == thumbprint
+		}) {
+			return errors.ErrInvalidClient
+		}
+	default:
+		return errors.ErrInvalidClient
+	}
+	return nil
+}
@@ -0,0 +1,198 @@
+package main
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-oauth2/oauth2/v4"
+	"github.com/golang-jwt/jwt/v5"
+
+	"github.com/byebyebymyai/oauth2-api/ent"
+)
+
+// newTestCertificate creates a client certificate for cn, signed by parent
+// or self-signed when parent is nil.
+func newTestCertificate(t *testing.T, cn string, isCA bool, parent *x509.Certificate, parentKey *ecdsa.PrivateKey) (*x509.Certificate, *ecdsa.PrivateKey) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(time.Now().UnixNano()),
+		Subject:               pkix.Name{CommonName: cn},
+		NotBefore:             time.Now().Add(-time.Minute),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  isCA,
+	}
+	if parent == nil {
+		parent, parentKey = template, key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, key.Public(), parentKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return cert, key
+}
+
+// withTestCertificate makes r look like it was sent over mutual TLS.
+func withTestCertificate(r *http.Request, certs ...*x509.Certificate) *http.Request {
+	r.TLS = &tls.ConnectionState{PeerCertificates: certs}
+	return r
+}
+
+// certificateJWKS returns the JWKS of the public key of cert.
+func certificateJWKS(t *testing.T, cert *x509.Certificate) json.RawMessage {
+	t.Helper()
+	jwk, err := newJSONWebKey(cert.PublicKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	jwks, err := json.Marshal(JSONWebKeySet{Keys: []*JSONWebKey{jwk}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return jwks
+}
+
+func TestVerifyClientCertificate(t *testing.T) {
+	ca, caKey := newTestCertificate(t, "Test CA", true, nil, nil)
+	leaf, _ := newTestCertificate(t, "client", false, ca, caKey)
+	selfSigned, _ := newTestCertificate(t, "device", false, nil, nil)
+	otherSelfSigned, _ := newTestCertificate(t, "device", false, nil, nil)
+
+	clientCAs = x509.NewCertPool()
+	clientCAs.AddCert(ca)
+	t.Cleanup(func() { clientCAs = nil })
+
+	pki := &ent.Oauth2Client{TokenEndpointAuthMethod: "tls_client_auth", TLSClientAuthSubjectDn: "CN=client"}
+	otherDN := &ent.Oauth2Client{TokenEndpointAuthMethod: "tls_client_auth", TLSClientAuthSubjectDn: "CN=other"}
+	self := &ent.Oauth2Client{TokenEndpointAuthMethod: "self_signed_tls_client_auth", Jwks: certificateJWKS(t, selfSigned)}
+	secret := &ent.Oauth2Client{Secret: "secret"}
+
+	tests := []struct {
+		name    string
+		client  *ent.Oauth2Client
+		cert    *x509.Certificate
+		wantErr bool
+	}{
+		{name: "tls_client_auth", client: pki, cert: leaf},
+		{name: "subject DN mismatch", client: otherDN, cert: leaf, wantErr: true},
+		{name: "not issued by the CA", client: pki, cert: selfSigned, wantErr: true},
+		{name: "self_signed_tls_client_auth", client: self, cert: selfSigned},
+		{name: "unregistered key", client: self, cert: otherSelfSigned, wantErr: true},
+		{name: "no certificate", client: pki, wantErr: true},
+		{name: "secret client", client: secret, cert: leaf, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest("POST", "/token", nil)
+			if tt.cert != nil {
+				withTestCertificate(r, tt.cert)
+			}
+			if err := verifyClientCertificate(r, tt.client); (err != nil) != tt.wantErr {
+				t.Errorf("verifyClientCertificate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestTokenHandlerCertificateBoundToken(t *testing.T) {
+	cert, _ := newTestCertificate(t, "device", false, nil, nil)
+	client := addTestClient(&ent.Oauth2Client{
+		Domain:                  "https://app.example.com",
+		TokenEndpointAuthMethod: "self_signed_tls_client_auth",
+		Jwks:                    certificateJWKS(t, cert),
+	})
+
+	ti, err := srv.Manager.GenerateAuthToken(context.Background(), oauth2.Code, &oauth2.TokenGenerateRequest{
+		ClientID:    client.GetID(),
+		UserID:      "user",
+		RedirectURI: "https://app.example.com/cb",
+		Request:     httptest.NewRequest("GET", "/authorize", nil),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	form := url.Values{
+		"grant_type":   {"authorization_code"},
+		"client_id":    {client.GetID()},
+		"code":         {ti.GetCode()},
+		"redirect_uri": {"https://app.example.com/cb"},
+	}
+	r := httptest.NewRequest("POST", "/token", strings.NewReader(form.Encode()))
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	w := httptest.NewRecorder()
+	tokenHandler(w, withTestCertificate(r, cert))
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d, body %s", w.Code, w.Body)
+	}
+
+	var data map[string]interface{}
+	if err := json.Unmarshal(w.Body.Bytes(), &data); err != nil {
+		t.Fatal(err)
+	}
+	claims := jwt.MapClaims{}
+	if _, _, err := jwt.NewParser().ParseUnverified(data["access_token"].(string), claims); err != nil {
+		t.Fatal(err)
+	}
+	cnf, _ := claims["cnf"].(map[string]interface{})
+	if cnf["x5t#S256"] != certificateThumbprint(cert) {
+		t.Errorf("cnf = %v", claims["cnf"])
+	}
+}
+
+func TestValidateAccessTokenCertificateBound(t *testing.T) {
+	cert, _ := newTestCertificate(t, "device", false, nil, nil)
+	other, _ := newTestCertificate(t, "other", false, nil, nil)
+	client := addTestClient(&ent.Oauth2Client{})
+	access, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"cnf": map[string]interface{}{"x5t#S256": certificateThumbprint(cert)},
+	}).SignedString([]byte("key"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	bound := addTestToken(t, client, access, "")
+
+	tests := []struct {
+		name    string
+		cert    *x509.Certificate
+		wantErr bool
+	}{
+		{name: "same certificate", cert: cert},
+		{name: "no certificate", wantErr: true},
+		{name: "other certificate", cert: other, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest("GET", "/userinfo", nil)
+			r.Header.Set("Authorization", "Bearer "+bound.Access)
+			if tt.cert != nil {
+				withTestCertificate(r, tt.cert)
+			}
+			if _, err := validateAccessToken(httptest.NewRecorder(), r); (err != nil) != tt.wantErr {
+				t.Errorf("validateAccessToken() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
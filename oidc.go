@@ -0,0 +1,190 @@
+package main
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base64"
+	"hash"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/go-oauth2/oauth2/v4"
+	"github.com/go-oauth2/oauth2/v4/errors"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+)
+
+// authSession is the state of the user authentication, carried from the
+// authorization request to the code and on to the refresh tokens issued for
+// it.
+type authSession struct {
+	Nonce    string `json:"nonce,omitempty"`
+	AuthTime int64  `json:"auth_time"`
+}
+
+func codeSessionKey(code string) string {
+	return "session:code:" + code
+}
+
+func refreshSessionKey(refresh string) string {
+	return "session:refresh:" + refresh
+}
+
+// loadAuthSession returns the session stored under key, or nil.
+func loadAuthSession(ctx context.Context, key string) *authSession {
+	var session authSession
+	if err := getJSON(ctx, stateStore, key, &session); err != nil {
+		if err != errKeyNotFound {
+			errorLogger.Error("[loadAuthSession]", "error", err.Error())
+		}
+		return nil
+	}
+	return &session
+}
+
+// sessionAuthorizeGenerate stores the authentication state of the
+// authorization request with every code it generates.
+type sessionAuthorizeGenerate struct {
+	next oauth2.AuthorizeGenerate
+}
+
+// Token implements oauth2.AuthorizeGenerate.
+func (g sessionAuthorizeGenerate) Token(ctx context.Context, data *oauth2.GenerateBasic) (string, error) {
+	code, err := g.next.Token(ctx, data)
+	if err != nil {
+		return "", err
+	}
+
+	session := authSession{AuthTime: data.CreateAt.Unix()}
+	if data.Request != nil {
+		session.Nonce = data.Request.FormValue("nonce")
+		// the user authenticated when logging in, not now
+		if login, err := currentLoginSession(data.Request); err == nil && login != nil && login.UserID == data.UserID {
+			session.AuthTime = login.AuthTime
+		}
+	}
+	if err := setJSON(ctx, stateStore, codeSessionKey(code), session, data.TokenInfo.GetCodeExpiresIn()); err != nil {
+		return "", err
+	}
+	return code, nil
+}
+
+// hasScope reports whether the space separated scope contains s.
+func hasScope(scope, s string) bool {
+	return slices.Contains(strings.Fields(scope), s)
+}
+
+// newIDToken issues the OpenID Connect ID token for ti. code is set when the
+// token was obtained with an authorization code.
+func newIDToken(ctx context.Context, ti oauth2.TokenInfo, session *authSession, code string) (string, error) {
+	key := signingKeys.Active()
+	if key == nil {
+		return "", errors.New("no signing key configured")
+	}
+
+	now := time.Now()
+	claims := jwt.MapClaims{
+		"iss":       issuer,
+		"sub":       ti.GetUserID(),
+		"aud":       ti.GetClientID(),
+		"iat":       now.Unix(),
+		"exp":       now.Add(ti.GetAccessExpiresIn()).Unix(),
+		"auth_time": session.AuthTime,
+		"jti":       uuid.NewString(),
+		"at_hash":   tokenHash(key, ti.GetAccess()),
+	}
+	if session.Nonce != "" && code != "" {
+		claims["nonce"] = session.Nonce
+	}
+	if code != "" {
+		claims["c_hash"] = tokenHash(key, code)
+	}
+
+	if userID, err := uuid.Parse(ti.GetUserID()); err == nil {
+		user, err := makeProxyUserService(ctx, rbac)(&defaultUserService{}).Get(userID)
+		if err != nil {
+			return "", err
+		}
+		for k, v := range userClaims(user, ti.GetScope()) {
+			claims[k] = v
+		}
+	}
+
+	return key.Sign(claims, "JWT")
+}
+
+// verifyIDTokenHint verifies an ID token issued by this server, given back as
+// the id_token_hint of a logout request. The token may have expired.
+func verifyIDTokenHint(hint string) (*jwt.RegisteredClaims, error) {
+	keys, err := signingKeys.Keys()
+	if err != nil {
+		return nil, err
+	}
+	claims := &jwt.RegisteredClaims{}
+	_, err = jwt.ParseWithClaims(hint, claims, (&JSONWebKeySet{Keys: keys}).Keyfunc(),
+		jwt.WithValidMethods(signingAlgorithms()),
+		jwt.WithoutClaimsValidation(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	if claims.Issuer != issuer || claims.Subject == "" {
+		return nil, errors.New("not an ID token of this issuer")
+	}
+	return claims, nil
+}
+
+// tokenHash computes the at_hash and c_hash values: the left half of the hash
+// of value, with the hash function of the signing algorithm.
+func tokenHash(key *signingKey, value string) string {
+	var h hash.Hash
+	if key.method == jwt.SigningMethodEdDSA {
+		h = sha512.New()
+	} else {
+		h = sha256.New()
+	}
+	h.Write([]byte(value))
+	sum := h.Sum(nil)
+	return base64.RawURLEncoding.EncodeToString(sum[:len(sum)/2])
+}
+
+// userClaims maps the user to the claims granted by scope: the standard
+// profile and phone claims, and the group, position and roles claims of the
+// dedicated scopes.
+func userClaims(user User, scope string) map[string]interface{} {
+	claims := make(map[string]interface{})
+	if hasScope(scope, "profile") {
+		if user.Username != "" {
+			claims["preferred_username"] = user.Username
+		}
+		if user.Name != nil {
+			claims["name"] = *user.Name
+		}
+	}
+	if hasScope(scope, "phone") && user.Phone != nil {
+		claims["phone_number"] = *user.Phone
+	}
+
+	roles := userRoles(user)
+	var group *Group
+	var position *Position
+	if user.Edges != nil {
+		group, position = user.Edges.Group, user.Edges.Position
+	}
+	if hasScope(scope, "group") && group != nil {
+		claims["group"] = map[string]string{"code": group.Code, "name": group.Name}
+	}
+	if hasScope(scope, "position") && position != nil {
+		claims["position"] = map[string]string{"code": position.Code, "name": position.Name}
+	}
+	if hasScope(scope, "roles") {
+		names := make([]string, 0, len(roles))
+		for _, role := range roles {
+			names = append(names, role.Name)
+		}
+		claims["roles"] = names
+	}
+	return claims
+}
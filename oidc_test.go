@@ -0,0 +1,103 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/go-oauth2/oauth2/v4"
+	"github.com/golang-jwt/jwt/v5"
+
+	"github.com/byebyebymyai/oauth2-api/ent"
+)
+
+func TestTokenHash(t *testing.T) {
+	// Example from OpenID Connect Core 1.0, appendix A.3.
+	key := &signingKey{method: jwt.SigningMethodRS256}
+	if got := tokenHash(key, "jHkWEdUXMU1BwAsC4vtUsZwnNvTIxEl0z9K3vx5KF0Y"); got != "77QmUPtjPfzWtF2AnpK9RQ" {
+		t.Errorf("tokenHash() = %q", got)
+	}
+}
+
+func TestUserClaims(t *testing.T) {
+	name, phone := "Jane Doe", "+1 555 0100"
+	user := User{Username: "jane", Name: &name, Phone: &phone}
+
+	claims := userClaims(user, "openid profile")
+	if claims["preferred_username"] != "jane" || claims["name"] != name || claims["phone_number"] != nil {
+		t.Errorf("userClaims(profile) = %v", claims)
+	}
+	claims = userClaims(user, "openid phone")
+	if claims["phone_number"] != phone || claims["name"] != nil {
+		t.Errorf("userClaims(phone) = %v", claims)
+	}
+}
+
+// exchangeTestCode issues a code with the given authorization request
+// parameters and exchanges it at the token endpoint.
+func exchangeTestCode(t *testing.T, client *ent.Oauth2Client, params url.Values) map[string]interface{} {
+	t.Helper()
+	ctx := context.Background()
+	ti, err := srv.Manager.GenerateAuthToken(ctx, oauth2.Code, &oauth2.TokenGenerateRequest{
+		ClientID:    client.GetID(),
+		UserID:      "user",
+		RedirectURI: "https://app.example.com/cb",
+		Scope:       params.Get("scope"),
+		Request:     httptest.NewRequest("GET", "/authorize?"+params.Encode(), nil),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	form := url.Values{
+		"grant_type":    {"authorization_code"},
+		"client_id":     {client.GetID()},
+		"client_secret": {"secret"},
+		"code":          {ti.GetCode()},
+		"redirect_uri":  {"https://app.example.com/cb"},
+	}
+	r := httptest.NewRequest("POST", "/token", strings.NewReader(form.Encode()))
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	w := httptest.NewRecorder()
+	tokenHandler(w, r)
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d, body %s", w.Code, w.Body)
+	}
+	var data map[string]interface{}
+	if err := json.Unmarshal(w.Body.Bytes(), &data); err != nil {
+		t.Fatal(err)
+	}
+	return data
+}
+
+func TestTokenHandlerIDToken(t *testing.T) {
+	client := addTestClient(&ent.Oauth2Client{Secret: "secret", Domain: "https://app.example.com"})
+
+	data := exchangeTestCode(t, client, url.Values{"scope": {"openid"}, "nonce": {"n-0S6_WzA2Mj"}})
+	idToken, ok := data["id_token"].(string)
+	if !ok {
+		t.Fatalf("no id_token in %v", data)
+	}
+	claims := jwt.MapClaims{}
+	_, err := jwt.ParseWithClaims(idToken, claims, func(*jwt.Token) (interface{}, error) {
+		return signingKeys.Active().key.Public(), nil
+	}, jwt.WithAudience(client.GetID()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if claims["nonce"] != "n-0S6_WzA2Mj" || claims["sub"] != "user" {
+		t.Errorf("claims = %v", claims)
+	}
+	if claims["at_hash"] != tokenHash(signingKeys.Active(), data["access_token"].(string)) {
+		t.Errorf("at_hash = %v", claims["at_hash"])
+	}
+
+	data = exchangeTestCode(t, client, url.Values{"scope": {"profile"}})
+	if _, ok := data["id_token"]; ok {
+		t.Error("id_token issued without the openid scope")
+	}
+}
@@ -0,0 +1,122 @@
+package main
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/go-oauth2/oauth2/v4/errors"
+)
+
+const (
+	// requestURIPrefix prefixes the request_uri of pushed authorization
+	// requests.
+	requestURIPrefix = "urn:ietf:params:oauth:request_uri:"
+	// pushedRequestExpiration is the lifetime of a pushed authorization
+	// request.
+	pushedRequestExpiration = 60 * time.Second
+)
+
+var ErrInvalidRequestURI = errors.New("invalid_request_uri")
+
+func init() {
+	errors.Descriptions[ErrInvalidRequestURI] = "The request_uri is invalid or expired"
+	errors.StatusCodes[ErrInvalidRequestURI] = http.StatusBadRequest
+}
+
+// clientAuthParams are the client authentication parameters, which are not
+// part of a pushed authorization request.
+var clientAuthParams = []string{"client_secret", "client_assertion", "client_assertion_type"}
+
+func pushedRequestKey(requestURI string) string {
+	return "par:" + requestURI
+}
+
+// parHandler implements pushed authorization requests (RFC 9126). The client
+// authenticates and posts the parameters of an authorization request, which
+// are validated and stored for a short time under the returned request_uri.
+func parHandler(w http.ResponseWriter, r *http.Request) {
+	client, err := authenticateClient(r)
+	if err != nil {
+		tokenError(w, err)
+		return
+	}
+
+	params := url.Values{}
+	for k, v := range r.PostForm {
+		params[k] = v
+	}
+	for _, k := range clientAuthParams {
+		params.Del(k)
+	}
+	if params.Has("request_uri") {
+		tokenError(w, errors.ErrInvalidRequest)
+		return
+	}
+	if params.Get("client_id") == "" {
+		params.Set("client_id", client.GetID())
+	}
+
+	// validate the request as /authorize will see it
+	r.Form = params
+	if err := resolveRequestObject(r); err != nil {
+		tokenError(w, err)
+		return
+	}
+	if err := validateAuthorizeRequest(r, client); err != nil {
+		tokenError(w, err)
+		return
+	}
+	params = r.Form
+
+	random, err := randomString(32)
+	if err != nil {
+		tokenError(w, err)
+		return
+	}
+	requestURI := requestURIPrefix + random
+	ctx := r.Context()
+	if err := setJSON(ctx, stateStore, pushedRequestKey(requestURI), params, pushedRequestExpiration); err != nil {
+		tokenError(w, err)
+		return
+	}
+
+	logger.Info("[parHandle]", "msg", "authorization request pushed", "clientID", client.GetID())
+	writeJSON(w, map[string]interface{}{
+		"request_uri": requestURI,
+		"expires_in":  int64(pushedRequestExpiration.Seconds()),
+	}, nil, http.StatusCreated)
+}
+
+// resolveRequestURI replaces the parameters of an authorization request with
+// the pushed request its request_uri refers to. Clients with
+// require_pushed_authorization_requests must use one. A request_uri can be
+// used once; the login and consent pages keep the resolved parameters while
+// the user decides. Other request_uri values refer to request objects, see
+// resolveRequestObject.
+func resolveRequestURI(r *http.Request) error {
+	ctx := r.Context()
+	clientID := r.FormValue("client_id")
+	requestURI := r.FormValue("request_uri")
+	if !strings.HasPrefix(requestURI, requestURIPrefix) {
+		client, err := getOauth2Client(ctx, clientID)
+		if err == nil && client.RequirePushedAuthorizationRequests {
+			return errors.ErrInvalidRequest
+		}
+		return nil
+	}
+
+	var params url.Values
+	if err := getDelJSON(ctx, stateStore, pushedRequestKey(requestURI), &params); err != nil {
+		if err == errKeyNotFound {
+			return ErrInvalidRequestURI
+		}
+		return err
+	}
+	if params.Get("client_id") != clientID {
+		return ErrInvalidRequestURI
+	}
+	r.Form = params
+	return nil
+}
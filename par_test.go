@@ -0,0 +1,88 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/go-oauth2/oauth2/v4/errors"
+
+	"github.com/byebyebymyai/oauth2-api/ent"
+)
+
+// pushTestRequest pushes an authorization request of client and returns the
+// response.
+func pushTestRequest(t *testing.T, client *ent.Oauth2Client, params url.Values) (int, map[string]interface{}) {
+	t.Helper()
+	form := url.Values{"client_id": {client.GetID()}, "client_secret": {"secret"}}
+	for k, v := range params {
+		form[k] = v
+	}
+	return postTestForm(t, parHandler, "/par", form)
+}
+
+func TestPARHandler(t *testing.T) {
+	client := addTestClient(&ent.Oauth2Client{Secret: "secret", Domain: "https://app.example.com", RedirectUris: []string{"https://app.example.com/cb"}})
+
+	tests := []struct {
+		name   string
+		params url.Values
+		want   string
+	}{
+		{name: "valid", params: url.Values{"response_type": {"code"}, "redirect_uri": {"https://app.example.com/cb"}, "state": {"xyz"}}},
+		{name: "request_uri", params: url.Values{"response_type": {"code"}, "request_uri": {requestURIPrefix + "x"}}, want: "invalid_request"},
+		{name: "foreign redirect_uri", params: url.Values{"response_type": {"code"}, "redirect_uri": {"https://evil.example.com/cb"}}, want: "invalid_request"},
+		{name: "response_type missing", params: url.Values{}, want: "unsupported_response_type"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status, data := pushTestRequest(t, client, tt.params)
+			if tt.want != "" {
+				if data["error"] != tt.want {
+					t.Errorf("error = %v, want %s", data["error"], tt.want)
+				}
+				return
+			}
+			if status != http.StatusCreated || data["expires_in"] != float64(60) {
+				t.Fatalf("status = %d, body %v", status, data)
+			}
+		})
+	}
+}
+
+func TestResolveRequestURI(t *testing.T) {
+	client := addTestClient(&ent.Oauth2Client{Secret: "secret", Domain: "https://app.example.com", RedirectUris: []string{"https://app.example.com/cb"}})
+	other := addTestClient(&ent.Oauth2Client{Secret: "secret"})
+	required := addTestClient(&ent.Oauth2Client{Secret: "secret", RequirePushedAuthorizationRequests: true})
+
+	_, data := pushTestRequest(t, client, url.Values{"response_type": {"code"}, "state": {"xyz"}})
+	requestURI, _ := data["request_uri"].(string)
+	_, data = pushTestRequest(t, client, url.Values{"response_type": {"code"}})
+	otherURI, _ := data["request_uri"].(string)
+
+	tests := []struct {
+		name   string
+		params url.Values
+		want   error
+	}{
+		{name: "pushed request", params: url.Values{"client_id": {client.GetID()}, "request_uri": {requestURI}}},
+		{name: "used twice", params: url.Values{"client_id": {client.GetID()}, "request_uri": {requestURI}}, want: ErrInvalidRequestURI},
+		{name: "other client", params: url.Values{"client_id": {other.GetID()}, "request_uri": {otherURI}}, want: ErrInvalidRequestURI},
+		{name: "unknown request_uri", params: url.Values{"client_id": {client.GetID()}, "request_uri": {requestURIPrefix + "unknown"}}, want: ErrInvalidRequestURI},
+		{name: "request object", params: url.Values{"client_id": {client.GetID()}, "request_uri": {"https://app.example.com/request.jwt"}}},
+		{name: "pushed request required", params: url.Values{"client_id": {required.GetID()}, "response_type": {"code"}}, want: errors.ErrInvalidRequest},
+		{name: "plain request", params: url.Values{"client_id": {client.GetID()}, "response_type": {"code"}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest("GET", "/authorize?"+tt.params.Encode(), nil)
+			if err := resolveRequestURI(r); err != tt.want {
+				t.Fatalf("resolveRequestURI() error = %v, want %v", err, tt.want)
+			}
+			if tt.params.Get("request_uri") == requestURI && tt.want == nil && r.FormValue("state") != "xyz" {
+				t.Errorf("parameters not resolved: %v", r.Form)
+			}
+		})
+	}
+}
@@ -0,0 +1,138 @@
+package main
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/go-oauth2/oauth2/v4"
+	"github.com/go-oauth2/oauth2/v4/errors"
+	"github.com/google/uuid"
+
+	"github.com/byebyebymyai/oauth2-api/ent"
+)
+
+func TestValidateAuthorizePKCE(t *testing.T) {
+	required := addTestClient(&ent.Oauth2Client{RequirePkce: true})
+	optional := addTestClient(&ent.Oauth2Client{})
+
+	tests := []struct {
+		name   string
+		client *ent.Oauth2Client
+		params url.Values
+		want   error
+	}{
+		{
+			name:   "S256",
+			client: required,
+			params: url.Values{"code_challenge": {"challenge"}, "code_challenge_method": {"S256"}},
+		},
+		{
+			name:   "plain rejected",
+			client: required,
+			params: url.Values{"code_challenge": {"challenge"}, "code_challenge_method": {"plain"}},
+			want:   errors.ErrUnsupportedCodeChallengeMethod,
+		},
+		{
+			name:   "method missing",
+			client: required,
+			params: url.Values{"code_challenge": {"challenge"}},
+			want:   errors.ErrUnsupportedCodeChallengeMethod,
+		},
+		{
+			name:   "challenge missing",
+			client: required,
+			params: url.Values{},
+			want:   errors.ErrCodeChallengeRquired,
+		},
+		{
+			name:   "not required",
+			client: optional,
+			params: url.Values{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.params.Set("response_type", "code")
+			tt.params.Set("client_id", tt.client.GetID())
+			r := httptest.NewRequest("GET", "/authorize?"+tt.params.Encode(), nil)
+			if err := validateAuthorizePKCE(r); err != tt.want {
+				t.Errorf("validateAuthorizePKCE() = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateTokenPKCE(t *testing.T) {
+	required := addTestClient(&ent.Oauth2Client{RequirePkce: true})
+	optional := addTestClient(&ent.Oauth2Client{})
+
+	tests := []struct {
+		name     string
+		client   *ent.Oauth2Client
+		verifier string
+		want     error
+	}{
+		{name: "verifier", client: required, verifier: "verifier"},
+		{name: "verifier missing", client: required, want: errors.ErrInvalidRequest},
+		{name: "not required", client: optional},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			form := url.Values{"grant_type": {"authorization_code"}, "client_id": {tt.client.GetID()}, "code": {"code"}}
+			if tt.verifier != "" {
+				form.Set("code_verifier", tt.verifier)
+			}
+			r := httptest.NewRequest("POST", "/token", strings.NewReader(form.Encode()))
+			r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+			if err := validateTokenPKCE(r); err != tt.want {
+				t.Errorf("validateTokenPKCE() = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestPKCECodeExchange(t *testing.T) {
+	client := addTestClient(&ent.Oauth2Client{Domain: "https://app.example.com", RequirePkce: true})
+	verifier := "dBjftJeZ4CVP-mB92K27uhbUJU1p1r_wW1gFWFOEjXk"
+	sum := sha256.Sum256([]byte(verifier))
+	challenge := base64.RawURLEncoding.EncodeToString(sum[:])
+
+	tests := []struct {
+		name     string
+		verifier string
+		want     error
+	}{
+		{name: "S256 match", verifier: verifier},
+		{name: "S256 mismatch", verifier: "wrong-verifier-wrong-verifier-wrong-verifier", want: errors.ErrInvalidCodeChallenge},
+		{name: "verifier missing", want: errors.ErrMissingCodeVerifier},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			ti, err := srv.Manager.GenerateAuthToken(ctx, oauth2.Code, &oauth2.TokenGenerateRequest{
+				ClientID:            client.GetID(),
+				UserID:              uuid.NewString(),
+				RedirectURI:         "https://app.example.com/cb",
+				CodeChallenge:       challenge,
+				CodeChallengeMethod: oauth2.CodeChallengeS256,
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			_, err = srv.Manager.GenerateAccessToken(ctx, oauth2.AuthorizationCode, &oauth2.TokenGenerateRequest{
+				ClientID:     client.GetID(),
+				RedirectURI:  "https://app.example.com/cb",
+				Code:         ti.GetCode(),
+				CodeVerifier: tt.verifier,
+			})
+			if err != tt.want {
+				t.Errorf("GenerateAccessToken() = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
@@ -2,7 +2,9 @@ package main
 
 import (
 	"context"
+	"time"
 
+	"github.com/byebyebymyai/oauth2-api/middleware"
 	"github.com/go-oauth2/oauth2/v4"
 )
 
@@ -35,3 +37,18 @@ func makeProxyTokenService(ctx context.Context, instance string) TokenServiceMid
 		return proxyTokenService{ctx, next, e}
 	}
 }
+
+type KeyServiceMiddleware func(next KeyService) KeyService
+
+func makeProxyKeyService(ctx context.Context, instance string) KeyServiceMiddleware {
+	if instance == "" {
+		return func(next KeyService) KeyService { return next }
+	}
+
+	// The JOSE key set changes rarely, cache it instead of fetching it for
+	// every JWKS request.
+	e := middleware.CachingMiddleware(5 * time.Minute)(proxyKeysEndpoint(ctx, instance))
+	return func(next KeyService) KeyService {
+		return proxyKeyService{ctx, next, e}
+	}
+}
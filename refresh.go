@@ -0,0 +1,110 @@
+package main
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-oauth2/oauth2/v4"
+	"github.com/go-oauth2/oauth2/v4/errors"
+	"github.com/google/uuid"
+)
+
+// refreshFamily is the family of the refresh tokens descended from one grant.
+// Only its current token is valid; the rotated ones stay known to the family
+// so that their reuse can be detected.
+type refreshFamily struct {
+	ID       string `json:"id"`
+	ClientID string `json:"client_id"`
+	UserID   string `json:"user_id,omitempty"`
+	Current  string `json:"current,omitempty"`
+	// Expires is when the refresh tokens of the family expire, zero if never.
+	Expires time.Time `json:"expires,omitempty"`
+}
+
+func refreshFamilyKey(refresh string) string {
+	return "refresh:family:" + refresh
+}
+
+func familyKey(id string) string {
+	return "family:" + id
+}
+
+// ttl returns how long the family has to be remembered.
+func (f *refreshFamily) ttl() time.Duration {
+	if f.Expires.IsZero() {
+		return 0
+	}
+	return time.Until(f.Expires)
+}
+
+// loadRefreshFamily returns the family of a presented refresh token, or nil
+// for tokens issued before families were tracked. A rotated token is a reuse:
+// the family is revoked and ErrInvalidGrant returned.
+func loadRefreshFamily(ctx context.Context, refresh string) (*refreshFamily, error) {
+	b, err := stateStore.Get(ctx, refreshFamilyKey(refresh))
+	if err == errKeyNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var family refreshFamily
+	if err := getJSON(ctx, stateStore, familyKey(string(b)), &family); err != nil {
+		if err == errKeyNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if family.Current != refresh {
+		errorLogger.Error("[refreshTokenReuse]", "msg", "rotated refresh token reused, revoking its family", "family", family.ID, "clientID", family.ClientID, "userID", family.UserID)
+		if err := revokeRefreshFamily(ctx, &family); err != nil {
+			return nil, err
+		}
+		return nil, errors.ErrInvalidGrant
+	}
+	return &family, nil
+}
+
+// revokeRefreshFamily revokes the current refresh token of the family and
+// its access token. The family stays known, so later reuses are logged too.
+func revokeRefreshFamily(ctx context.Context, family *refreshFamily) error {
+	if family.Current == "" {
+		return nil
+	}
+	if ti, err := srv.Manager.LoadRefreshToken(ctx, family.Current); err == nil {
+		if err := srv.Manager.RemoveAccessToken(ctx, ti.GetAccess()); err != nil {
+			return err
+		}
+	}
+	if err := srv.Manager.RemoveRefreshToken(ctx, family.Current); err != nil {
+		return err
+	}
+	family.Current = ""
+	return setJSON(ctx, stateStore, familyKey(family.ID), family, family.ttl())
+}
+
+// trackRefreshToken adds the refresh token of ti to family as its current
+// token, or starts a new family when family is nil.
+func trackRefreshToken(ctx context.Context, family *refreshFamily, ti oauth2.TokenInfo) error {
+	refresh := ti.GetRefresh()
+	if refresh == "" {
+		return nil
+	}
+	if family == nil {
+		family = &refreshFamily{
+			ID:       uuid.NewString(),
+			ClientID: ti.GetClientID(),
+			UserID:   ti.GetUserID(),
+		}
+		if exp := ti.GetRefreshExpiresIn(); exp > 0 {
+			family.Expires = ti.GetRefreshCreateAt().Add(exp)
+		}
+	}
+	family.Current = refresh
+
+	if err := stateStore.Set(ctx, refreshFamilyKey(refresh), []byte(family.ID), family.ttl()); err != nil {
+		return err
+	}
+	return setJSON(ctx, stateStore, familyKey(family.ID), family, family.ttl())
+}
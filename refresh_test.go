@@ -0,0 +1,124 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/go-oauth2/oauth2/v4"
+	"github.com/go-oauth2/oauth2/v4/errors"
+	"github.com/google/uuid"
+
+	"github.com/byebyebymyai/oauth2-api/ent"
+)
+
+func TestRefreshFamily(t *testing.T) {
+	client := addTestClient(&ent.Oauth2Client{})
+
+	tests := []struct {
+		name string
+		// rotation is how many times the refresh token is rotated.
+		rotation int
+		// reuse is the refresh token presented after the rotations.
+		reuse   func(tokens []oauth2.TokenInfo) string
+		wantErr error
+		revoked bool
+	}{
+		{
+			name:     "current token",
+			rotation: 2,
+			reuse:    func(tokens []oauth2.TokenInfo) string { return tokens[2].GetRefresh() },
+		},
+		{
+			name:     "rotated token revokes the family",
+			rotation: 2,
+			reuse:    func(tokens []oauth2.TokenInfo) string { return tokens[0].GetRefresh() },
+			wantErr:  errors.ErrInvalidGrant,
+			revoked:  true,
+		},
+		{
+			name:     "previous token revokes the family",
+			rotation: 1,
+			reuse:    func(tokens []oauth2.TokenInfo) string { return tokens[0].GetRefresh() },
+			wantErr:  errors.ErrInvalidGrant,
+			revoked:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			// the grant starts a family, every refresh rotates its token
+			var family *refreshFamily
+			var tokens []oauth2.TokenInfo
+			var err error
+			for i := 0; i <= tt.rotation; i++ {
+				if i > 0 {
+					if family, err = loadRefreshFamily(ctx, tokens[i-1].GetRefresh()); err != nil || family == nil {
+						t.Fatalf("loadRefreshFamily() = %v, %v", family, err)
+					}
+				}
+				ti := addTestToken(t, client, uuid.NewString(), uuid.NewString())
+				if err := trackRefreshToken(ctx, family, ti); err != nil {
+					t.Fatal(err)
+				}
+				tokens = append(tokens, ti)
+			}
+
+			family, err = loadRefreshFamily(ctx, tt.reuse(tokens))
+			if err != tt.wantErr {
+				t.Fatalf("loadRefreshFamily() error = %v, want %v", err, tt.wantErr)
+			}
+			if err == nil && family.Current != tokens[tt.rotation].GetRefresh() {
+				t.Errorf("family.Current = %q, want %q", family.Current, tokens[tt.rotation].GetRefresh())
+			}
+
+			// the current token of a revoked family is gone, and presenting it
+			// is a reuse as well
+			current := tokens[tt.rotation]
+			_, rerr := srv.Manager.LoadRefreshToken(ctx, current.GetRefresh())
+			_, aerr := srv.Manager.LoadAccessToken(ctx, current.GetAccess())
+			if revoked := rerr != nil && aerr != nil; revoked != tt.revoked {
+				t.Errorf("current tokens revoked = %v, want %v", revoked, tt.revoked)
+			}
+			if tt.revoked {
+				if _, err := loadRefreshFamily(ctx, current.GetRefresh()); err != errors.ErrInvalidGrant {
+					t.Errorf("loadRefreshFamily(current) error = %v, want %v", err, errors.ErrInvalidGrant)
+				}
+			}
+		})
+	}
+}
+
+func TestRefreshFamilyUntracked(t *testing.T) {
+	family, err := loadRefreshFamily(context.Background(), "issued-before-families")
+	if family != nil || err != nil {
+		t.Errorf("loadRefreshFamily() = %v, %v, want nil, nil", family, err)
+	}
+}
+
+func TestTokenHandlerRefreshReuse(t *testing.T) {
+	client := addTestClient(&ent.Oauth2Client{Secret: "secret", Domain: "https://app.example.com"})
+	first := exchangeTestCode(t, client, url.Values{"scope": {"openid"}})
+
+	refresh := func(token string) (int, map[string]interface{}) {
+		return postTestForm(t, tokenHandler, "/token", url.Values{
+			"grant_type":    {"refresh_token"},
+			"refresh_token": {token},
+			"client_id":     {client.GetID()},
+			"client_secret": {"secret"},
+		})
+	}
+
+	status, second := refresh(first["refresh_token"].(string))
+	if status != http.StatusOK || second["refresh_token"] == first["refresh_token"] {
+		t.Fatalf("status = %d, body %v", status, second)
+	}
+	if _, data := refresh(first["refresh_token"].(string)); data["error"] != "invalid_grant" {
+		t.Errorf("reused refresh token: %v", data)
+	}
+	if _, data := refresh(second["refresh_token"].(string)); data["error"] != "invalid_grant" {
+		t.Errorf("current refresh token after reuse: %v", data)
+	}
+}
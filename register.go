@@ -0,0 +1,562 @@
+package main
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/go-oauth2/oauth2/v4/errors"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+
+	"github.com/byebyebymyai/oauth2-api/ent"
+	"github.com/byebyebymyai/oauth2-api/ent/clientsecret"
+	"github.com/byebyebymyai/oauth2-api/ent/oauth2client"
+)
+
+var (
+	ErrInvalidClientMetadata       = errors.New("invalid_client_metadata")
+	ErrInvalidClientRedirectURI    = errors.New("invalid_redirect_uri")
+	ErrInvalidSoftwareStatement    = errors.New("invalid_software_statement")
+	ErrUnapprovedSoftwareStatement = errors.New("unapproved_software_statement")
+)
+
+func init() {
+	errors.Descriptions[ErrInvalidClientMetadata] = "The value of one of the client metadata fields is invalid"
+	errors.Descriptions[ErrInvalidClientRedirectURI] = "The value of one or more redirection URIs is invalid"
+	errors.Descriptions[ErrInvalidSoftwareStatement] = "The software statement presented is invalid"
+	errors.Descriptions[ErrUnapprovedSoftwareStatement] = "The software statement presented is not approved for use by this authorization server"
+	errors.StatusCodes[ErrInvalidClientMetadata] = http.StatusBadRequest
+	errors.StatusCodes[ErrInvalidClientRedirectURI] = http.StatusBadRequest
+	errors.StatusCodes[ErrInvalidSoftwareStatement] = http.StatusBadRequest
+	errors.StatusCodes[ErrUnapprovedSoftwareStatement] = http.StatusBadRequest
+}
+
+// clientMetadata is the client metadata of dynamic client registration
+// (RFC 7591).
+type clientMetadata struct {
+	ClientName                         string          `json:"client_name,omitempty"`
+	LogoURI                            string          `json:"logo_uri,omitempty"`
+	RedirectURIs                       []string        `json:"redirect_uris,omitempty"`
+	PostLogoutRedirectURIs             []string        `json:"post_logout_redirect_uris,omitempty"`
+	GrantTypes                         []string        `json:"grant_types,omitempty"`
+	ResponseTypes                      []string        `json:"response_types,omitempty"`
+	Scope                              string          `json:"scope,omitempty"`
+	TokenEndpointAuthMethod            string          `json:"token_endpoint_auth_method,omitempty"`
+	JWKS                               json.RawMessage `json:"jwks,omitempty"`
+	JWKSURI                            string          `json:"jwks_uri,omitempty"`
+	RequestURIs                        []string        `json:"request_uris,omitempty"`
+	TLSClientAuthSubjectDN             string          `json:"tls_client_auth_subject_dn,omitempty"`
+	DPoPBoundAccessTokens              bool            `json:"dpop_bound_access_tokens,omitempty"`
+	RequirePushedAuthorizationRequests bool            `json:"require_pushed_authorization_requests,omitempty"`
+	SoftwareStatement                  string          `json:"software_statement,omitempty"`
+}
+
+// clientRegistration is the client information response (RFC 7591 and
+// RFC 7592).
+type clientRegistration struct {
+	ClientID                string `json:"client_id"`
+	ClientSecret            string `json:"client_secret,omitempty"`
+	ClientSecretExpiresAt   *int64 `json:"client_secret_expires_at,omitempty"`
+	ClientIDIssuedAt        int64  `json:"client_id_issued_at,omitempty"`
+	RegistrationAccessToken string `json:"registration_access_token,omitempty"`
+	RegistrationClientURI   string `json:"registration_client_uri"`
+	clientMetadata
+}
+
+// newClientRegistration returns the registration of client.
+func newClientRegistration(r *http.Request, client *ent.Oauth2Client) *clientRegistration {
+	reg := &clientRegistration{
+		ClientID:              client.GetID(),
+		RegistrationClientURI: issuer + "/register/" + client.GetID(),
+		clientMetadata: clientMetadata{
+			ClientName:                         client.ClientName,
+			LogoURI:                            client.LogoURI,
+			RedirectURIs:                       client.RedirectUris,
+			PostLogoutRedirectURIs:             client.PostLogoutRedirectUris,
+			GrantTypes:                         client.GrantTypes,
+			ResponseTypes:                      client.ResponseTypes,
+			Scope:                              strings.Join(client.AllowedScopes, " "),
+			TokenEndpointAuthMethod:            client.TokenEndpointAuthMethod,
+			JWKS:                               client.Jwks,
+			JWKSURI:                            client.JwksURI,
+			RequestURIs:                        client.RequestUris,
+			TLSClientAuthSubjectDN:             client.TLSClientAuthSubjectDn,
+			DPoPBoundAccessTokens:              client.DpopBoundAccessTokens,
+			RequirePushedAuthorizationRequests: client.RequirePushedAuthorizationRequests,
+		},
+	}
+	return reg
+}
+
+// setSecret adds a secret, which does not expire, to the registration.
+func (reg *clientRegistration) setSecret(secret string) {
+	if secret == "" {
+		return
+	}
+	reg.ClientSecret = secret
+	reg.ClientSecretExpiresAt = new(int64)
+}
+
+// updateClientSecrets gives client the secrets of the authentication method
+// in md: hashed secrets for the secret methods, also encrypted for
+// client_secret_jwt, and none otherwise. It returns a newly issued secret.
+func updateClientSecrets(ctx context.Context, tx *ent.Tx, client *ent.Oauth2Client, md *clientMetadata) (string, error) {
+	secrets := clientsecret.HasClientWith(oauth2client.ID(client.ID))
+	encrypted := encryptedSecretMethod(md.TokenEndpointAuthMethod)
+	var err error
+	switch {
+	case !md.usesSecret():
+		_, err = tx.ClientSecret.Delete().Where(secrets).Exec(ctx)
+	case encrypted:
+		// secrets of which only the hash is known cannot verify assertions
+		_, err = tx.ClientSecret.Delete().Where(secrets, clientsecret.CiphertextIsNil()).Exec(ctx)
+	default:
+		err = tx.ClientSecret.Update().Where(secrets).ClearCiphertext().Exec(ctx)
+	}
+	if err != nil {
+		return "", err
+	}
+
+	switch {
+	case !md.usesSecret():
+		return "", tx.Oauth2Client.UpdateOne(client).ClearSecret().Exec(ctx)
+	case client.Secret != "":
+		// the client keeps its secret, hashed
+		_, err := hashClientSecret(ctx, tx.Client(), client, encrypted)
+		return "", err
+	}
+
+	n, err := tx.ClientSecret.Query().
+		Where(clientsecret.HasClientWith(oauth2client.ID(client.ID))).
+		Count(ctx)
+	if err != nil || n > 0 {
+		return "", err
+	}
+	secret, _, err := issueClientSecret(ctx, tx.Client(), client.ID, nil, encrypted)
+	return secret, err
+}
+
+// maxRegistrationRequestSize is the largest client metadata document read on
+// registration.
+const maxRegistrationRequestSize = 64 << 10
+
+// registerHandler implements dynamic client registration (RFC 7591). The
+// request needs an initial access token, or a software statement signed by a
+// trusted issuer, whose claims take precedence over the other metadata.
+func registerHandler(w http.ResponseWriter, r *http.Request) {
+	r.Body = http.MaxBytesReader(w, r.Body, maxRegistrationRequestSize)
+	var md clientMetadata
+	if err := json.NewDecoder(r.Body).Decode(&md); err != nil {
+		tokenError(w, ErrInvalidClientMetadata)
+		return
+	}
+
+	if md.SoftwareStatement != "" {
+		if err := applySoftwareStatement(r, &md); err != nil {
+			tokenError(w, err)
+			return
+		}
+	} else if !validInitialAccessToken(r) {
+		w.Header().Set("WWW-Authenticate", `Bearer error="invalid_token"`)
+		tokenError(w, ErrInvalidToken)
+		return
+	}
+
+	if err := md.validate(); err != nil {
+		tokenError(w, err)
+		return
+	}
+
+	ctx := r.Context()
+	token, err := randomString(32)
+	if err != nil {
+		tokenError(w, err)
+		return
+	}
+	tx, err := entClient.Tx(ctx)
+	if err != nil {
+		tokenError(w, err)
+		return
+	}
+	create := tx.Oauth2Client.Create().
+		SetDomain(md.domain(issuer)).
+		SetRegistrationAccessTokenHash(registrationTokenHash(token))
+	md.apply(create.Mutation())
+	client, err := create.Save(ctx)
+	var secret string
+	if err == nil {
+		secret, err = updateClientSecrets(ctx, tx, client, &md)
+	}
+	if err == nil {
+		err = tx.Commit()
+	} else {
+		tx.Rollback()
+	}
+	if err != nil {
+		errorLogger.Error("[registerHandle]", "error", err.Error())
+		tokenError(w, err)
+		return
+	}
+
+	logger.Info("[registerHandle]", "msg", "client registered", "clientID", client.GetID(), "clientName", client.ClientName)
+	reg := newClientRegistration(r, client)
+	reg.setSecret(secret)
+	reg.ClientIDIssuedAt = time.Now().Unix()
+	reg.RegistrationAccessToken = token
+	writeJSON(w, reg, nil, http.StatusCreated)
+}
+
+// registrationHandler implements the client configuration endpoint
+// (RFC 7592) at /register/{client_id}, where a client reads, updates or
+// deletes its registration with its registration access token.
+func registrationHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	client, err := registeredClient(r)
+	if err != nil {
+		w.Header().Set("WWW-Authenticate", `Bearer error="invalid_token"`)
+		tokenError(w, ErrInvalidToken)
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, newClientRegistration(r, client), nil, http.StatusOK)
+	case http.MethodPut:
+		var reg clientRegistration
+		r.Body = http.MaxBytesReader(w, r.Body, maxRegistrationRequestSize)
+		if err := json.NewDecoder(r.Body).Decode(&reg); err != nil {
+			tokenError(w, ErrInvalidClientMetadata)
+			return
+		}
+		if reg.ClientID != client.GetID() || (reg.ClientSecret != "" && !client.HasSecret(reg.ClientSecret)) {
+			tokenError(w, ErrInvalidClientMetadata)
+			return
+		}
+		md := reg.clientMetadata
+		if md.SoftwareStatement != "" {
+			if err := applySoftwareStatement(r, &md); err != nil {
+				tokenError(w, err)
+				return
+			}
+		}
+		if err := md.validate(); err != nil {
+			tokenError(w, err)
+			return
+		}
+
+		tx, err := entClient.Tx(ctx)
+		if err != nil {
+			tokenError(w, err)
+			return
+		}
+		secret, err := updateClientSecrets(ctx, tx, client, &md)
+		if err == nil {
+			update := tx.Oauth2Client.UpdateOneID(client.ID).SetDomain(md.domain(issuer))
+			md.apply(update.Mutation())
+			client, err = update.Save(ctx)
+		}
+		if err == nil {
+			err = tx.Commit()
+		} else {
+			tx.Rollback()
+		}
+		if err != nil {
+			errorLogger.Error("[registrationHandle]", "error", err.Error())
+			tokenError(w, err)
+			return
+		}
+		logger.Info("[registrationHandle]", "msg", "client updated", "clientID", client.GetID())
+		reg = *newClientRegistration(r, client)
+		reg.setSecret(secret)
+		writeJSON(w, reg, nil, http.StatusOK)
+	case http.MethodDelete:
+		if err := entClient.Oauth2Client.DeleteOne(client).Exec(ctx); err != nil {
+			errorLogger.Error("[registrationHandle]", "error", err.Error())
+			tokenError(w, err)
+			return
+		}
+		logger.Info("[registrationHandle]", "msg", "client deleted", "clientID", client.GetID())
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+// registeredClient returns the client of a client configuration request,
+// authenticated by its registration access token.
+func registeredClient(r *http.Request) (*ent.Oauth2Client, error) {
+	token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if !ok || token == "" {
+		return nil, ErrInvalidToken
+	}
+	id, err := uuid.Parse(r.PathValue("client_id"))
+	if err != nil {
+		return nil, ErrInvalidToken
+	}
+	client, err := entClient.Oauth2Client.Query().
+		Where(oauth2client.ID(id)).
+		WithSecrets().
+		Only(r.Context())
+	if err != nil {
+		return nil, err
+	}
+	if client.RegistrationAccessTokenHash == "" ||
+		subtle.ConstantTimeCompare([]byte(client.RegistrationAccessTokenHash), []byte(registrationTokenHash(token))) != 1 {
+		return nil, ErrInvalidToken
+	}
+	return client, nil
+}
+
+func registrationTokenHash(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// validInitialAccessToken reports whether the request carries one of the
+// configured initial access tokens.
+func validInitialAccessToken(r *http.Request) bool {
+	token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if !ok || token == "" {
+		return false
+	}
+	for _, t := range registrationInitialAccessTokens {
+		if subtle.ConstantTimeCompare([]byte(t), []byte(token)) == 1 {
+			return true
+		}
+	}
+	return false
+}
+
+// applySoftwareStatement verifies the software statement of md with the keys
+// of its issuer and overrides md with its claims. The issuers and their
+// jwks_uri are configured by the operator, so the keys are fetched with the
+// default HTTP client, which may reach internal hosts.
+func applySoftwareStatement(r *http.Request, md *clientMetadata) error {
+	unverified, err := parseUnverifiedAssertion(md.SoftwareStatement)
+	if err != nil {
+		return ErrInvalidSoftwareStatement
+	}
+	jwksURI, ok := softwareStatementIssuers[unverified.Issuer]
+	if !ok {
+		return ErrUnapprovedSoftwareStatement
+	}
+	keys, err := fetchJSONWebKeySet(r.Context(), softwareStatementHTTPClient, jwksURI, false)
+	if err != nil {
+		errorLogger.Error("[applySoftwareStatement]", "error", err.Error(), "issuer", unverified.Issuer)
+		return ErrUnapprovedSoftwareStatement
+	}
+
+	claims := jwt.MapClaims{}
+	if _, err := jwt.ParseWithClaims(md.SoftwareStatement, claims, jwksURIKeyfunc(r.Context(), softwareStatementHTTPClient, jwksURI, keys), jwt.WithValidMethods(assertionAlgorithms)); err != nil {
+		errorLogger.Error("[applySoftwareStatement]", "error", err.Error(), "issuer", unverified.Issuer)
+		return ErrInvalidSoftwareStatement
+	}
+	b, err := json.Marshal(claims)
+	if err != nil {
+		return ErrInvalidSoftwareStatement
+	}
+	statement := md.SoftwareStatement
+	if err := json.Unmarshal(b, md); err != nil {
+		return ErrInvalidSoftwareStatement
+	}
+	md.SoftwareStatement = statement
+	return nil
+}
+
+// validRedirectURI reports whether uri can be registered as a redirect URI:
+// an absolute URI without fragment that uses https, http on a loopback
+// address, or the private-use scheme of a native app in reverse domain
+// notation (RFC 8252, section 7.1), such as com.example.app:/cb. Schemes like
+// javascript: and data: are refused.
+func validRedirectURI(uri string) bool {
+	u, err := url.Parse(uri)
+	if err != nil || !u.IsAbs() || u.Fragment != "" {
+		return false
+	}
+	switch u.Scheme {
+	case "https":
+		return u.Host != ""
+	case "http":
+		ip := net.ParseIP(u.Hostname())
+		return ip != nil && ip.IsLoopback()
+	default:
+		return strings.Contains(u.Scheme, ".")
+	}
+}
+
+// validate checks the metadata and fills in the defaults of RFC 7591.
+func (md *clientMetadata) validate() error {
+	if md.TokenEndpointAuthMethod == "" {
+		md.TokenEndpointAuthMethod = "client_secret_basic"
+	}
+	if len(md.GrantTypes) == 0 {
+		md.GrantTypes = []string{"authorization_code"}
+	}
+	if len(md.ResponseTypes) == 0 && slices.Contains(md.GrantTypes, "authorization_code") {
+		md.ResponseTypes = []string{"code"}
+	}
+
+	if !slices.Contains(tokenEndpointAuthMethods, md.TokenEndpointAuthMethod) &&
+		!slices.Contains(clientAuthMethods, md.TokenEndpointAuthMethod) {
+		return ErrInvalidClientMetadata
+	}
+	supported := supportedGrantTypes()
+	for _, gt := range md.GrantTypes {
+		if !slices.Contains(supported, gt) {
+			return ErrInvalidClientMetadata
+		}
+	}
+	supported = supportedResponseTypes()
+	for _, rt := range md.ResponseTypes {
+		if !slices.Contains(supported, rt) {
+			return ErrInvalidClientMetadata
+		}
+	}
+	if slices.Contains(md.ResponseTypes, "code") != slices.Contains(md.GrantTypes, "authorization_code") ||
+		slices.Contains(md.ResponseTypes, "token") != slices.Contains(md.GrantTypes, "implicit") {
+		return ErrInvalidClientMetadata
+	}
+
+	if len(md.RedirectURIs) == 0 && len(md.ResponseTypes) > 0 {
+		return ErrInvalidClientRedirectURI
+	}
+	for _, uri := range md.RedirectURIs {
+		if !validRedirectURI(uri) {
+			return ErrInvalidClientRedirectURI
+		}
+	}
+	for _, uri := range md.PostLogoutRedirectURIs {
+		if !validRedirectURI(uri) {
+			return ErrInvalidClientMetadata
+		}
+	}
+	for _, uri := range md.RequestURIs {
+		if u, err := url.Parse(uri); err != nil || u.Scheme != "https" {
+			return ErrInvalidClientMetadata
+		}
+	}
+
+	if len(md.JWKS) > 0 && md.JWKSURI != "" {
+		return ErrInvalidClientMetadata
+	}
+	if len(md.JWKS) > 0 {
+		if _, err := parseJSONWebKeySet(md.JWKS); err != nil {
+			return ErrInvalidClientMetadata
+		}
+	}
+	if md.JWKSURI != "" {
+		if u, err := url.Parse(md.JWKSURI); err != nil || u.Scheme != "https" {
+			return ErrInvalidClientMetadata
+		}
+	}
+	if md.LogoURI != "" {
+		if u, err := url.Parse(md.LogoURI); err != nil || u.Scheme != "https" {
+			return ErrInvalidClientMetadata
+		}
+	}
+	switch md.TokenEndpointAuthMethod {
+	case "none":
+		// public clients cannot get tokens of their own or handle passwords
+		if slices.Contains(md.GrantTypes, "client_credentials") || slices.Contains(md.GrantTypes, "password") {
+			return ErrInvalidClientMetadata
+		}
+	case "private_key_jwt", "self_signed_tls_client_auth":
+		if len(md.JWKS) == 0 && md.JWKSURI == "" {
+			return ErrInvalidClientMetadata
+		}
+	case "tls_client_auth":
+		if md.TLSClientAuthSubjectDN == "" {
+			return ErrInvalidClientMetadata
+		}
+	case "client_secret_jwt":
+		// the secrets are kept encrypted with CLIENT_SECRET_KEY
+		if len(clientSecretKey) == 0 {
+			return ErrInvalidClientMetadata
+		}
+	}
+	return nil
+}
+
+// usesSecret reports whether the client authenticates with a secret.
+func (md *clientMetadata) usesSecret() bool {
+	switch md.TokenEndpointAuthMethod {
+	case "client_secret_basic", "client_secret_post", "client_secret_jwt":
+		return true
+	}
+	return false
+}
+
+// domain returns the domain of the client: the origin of its first redirect
+// URI, or the issuer for clients without redirects.
+func (md *clientMetadata) domain(issuer string) string {
+	if len(md.RedirectURIs) == 0 {
+		return issuer
+	}
+	u, _ := url.Parse(md.RedirectURIs[0])
+	return u.Scheme + "://" + u.Host
+}
+
+// apply sets the metadata on a client mutation, clearing what is unset.
+func (md *clientMetadata) apply(m *ent.Oauth2ClientMutation) {
+	m.SetTokenEndpointAuthMethod(md.TokenEndpointAuthMethod)
+	m.SetGrantTypes(md.GrantTypes)
+	m.SetDpopBoundAccessTokens(md.DPoPBoundAccessTokens)
+	m.SetRequirePushedAuthorizationRequests(md.RequirePushedAuthorizationRequests)
+	if md.ClientName != "" {
+		m.SetClientName(md.ClientName)
+	} else {
+		m.ClearClientName()
+	}
+	if md.LogoURI != "" {
+		m.SetLogoURI(md.LogoURI)
+	} else {
+		m.ClearLogoURI()
+	}
+	if len(md.RedirectURIs) > 0 {
+		m.SetRedirectUris(md.RedirectURIs)
+	} else {
+		m.ClearRedirectUris()
+	}
+	if len(md.PostLogoutRedirectURIs) > 0 {
+		m.SetPostLogoutRedirectUris(md.PostLogoutRedirectURIs)
+	} else {
+		m.ClearPostLogoutRedirectUris()
+	}
+	if len(md.ResponseTypes) > 0 {
+		m.SetResponseTypes(md.ResponseTypes)
+	} else {
+		m.ClearResponseTypes()
+	}
+	if scopes := strings.Fields(md.Scope); len(scopes) > 0 {
+		m.SetAllowedScopes(scopes)
+	} else {
+		m.ClearAllowedScopes()
+	}
+	if len(md.JWKS) > 0 {
+		m.SetJwks(md.JWKS)
+	} else {
+		m.ClearJwks()
+	}
+	if md.JWKSURI != "" {
+		m.SetJwksURI(md.JWKSURI)
+	} else {
+		m.ClearJwksURI()
+	}
+	if len(md.RequestURIs) > 0 {
+		m.SetRequestUris(md.RequestURIs)
+	} else {
+		m.ClearRequestUris()
+	}
+	if md.TLSClientAuthSubjectDN != "" {
+		m.SetTLSClientAuthSubjectDn(md.TLSClientAuthSubjectDN)
+	} else {
+		m.ClearTLSClientAuthSubjectDn()
+	}
+}
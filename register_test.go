@@ -0,0 +1,138 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+func TestClientMetadataValidate(t *testing.T) {
+	jwks := json.RawMessage(`{"keys":[]}`)
+
+	tests := []struct {
+		name string
+		md   clientMetadata
+		want error
+	}{
+		{name: "defaults", md: clientMetadata{RedirectURIs: []string{"https://app.example.com/cb"}}},
+		{name: "client_credentials without redirect", md: clientMetadata{GrantTypes: []string{"client_credentials"}}},
+		{name: "redirect missing", md: clientMetadata{}, want: ErrInvalidClientRedirectURI},
+		{name: "relative redirect", md: clientMetadata{RedirectURIs: []string{"/cb"}}, want: ErrInvalidClientRedirectURI},
+		{name: "redirect with fragment", md: clientMetadata{RedirectURIs: []string{"https://app.example.com/cb#x"}}, want: ErrInvalidClientRedirectURI},
+		{name: "loopback redirect", md: clientMetadata{RedirectURIs: []string{"http://127.0.0.1/cb", "http://[::1]:8080/cb"}}},
+		{name: "private-use scheme redirect", md: clientMetadata{RedirectURIs: []string{"com.example.app:/cb"}}},
+		{name: "http redirect", md: clientMetadata{RedirectURIs: []string{"http://app.example.com/cb"}}, want: ErrInvalidClientRedirectURI},
+		{name: "javascript redirect", md: clientMetadata{RedirectURIs: []string{"javascript:alert(1)"}}, want: ErrInvalidClientRedirectURI},
+		{name: "data redirect", md: clientMetadata{RedirectURIs: []string{"data:text/html,<script>alert(1)</script>"}}, want: ErrInvalidClientRedirectURI},
+		{name: "javascript post logout redirect", md: clientMetadata{RedirectURIs: []string{"https://app.example.com/cb"}, PostLogoutRedirectURIs: []string{"javascript:alert(1)"}}, want: ErrInvalidClientMetadata},
+		{name: "unknown grant type", md: clientMetadata{GrantTypes: []string{"magic"}}, want: ErrInvalidClientMetadata},
+		{name: "code without authorization_code", md: clientMetadata{GrantTypes: []string{"client_credentials"}, ResponseTypes: []string{"code"}, RedirectURIs: []string{"https://app.example.com/cb"}}, want: ErrInvalidClientMetadata},
+		{name: "unknown auth method", md: clientMetadata{TokenEndpointAuthMethod: "magic", RedirectURIs: []string{"https://app.example.com/cb"}}, want: ErrInvalidClientMetadata},
+		{name: "private_key_jwt with jwks", md: clientMetadata{TokenEndpointAuthMethod: "private_key_jwt", JWKS: jwks, RedirectURIs: []string{"https://app.example.com/cb"}}},
+		{name: "private_key_jwt without keys", md: clientMetadata{TokenEndpointAuthMethod: "private_key_jwt", RedirectURIs: []string{"https://app.example.com/cb"}}, want: ErrInvalidClientMetadata},
+		{name: "jwks and jwks_uri", md: clientMetadata{JWKS: jwks, JWKSURI: "https://app.example.com/jwks", RedirectURIs: []string{"https://app.example.com/cb"}}, want: ErrInvalidClientMetadata},
+		{name: "http jwks_uri", md: clientMetadata{JWKSURI: "http://app.example.com/jwks", RedirectURIs: []string{"https://app.example.com/cb"}}, want: ErrInvalidClientMetadata},
+		{name: "public client", md: clientMetadata{TokenEndpointAuthMethod: "none", RedirectURIs: []string{"https://app.example.com/cb"}}},
+		{name: "public client_credentials", md: clientMetadata{TokenEndpointAuthMethod: "none", GrantTypes: []string{"client_credentials"}}, want: ErrInvalidClientMetadata},
+		{name: "public password", md: clientMetadata{TokenEndpointAuthMethod: "none", GrantTypes: []string{"password"}}, want: ErrInvalidClientMetadata},
+		{name: "tls_client_auth without subject DN", md: clientMetadata{TokenEndpointAuthMethod: "tls_client_auth", RedirectURIs: []string{"https://app.example.com/cb"}}, want: ErrInvalidClientMetadata},
+		{name: "client_secret_jwt", md: clientMetadata{TokenEndpointAuthMethod: "client_secret_jwt", RedirectURIs: []string{"https://app.example.com/cb"}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.md.validate(); err != tt.want {
+				t.Errorf("validate() error = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestClientMetadataValidateWithoutClientSecretKey(t *testing.T) {
+	key := clientSecretKey
+	clientSecretKey = nil
+	t.Cleanup(func() { clientSecretKey = key })
+
+	md := clientMetadata{TokenEndpointAuthMethod: "client_secret_jwt", RedirectURIs: []string{"https://app.example.com/cb"}}
+	if err := md.validate(); err != ErrInvalidClientMetadata {
+		t.Errorf("validate() error = %v, want %v", err, ErrInvalidClientMetadata)
+	}
+}
+
+func TestClientMetadataDefaults(t *testing.T) {
+	md := clientMetadata{RedirectURIs: []string{"https://app.example.com/cb"}}
+	if err := md.validate(); err != nil {
+		t.Fatal(err)
+	}
+	if md.TokenEndpointAuthMethod != "client_secret_basic" || md.GrantTypes[0] != "authorization_code" || md.ResponseTypes[0] != "code" {
+		t.Errorf("metadata = %+v", md)
+	}
+	if !md.usesSecret() || md.domain("https://as.example.com") != "https://app.example.com" {
+		t.Errorf("usesSecret() = %v, domain() = %q", md.usesSecret(), md.domain("https://as.example.com"))
+	}
+}
+
+func TestRegisterHandlerAuthorization(t *testing.T) {
+	registrationInitialAccessTokens = []string{"initial-token"}
+	t.Cleanup(func() { registrationInitialAccessTokens = nil })
+
+	tests := []struct {
+		name   string
+		auth   string
+		body   string
+		status int
+		want   string
+	}{
+		{name: "without token", body: `{"redirect_uris":["https://app.example.com/cb"]}`, status: http.StatusUnauthorized, want: "invalid_token"},
+		{name: "wrong token", auth: "Bearer other", body: `{"redirect_uris":["https://app.example.com/cb"]}`, status: http.StatusUnauthorized, want: "invalid_token"},
+		{name: "unapproved software statement", body: `{"software_statement":"eyJhbGciOiJSUzI1NiJ9.eyJpc3MiOiJodHRwczovL290aGVyIn0.c2ln"}`, status: http.StatusBadRequest, want: "unapproved_software_statement"},
+		{name: "invalid metadata", auth: "Bearer initial-token", body: `{"grant_types":["magic"]}`, status: http.StatusBadRequest, want: "invalid_client_metadata"},
+		{name: "not JSON", auth: "Bearer initial-token", body: `redirect_uris=x`, status: http.StatusBadRequest, want: "invalid_client_metadata"},
+		{name: "too large", auth: "Bearer initial-token", body: `{"client_name":"` + strings.Repeat("x", maxRegistrationRequestSize) + `"}`, status: http.StatusBadRequest, want: "invalid_client_metadata"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest("POST", "/register", strings.NewReader(tt.body))
+			r.Header.Set("Content-Type", "application/json")
+			if tt.auth != "" {
+				r.Header.Set("Authorization", tt.auth)
+			}
+			w := httptest.NewRecorder()
+			registerHandler(w, r)
+
+			var data map[string]interface{}
+			json.Unmarshal(w.Body.Bytes(), &data)
+			if w.Code != tt.status || data["error"] != tt.want {
+				t.Errorf("status = %d, error = %v, want %d %s", w.Code, data["error"], tt.status, tt.want)
+			}
+		})
+	}
+}
+
+func TestApplySoftwareStatement(t *testing.T) {
+	key, jwks := newTestIssuer(t)
+	// the issuer is configured by the operator and may be an internal host
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write(jwks)
+	}))
+	defer ts.Close()
+	softwareStatementIssuers = map[string]string{"https://issuer.example.com": ts.URL}
+	t.Cleanup(func() { softwareStatementIssuers = nil })
+
+	token := jwt.NewWithClaims(jwt.SigningMethodES256, jwt.MapClaims{"iss": "https://issuer.example.com", "client_name": "Statement App"})
+	token.Header["kid"] = "issuer-key"
+	statement, err := token.SignedString(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	md := &clientMetadata{ClientName: "App", SoftwareStatement: statement}
+	if err := applySoftwareStatement(httptest.NewRequest("POST", "/register", nil), md); err != nil {
+		t.Fatal(err)
+	}
+	if md.ClientName != "Statement App" {
+		t.Errorf("client_name = %q, want the statement's", md.ClientName)
+	}
+}
@@ -0,0 +1,71 @@
+package main
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/go-oauth2/oauth2/v4"
+	"github.com/go-oauth2/oauth2/v4/errors"
+)
+
+// revokeHandler implements token revocation (RFC 7009). Revoking a refresh
+// token also revokes the access token issued with it and its refresh family.
+// Unknown tokens and tokens of other clients are ignored, so the response is
+// always 200 once the client is authenticated.
+func revokeHandler(w http.ResponseWriter, r *http.Request) {
+	client, err := authenticateClient(r)
+	if err != nil {
+		tokenError(w, err)
+		return
+	}
+
+	token := r.FormValue("token")
+	if token == "" {
+		tokenError(w, errors.ErrInvalidRequest)
+		return
+	}
+
+	ctx := r.Context()
+	ti, isRefresh := loadToken(ctx, token, r.FormValue("token_type_hint"))
+	if ti == nil || ti.GetClientID() != client.GetID() {
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	if isRefresh {
+		err = revokeRefreshToken(ctx, token, ti)
+	} else {
+		err = srv.Manager.RemoveAccessToken(ctx, token)
+	}
+	if err != nil {
+		errorLogger.Error("[revokeHandle]", "error", err.Error())
+		tokenError(w, err)
+		return
+	}
+
+	logger.Info("[revokeHandle]", "msg", "token revoked", "clientID", client.GetID(), "userID", ti.GetUserID(), "refresh", isRefresh)
+	w.WriteHeader(http.StatusOK)
+}
+
+// revokeRefreshToken revokes the refresh token ti and its access token. The
+// family of the token is revoked with it, so that presenting one of its
+// rotated tokens afterwards is still detected as a reuse.
+func revokeRefreshToken(ctx context.Context, refresh string, ti oauth2.TokenInfo) error {
+	family, err := loadRefreshFamily(ctx, refresh)
+	if err != nil && err != errors.ErrInvalidGrant {
+		return err
+	}
+	if family != nil {
+		return revokeRefreshFamily(ctx, family)
+	}
+
+	// a token issued before families were tracked, or left by a family just
+	// revoked for a reuse
+	if err := srv.Manager.RemoveRefreshToken(ctx, refresh); err != nil {
+		return err
+	}
+	if ti.GetAccess() != "" {
+		return srv.Manager.RemoveAccessToken(ctx, ti.GetAccess())
+	}
+	return nil
+}
@@ -0,0 +1,92 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/go-oauth2/oauth2/v4/errors"
+	"github.com/google/uuid"
+
+	"github.com/byebyebymyai/oauth2-api/ent"
+)
+
+func revoke(t *testing.T, client *ent.Oauth2Client, form url.Values) {
+	t.Helper()
+	r := httptest.NewRequest("POST", "/revoke", strings.NewReader(form.Encode()))
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	r.SetBasicAuth(client.GetID(), "secret")
+	w := httptest.NewRecorder()
+	revokeHandler(w, r)
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d, body %s", w.Code, w.Body)
+	}
+}
+
+func TestRevokeHandler(t *testing.T) {
+	ctx := context.Background()
+	client := addTestClient(&ent.Oauth2Client{Secret: "secret"})
+	other := addTestClient(&ent.Oauth2Client{Secret: "secret"})
+
+	t.Run("access token", func(t *testing.T) {
+		ti := addTestToken(t, client, uuid.NewString(), uuid.NewString())
+		revoke(t, client, url.Values{"token": {ti.Access}})
+		if _, err := srv.Manager.LoadAccessToken(ctx, ti.Access); err == nil {
+			t.Error("access token was not revoked")
+		}
+		if _, err := srv.Manager.LoadRefreshToken(ctx, ti.Refresh); err != nil {
+			t.Errorf("refresh token was revoked: %v", err)
+		}
+	})
+	t.Run("refresh token", func(t *testing.T) {
+		ti := addTestToken(t, client, uuid.NewString(), uuid.NewString())
+		revoke(t, client, url.Values{"token": {ti.Refresh}, "token_type_hint": {"refresh_token"}})
+		if _, err := srv.Manager.LoadRefreshToken(ctx, ti.Refresh); err == nil {
+			t.Error("refresh token was not revoked")
+		}
+		if _, err := srv.Manager.LoadAccessToken(ctx, ti.Access); err == nil {
+			t.Error("access token of the refresh token was not revoked")
+		}
+	})
+	t.Run("refresh token family", func(t *testing.T) {
+		first := addTestToken(t, client, uuid.NewString(), uuid.NewString())
+		if err := trackRefreshToken(ctx, nil, first); err != nil {
+			t.Fatal(err)
+		}
+		family, err := loadRefreshFamily(ctx, first.Refresh)
+		if err != nil || family == nil {
+			t.Fatalf("loadRefreshFamily() = %v, %v", family, err)
+		}
+		current := addTestToken(t, client, uuid.NewString(), uuid.NewString())
+		if err := trackRefreshToken(ctx, family, current); err != nil {
+			t.Fatal(err)
+		}
+
+		revoke(t, client, url.Values{"token": {current.Refresh}})
+		if _, err := srv.Manager.LoadRefreshToken(ctx, current.Refresh); err == nil {
+			t.Error("refresh token was not revoked")
+		}
+		if _, err := srv.Manager.LoadAccessToken(ctx, current.Access); err == nil {
+			t.Error("access token of the refresh token was not revoked")
+		}
+		// the family stays known, so its tokens are reuses
+		for _, refresh := range []string{first.Refresh, current.Refresh} {
+			if _, err := loadRefreshFamily(ctx, refresh); err != errors.ErrInvalidGrant {
+				t.Errorf("loadRefreshFamily() after revocation error = %v, want %v", err, errors.ErrInvalidGrant)
+			}
+		}
+	})
+	t.Run("other client", func(t *testing.T) {
+		ti := addTestToken(t, client, uuid.NewString(), "")
+		revoke(t, other, url.Values{"token": {ti.Access}})
+		if _, err := srv.Manager.LoadAccessToken(ctx, ti.Access); err != nil {
+			t.Errorf("token of another client was revoked: %v", err)
+		}
+	})
+	t.Run("unknown token", func(t *testing.T) {
+		revoke(t, client, url.Values{"token": {"unknown"}})
+	})
+}
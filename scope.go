@@ -0,0 +1,187 @@
+package main
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/go-oauth2/oauth2/v4"
+	"github.com/google/uuid"
+
+	"github.com/byebyebymyai/oauth2-api/ent"
+	"github.com/byebyebymyai/oauth2-api/ent/scope"
+)
+
+// registeredScopes returns the registered scopes among names, with their
+// permissions, by name.
+func registeredScopes(ctx context.Context, names []string) (map[string]*ent.Scope, error) {
+	scopes := make(map[string]*ent.Scope)
+	if len(names) == 0 {
+		return scopes, nil
+	}
+	all, err := entClient.Scope.Query().
+		Where(scope.NameIn(names...)).
+		WithPermissions().
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, s := range all {
+		scopes[s.Name] = s
+	}
+	return scopes, nil
+}
+
+// defaultScope returns the default scopes the client may ask for, space
+// separated.
+func defaultScope(ctx context.Context, client *ent.Oauth2Client) (string, error) {
+	names, err := entClient.Scope.Query().
+		Where(scope.IsDefault(true)).
+		Order(ent.Asc(scope.FieldName)).
+		Select(scope.FieldName).
+		Strings(ctx)
+	if err != nil {
+		return "", err
+	}
+	if len(client.AllowedScopes) > 0 {
+		names = slices.DeleteFunc(names, func(name string) bool {
+			return !slices.Contains(client.AllowedScopes, name)
+		})
+	}
+	return strings.Join(names, " "), nil
+}
+
+// consentScopes returns the scopes among names that the user has to consent
+// to. Scopes that are not registered always need consent.
+func consentScopes(ctx context.Context, names []string) ([]string, error) {
+	scopes, err := registeredScopes(ctx, names)
+	if err != nil {
+		return nil, err
+	}
+	var consent []string
+	for _, name := range names {
+		if s, ok := scopes[name]; !ok || s.RequiresConsent {
+			consent = append(consent, name)
+		}
+	}
+	return consent, nil
+}
+
+// userRoles returns the roles of the user from the user service.
+func userRoles(user User) []*Role {
+	if user.Edges != nil && len(user.Edges.Roles) > 0 {
+		return user.Edges.Roles
+	}
+	return user.Roles
+}
+
+// hasPermission reports whether one of the roles grants the permission of a
+// scope.
+func hasPermission(roles []*Role, p *ent.ScopePermission) bool {
+	for _, role := range roles {
+		for _, rp := range role.Edges.Permissions {
+			if strings.EqualFold(rp.Method, p.Method) && rp.Host == p.Host && rp.Path == p.Path {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// permittedScope returns the scopes of granted that the user's roles back. A
+// registered scope with permissions is only kept if the user holds all of
+// them; the other scopes are kept. Without a user, as in the client
+// credentials grant, or with a user id that is not a UUID, no scope with
+// permissions is kept.
+func permittedScope(ctx context.Context, userID string, granted string) (string, error) {
+	names := strings.Fields(granted)
+	scopes, err := registeredScopes(ctx, names)
+	if err != nil {
+		return "", err
+	}
+
+	var roles []*Role
+	permitted := make([]string, 0, len(names))
+	for _, name := range names {
+		s, ok := scopes[name]
+		if !ok || len(s.Edges.Permissions) == 0 {
+			permitted = append(permitted, name)
+			continue
+		}
+		if roles == nil {
+			roles = []*Role{}
+			if uid, err := uuid.Parse(userID); err == nil {
+				user, err := makeProxyUserService(ctx, rbac)(&defaultUserService{}).Get(uid)
+				if err != nil {
+					return "", err
+				}
+				roles = userRoles(user)
+			}
+		}
+		if !slices.ContainsFunc(s.Edges.Permissions, func(p *ent.ScopePermission) bool {
+			return !hasPermission(roles, p)
+		}) {
+			permitted = append(permitted, name)
+		}
+	}
+	return strings.Join(permitted, " "), nil
+}
+
+// scopedAccessGenerate sets the scope of every token before it is generated:
+// the default scopes when none was requested, limited to the scopes the
+// user's roles back.
+type scopedAccessGenerate struct {
+	next oauth2.AccessGenerate
+}
+
+// Token implements oauth2.AccessGenerate.
+func (g scopedAccessGenerate) Token(ctx context.Context, data *oauth2.GenerateBasic, isGenRefresh bool) (string, string, error) {
+	ti := data.TokenInfo
+	granted := ti.GetScope()
+	if granted == "" {
+		client, err := getOauth2Client(ctx, data.Client.GetID())
+		if err != nil {
+			return "", "", err
+		}
+		if granted, err = defaultScope(ctx, client); err != nil {
+			return "", "", err
+		}
+	}
+	if granted != "" {
+		var err error
+		if granted, err = permittedScope(ctx, data.UserID, granted); err != nil {
+			return "", "", err
+		}
+	}
+	ti.SetScope(granted)
+	return g.next.Token(ctx, data, isGenRefresh)
+}
+
+// scopeDescription returns the description of the scope in the language the
+// Accept-Language header prefers, falling back to English and then to any
+// language.
+func scopeDescription(s *ent.Scope, acceptLanguage string) string {
+	if len(s.Description) == 0 {
+		return ""
+	}
+	for _, tag := range strings.Split(acceptLanguage, ",") {
+		tag, _, _ = strings.Cut(strings.TrimSpace(tag), ";")
+		if d, ok := s.Description[tag]; ok {
+			return d
+		}
+		if base, _, ok := strings.Cut(tag, "-"); ok {
+			if d, ok := s.Description[base]; ok {
+				return d
+			}
+		}
+	}
+	if d, ok := s.Description["en"]; ok {
+		return d
+	}
+	langs := make([]string, 0, len(s.Description))
+	for lang := range s.Description {
+		langs = append(langs, lang)
+	}
+	slices.Sort(langs)
+	return s.Description[langs[0]]
+}
@@ -0,0 +1,177 @@
+package main
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/go-oauth2/oauth2/v4"
+	"github.com/google/uuid"
+
+	"github.com/byebyebymyai/oauth2-api/ent"
+)
+
+func TestScopeDescription(t *testing.T) {
+	s := &ent.Scope{Name: "profile", Description: map[string]string{
+		"en":    "Your profile",
+		"de":    "Ihr Profil",
+		"zh-CN": "您的个人资料",
+	}}
+	tests := map[string]string{
+		"":                "Your profile",
+		"de":              "Ihr Profil",
+		"de-AT, en;q=0.8": "Ihr Profil",
+		"zh-CN,zh;q=0.9":  "您的个人资料",
+		"fr, de;q=0.5":    "Ihr Profil",
+		"fr":              "Your profile",
+	}
+	for acceptLanguage, want := range tests {
+		if got := scopeDescription(s, acceptLanguage); got != want {
+			t.Errorf("scopeDescription(%q) = %q, want %q", acceptLanguage, got, want)
+		}
+	}
+
+	if got := scopeDescription(&ent.Scope{Description: map[string]string{"fr": "Profil", "de": "Profil (de)"}}, "es"); got != "Profil (de)" {
+		t.Errorf("scopeDescription() without English = %q, want the first language", got)
+	}
+	if got := scopeDescription(&ent.Scope{}, "en"); got != "" {
+		t.Errorf("scopeDescription() without descriptions = %q", got)
+	}
+}
+
+func TestUserRoles(t *testing.T) {
+	role := &Role{Name: "edge"}
+	if roles := userRoles(User{Edges: &UserEdges{Roles: []*Role{role}}, Roles: []*Role{{}}}); len(roles) != 1 || roles[0] != role {
+		t.Errorf("userRoles() = %v, want the roles edge", roles)
+	}
+	if roles := userRoles(User{Roles: []*Role{role}}); len(roles) != 1 || roles[0] != role {
+		t.Errorf("userRoles() = %v, want the roles", roles)
+	}
+}
+
+func TestHasPermission(t *testing.T) {
+	roles := []*Role{{Edges: RoleEdges{Permissions: []*Permission{
+		{Method: "get", Host: "api.example.com", Path: "/orders"},
+	}}}}
+	tests := []struct {
+		permission *ent.ScopePermission
+		want       bool
+	}{
+		{&ent.ScopePermission{Method: "GET", Host: "api.example.com", Path: "/orders"}, true},
+		{&ent.ScopePermission{Method: "POST", Host: "api.example.com", Path: "/orders"}, false},
+		{&ent.ScopePermission{Method: "GET", Host: "other.example.com", Path: "/orders"}, false},
+		{&ent.ScopePermission{Method: "GET", Host: "api.example.com", Path: "/orders/1"}, false},
+	}
+	for _, tt := range tests {
+		if got := hasPermission(roles, tt.permission); got != tt.want {
+			t.Errorf("hasPermission(%+v) = %v, want %v", tt.permission, got, tt.want)
+		}
+	}
+}
+
+// createTestScope registers a scope with the permissions, until the end of
+// the test.
+func createTestScope(t *testing.T, name string, isDefault, requiresConsent bool, permissions ...*ent.ScopePermission) {
+	t.Helper()
+	ctx := context.Background()
+	s, err := entClient.Scope.Create().
+		SetName(name).
+		SetIsDefault(isDefault).
+		SetRequiresConsent(requiresConsent).
+		Save(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { entClient.Scope.DeleteOne(s).Exec(ctx) })
+	for _, p := range permissions {
+		err := entClient.ScopePermission.Create().
+			SetScope(s).
+			SetMethod(p.Method).
+			SetHost(p.Host).
+			SetPath(p.Path).
+			Exec(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestDefaultScope(t *testing.T) {
+	createTestScope(t, "default-a", true, true)
+	createTestScope(t, "default-b", true, true)
+	createTestScope(t, "not-default", false, true)
+
+	ctx := context.Background()
+	if got, err := defaultScope(ctx, &ent.Oauth2Client{}); err != nil || got != "default-a default-b" {
+		t.Errorf("defaultScope() = %q, %v", got, err)
+	}
+	client := &ent.Oauth2Client{AllowedScopes: []string{"default-b", "not-default"}}
+	if got, err := defaultScope(ctx, client); err != nil || got != "default-b" {
+		t.Errorf("defaultScope() of a restricted client = %q, %v", got, err)
+	}
+}
+
+func TestConsentScopes(t *testing.T) {
+	createTestScope(t, "no-consent", false, false)
+	createTestScope(t, "needs-consent", false, true)
+
+	got, err := consentScopes(context.Background(), []string{"no-consent", "needs-consent", "unregistered"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !slices.Equal(got, []string{"needs-consent", "unregistered"}) {
+		t.Errorf("consentScopes() = %q", got)
+	}
+}
+
+func TestScopedAccessGenerate(t *testing.T) {
+	orders := &ent.ScopePermission{Method: "GET", Host: "api.example.com", Path: "/orders"}
+	createTestScope(t, "orders", false, true, orders)
+	client := addTestClient(&ent.Oauth2Client{})
+
+	tests := []struct {
+		name        string
+		userID      string
+		permissions []*Permission
+		want        string
+	}{
+		{
+			name:        "permitted",
+			userID:      uuid.NewString(),
+			permissions: []*Permission{{Method: "GET", Host: "api.example.com", Path: "/orders"}},
+			want:        "openid orders",
+		},
+		{
+			name:   "not permitted",
+			userID: uuid.NewString(),
+			want:   "openid",
+		},
+		{
+			name:        "user id not a UUID",
+			userID:      "jane",
+			permissions: []*Permission{{Method: "GET", Host: "api.example.com", Path: "/orders"}},
+			want:        "openid",
+		},
+		{
+			name:        "no user",
+			permissions: []*Permission{{Method: "GET", Host: "api.example.com", Path: "/orders"}},
+			want:        "openid",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			serveTestUser(t, User{Roles: []*Role{{Edges: RoleEdges{Permissions: tt.permissions}}}})
+			ti, err := srv.Manager.GenerateAccessToken(context.Background(), oauth2.PasswordCredentials, &oauth2.TokenGenerateRequest{
+				ClientID: client.GetID(),
+				UserID:   tt.userID,
+				Scope:    "openid orders",
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if ti.GetScope() != tt.want {
+				t.Errorf("scope = %q, want %q", ti.GetScope(), tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,344 @@
+package main
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/json"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/go-oauth2/oauth2/v4/errors"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/byebyebymyai/oauth2-api/ent"
+	"github.com/byebyebymyai/oauth2-api/ent/clientsecret"
+	"github.com/byebyebymyai/oauth2-api/ent/oauth2client"
+)
+
+// clientSecretResponse describes a client secret. The secret itself is only
+// returned when it is created.
+type clientSecretResponse struct {
+	ID           uuid.UUID  `json:"id"`
+	ClientSecret string     `json:"client_secret,omitempty"`
+	CreatedAt    time.Time  `json:"created_at"`
+	ExpiresAt    *time.Time `json:"expires_at,omitempty"`
+}
+
+func newClientSecretResponse(secret *ent.ClientSecret) clientSecretResponse {
+	return clientSecretResponse{
+		ID:        secret.ID,
+		CreatedAt: secret.CreatedAt,
+		ExpiresAt: secret.ExpiresAt,
+	}
+}
+
+// errNoClientSecretKey is returned when a secret has to be encrypted or
+// decrypted without CLIENT_SECRET_KEY.
+var errNoClientSecretKey = errors.New("CLIENT_SECRET_KEY is not set")
+
+// hashedSecretMethod reports whether clients authenticating with method keep
+// hashed secrets.
+func hashedSecretMethod(method string) bool {
+	switch method {
+	case "", "client_secret_basic", "client_secret_post", "client_secret_jwt":
+		return true
+	}
+	return false
+}
+
+// encryptedSecretMethod reports whether clients authenticating with method
+// also keep their secrets encrypted. client_secret_jwt needs the secret itself
+// to verify the assertions, so it is only supported with CLIENT_SECRET_KEY.
+func encryptedSecretMethod(method string) bool {
+	return method == "client_secret_jwt"
+}
+
+// encryptClientSecret encrypts secret with AES-GCM under clientSecretKey. The
+// nonce is prepended to the ciphertext.
+func encryptClientSecret(secret string) ([]byte, error) {
+	aead, err := clientSecretAEAD()
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	return aead.Seal(nonce, nonce, []byte(secret), nil), nil
+}
+
+// decryptClientSecret decrypts a secret encrypted by encryptClientSecret.
+func decryptClientSecret(ciphertext []byte) (string, error) {
+	aead, err := clientSecretAEAD()
+	if err != nil {
+		return "", err
+	}
+	if len(ciphertext) < aead.NonceSize() {
+		return "", errors.New("client secret ciphertext too short")
+	}
+	nonce, ciphertext := ciphertext[:aead.NonceSize()], ciphertext[aead.NonceSize():]
+	secret, err := aead.Open(nil, nonce, ciphertext, nil)
+	if err != nil {
+		return "", err
+	}
+	return string(secret), nil
+}
+
+func clientSecretAEAD() (cipher.AEAD, error) {
+	if len(clientSecretKey) == 0 {
+		return nil, errNoClientSecretKey
+	}
+	block, err := aes.NewCipher(clientSecretKey)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// clientSecretKeys returns the unexpired secrets of a client_secret_jwt
+// client, which must have been loaded with the client, as HMAC keys.
+func clientSecretKeys(client *ent.Oauth2Client) (jwt.VerificationKeySet, error) {
+	var keys jwt.VerificationKeySet
+	now := time.Now()
+	for _, secret := range client.Edges.Secrets {
+		if len(secret.Ciphertext) == 0 || secret.ExpiresAt != nil && !secret.ExpiresAt.After(now) {
+			continue
+		}
+		key, err := decryptClientSecret(secret.Ciphertext)
+		if err != nil {
+			return keys, err
+		}
+		keys.Keys = append(keys.Keys, []byte(key))
+	}
+	return keys, nil
+}
+
+// issueClientSecret creates a random secret for the client and stores its
+// hash, and when encrypted is set the encrypted secret. It returns the
+// secret, which cannot be read again.
+func issueClientSecret(ctx context.Context, c *ent.Client, clientID uuid.UUID, expiresAt *time.Time, encrypted bool) (string, *ent.ClientSecret, error) {
+	secret, err := randomString(32)
+	if err != nil {
+		return "", nil, err
+	}
+	create, err := newClientSecret(c, secret, encrypted)
+	if err != nil {
+		return "", nil, err
+	}
+	cs, err := create.
+		SetClientID(clientID).
+		SetNillableExpiresAt(expiresAt).
+		Save(ctx)
+	if err != nil {
+		return "", nil, err
+	}
+	return secret, cs, nil
+}
+
+// newClientSecret returns the builder of a client secret storing the hash of
+// secret, and when encrypted is set the encrypted secret.
+func newClientSecret(c *ent.Client, secret string, encrypted bool) (*ent.ClientSecretCreate, error) {
+	hash, err := bcrypt.GenerateFromPassword([]byte(secret), bcrypt.DefaultCost)
+	if err != nil {
+		return nil, err
+	}
+	create := c.ClientSecret.Create().SetHash(string(hash))
+	if encrypted {
+		ciphertext, err := encryptClientSecret(secret)
+		if err != nil {
+			return nil, err
+		}
+		create.SetCiphertext(ciphertext)
+	}
+	return create, nil
+}
+
+// hashClientSecret moves the plaintext secret of a client to a hashed
+// secret, also encrypted when encrypted is set. It reports false if the
+// secret changed in the meantime.
+func hashClientSecret(ctx context.Context, c *ent.Client, client *ent.Oauth2Client, encrypted bool) (bool, error) {
+	create, err := newClientSecret(c, client.Secret, encrypted)
+	if err != nil {
+		return false, err
+	}
+	update := c.Oauth2Client.Update().
+		Where(oauth2client.ID(client.ID), oauth2client.Secret(client.Secret)).
+		ClearSecret()
+	if client.TokenEndpointAuthMethod == "" {
+		// a client without secrets stays confidential
+		update.SetTokenEndpointAuthMethod("client_secret_basic")
+	}
+	n, err := update.Save(ctx)
+	if err != nil || n == 0 {
+		return false, err
+	}
+	if err := create.SetClientID(client.ID).Exec(ctx); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// migrateClientSecrets hashes the plaintext secrets stored before client
+// secrets were hashed. The clients keep authenticating with the same secret.
+// The secrets of client_secret_jwt clients are only migrated with
+// CLIENT_SECRET_KEY, since they are kept encrypted.
+func migrateClientSecrets(ctx context.Context, c *ent.Client) error {
+	methods := []string{"", "client_secret_basic", "client_secret_post"}
+	if len(clientSecretKey) > 0 {
+		methods = append(methods, "client_secret_jwt")
+	}
+	clients, err := c.Oauth2Client.Query().
+		Where(
+			oauth2client.SecretNEQ(""),
+			oauth2client.Or(
+				oauth2client.TokenEndpointAuthMethodIsNil(),
+				oauth2client.TokenEndpointAuthMethodIn(methods...),
+			),
+		).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+
+	migrated := 0
+	for _, client := range clients {
+		tx, err := c.Tx(ctx)
+		if err != nil {
+			return err
+		}
+		ok, err := hashClientSecret(ctx, tx.Client(), client, encryptedSecretMethod(client.TokenEndpointAuthMethod))
+		if err != nil {
+			tx.Rollback()
+			return err
+		}
+		if err := tx.Commit(); err != nil {
+			return err
+		}
+		if ok {
+			migrated++
+		}
+	}
+	if migrated > 0 {
+		logger.Info("[migrateClientSecrets]", "msg", "hashed plaintext client secrets", "clients", migrated)
+	}
+
+	if len(clientSecretKey) == 0 {
+		n, err := c.Oauth2Client.Query().
+			Where(oauth2client.SecretNEQ(""), oauth2client.TokenEndpointAuthMethod("client_secret_jwt")).
+			Count(ctx)
+		if err != nil {
+			return err
+		}
+		if n > 0 {
+			logger.Warn("[migrateClientSecrets]", "msg", "client_secret_jwt clients cannot authenticate without CLIENT_SECRET_KEY", "clients", n)
+		}
+	}
+	return nil
+}
+
+// clientSecretsHandler lists the secrets of a client, or creates a new one on
+// POST, at /register/{client_id}/secrets. The client authenticates with its
+// registration access token. Several secrets can be valid at once, so that a
+// secret can be rotated by creating the new one before revoking the old one.
+func clientSecretsHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	client, err := registeredClient(r)
+	if err != nil {
+		w.Header().Set("WWW-Authenticate", `Bearer error="invalid_token"`)
+		tokenError(w, ErrInvalidToken)
+		return
+	}
+
+	if r.Method != http.MethodPost {
+		secrets, err := client.QuerySecrets().Order(ent.Asc(clientsecret.FieldCreatedAt)).All(ctx)
+		if err != nil {
+			errorLogger.Error("[clientSecretsHandle]", "error", err.Error())
+			tokenError(w, err)
+			return
+		}
+		res := make([]clientSecretResponse, 0, len(secrets))
+		for _, secret := range secrets {
+			res = append(res, newClientSecretResponse(secret))
+		}
+		writeJSON(w, res, nil, http.StatusOK)
+		return
+	}
+
+	if !hashedSecretMethod(client.TokenEndpointAuthMethod) {
+		tokenError(w, errors.ErrInvalidRequest)
+		return
+	}
+	var req struct {
+		ExpiresAt *time.Time `json:"expires_at"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil && err != io.EOF {
+		tokenError(w, errors.ErrInvalidRequest)
+		return
+	}
+	if req.ExpiresAt != nil && req.ExpiresAt.Before(time.Now()) {
+		tokenError(w, errors.ErrInvalidRequest)
+		return
+	}
+
+	tx, err := entClient.Tx(ctx)
+	if err != nil {
+		tokenError(w, err)
+		return
+	}
+	secret, cs, err := issueClientSecret(ctx, tx.Client(), client.ID, req.ExpiresAt, encryptedSecretMethod(client.TokenEndpointAuthMethod))
+	if err == nil && client.TokenEndpointAuthMethod == "" {
+		err = tx.Oauth2Client.UpdateOne(client).SetTokenEndpointAuthMethod("client_secret_basic").Exec(ctx)
+	}
+	if err == nil {
+		err = tx.Commit()
+	} else {
+		tx.Rollback()
+	}
+	if err != nil {
+		errorLogger.Error("[clientSecretsHandle]", "error", err.Error())
+		tokenError(w, err)
+		return
+	}
+
+	logger.Info("[clientSecretsHandle]", "msg", "client secret created", "clientID", client.GetID(), "secretID", cs.ID)
+	res := newClientSecretResponse(cs)
+	res.ClientSecret = secret
+	writeJSON(w, res, nil, http.StatusCreated)
+}
+
+// revokeClientSecretHandler revokes a secret of a client at
+// /register/{client_id}/secrets/{id}.
+func revokeClientSecretHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	client, err := registeredClient(r)
+	if err != nil {
+		w.Header().Set("WWW-Authenticate", `Bearer error="invalid_token"`)
+		tokenError(w, ErrInvalidToken)
+		return
+	}
+	id, err := uuid.Parse(r.PathValue("id"))
+	if err != nil {
+		http.Error(w, "client secret not found", http.StatusNotFound)
+		return
+	}
+
+	n, err := entClient.ClientSecret.Delete().
+		Where(clientsecret.ID(id), clientsecret.HasClientWith(oauth2client.ID(client.ID))).
+		Exec(ctx)
+	if err != nil {
+		errorLogger.Error("[revokeClientSecretHandle]", "error", err.Error())
+		tokenError(w, err)
+		return
+	}
+	if n == 0 {
+		http.Error(w, "client secret not found", http.StatusNotFound)
+		return
+	}
+	logger.Info("[revokeClientSecretHandle]", "msg", "client secret revoked", "clientID", client.GetID(), "secretID", id)
+	w.WriteHeader(http.StatusNoContent)
+}
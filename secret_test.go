@@ -0,0 +1,137 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/byebyebymyai/oauth2-api/ent"
+)
+
+func TestHashedSecretMethod(t *testing.T) {
+	tests := map[string]bool{
+		"":                    true,
+		"client_secret_basic": true,
+		"client_secret_post":  true,
+		"client_secret_jwt":   true,
+		"private_key_jwt":     false,
+		"tls_client_auth":     false,
+		"none":                false,
+	}
+	for method, want := range tests {
+		if got := hashedSecretMethod(method); got != want {
+			t.Errorf("hashedSecretMethod(%q) = %v, want %v", method, got, want)
+		}
+	}
+}
+
+func TestEncryptClientSecret(t *testing.T) {
+	ciphertext, err := encryptClientSecret("client-secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bytes.Contains(ciphertext, []byte("client-secret")) {
+		t.Errorf("ciphertext %q contains the secret", ciphertext)
+	}
+	if secret, err := decryptClientSecret(ciphertext); err != nil || secret != "client-secret" {
+		t.Errorf("decryptClientSecret() = %q, %v", secret, err)
+	}
+
+	ciphertext[len(ciphertext)-1] ^= 1
+	if _, err := decryptClientSecret(ciphertext); err == nil {
+		t.Error("decryptClientSecret() of a modified ciphertext succeeded")
+	}
+
+	key := clientSecretKey
+	clientSecretKey = nil
+	t.Cleanup(func() { clientSecretKey = key })
+	if _, err := encryptClientSecret("client-secret"); err != errNoClientSecretKey {
+		t.Errorf("encryptClientSecret() without key error = %v, want %v", err, errNoClientSecretKey)
+	}
+}
+
+func TestNewClientSecretResponse(t *testing.T) {
+	expiresAt := time.Now().Add(time.Hour)
+	secret := &ent.ClientSecret{ID: uuid.New(), Hash: "hash", CreatedAt: time.Now(), ExpiresAt: &expiresAt}
+	res := newClientSecretResponse(secret)
+	if res.ID != secret.ID || res.ClientSecret != "" || res.ExpiresAt != &expiresAt {
+		t.Errorf("newClientSecretResponse() = %+v", res)
+	}
+}
+
+func TestClientSecretsHandlerUnauthorized(t *testing.T) {
+	for _, handler := range []http.HandlerFunc{clientSecretsHandler, revokeClientSecretHandler} {
+		r := httptest.NewRequest("POST", "/register/"+uuid.NewString()+"/secrets", nil)
+		w := httptest.NewRecorder()
+		handler(w, r)
+		if w.Code != http.StatusUnauthorized || w.Header().Get("WWW-Authenticate") != `Bearer error="invalid_token"` {
+			t.Errorf("status = %d, WWW-Authenticate %q", w.Code, w.Header().Get("WWW-Authenticate"))
+		}
+	}
+}
+
+func TestUpdateClientSecrets(t *testing.T) {
+	ctx := context.Background()
+	client, err := entClient.Oauth2Client.Get(ctx, saveTestClient(t, &ent.Oauth2Client{ID: uuid.New(), Domain: "https://app.example.com"}).ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	update := func(method string) string {
+		t.Helper()
+		tx, err := entClient.Tx(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		secret, err := updateClientSecrets(ctx, tx, client, &clientMetadata{TokenEndpointAuthMethod: method})
+		if err != nil {
+			tx.Rollback()
+			t.Fatal(err)
+		}
+		if err := tx.Commit(); err != nil {
+			t.Fatal(err)
+		}
+		return secret
+	}
+	secrets := func() []*ent.ClientSecret {
+		t.Helper()
+		secrets, err := client.QuerySecrets().All(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return secrets
+	}
+
+	secret := update("client_secret_jwt")
+	if s := secrets(); secret == "" || len(s) != 1 || len(s[0].Ciphertext) == 0 {
+		t.Fatalf("client_secret_jwt secrets = %v, issued %q", s, secret)
+	}
+	if keys, err := clientSecretKeys(&ent.Oauth2Client{Edges: ent.Oauth2ClientEdges{Secrets: secrets()}}); err != nil || len(keys.Keys) != 1 || string(keys.Keys[0].([]byte)) != secret {
+		t.Errorf("clientSecretKeys() = %v, %v", keys, err)
+	}
+
+	// the secret is kept, but no longer encrypted
+	if update("client_secret_basic") != "" {
+		t.Error("client_secret_basic issued a new secret")
+	}
+	if s := secrets(); len(s) != 1 || len(s[0].Ciphertext) != 0 {
+		t.Errorf("client_secret_basic secrets = %v", s)
+	}
+
+	// the hashed secret cannot verify assertions, so a new one is issued
+	if update("client_secret_jwt") == secret {
+		t.Error("client_secret_jwt kept the hashed secret")
+	}
+	if s := secrets(); len(s) != 1 || len(s[0].Ciphertext) == 0 {
+		t.Errorf("client_secret_jwt secrets = %v", s)
+	}
+
+	update("private_key_jwt")
+	if s := secrets(); len(s) != 0 {
+		t.Errorf("private_key_jwt secrets = %v", s)
+	}
+}
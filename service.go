@@ -4,12 +4,16 @@ import (
 	"bytes"
 	"context"
 	"encoding/base64"
+	"slices"
 	"strconv"
 	"strings"
 
 	"github.com/byebyebymyai/oauth2-api/endpoint"
 	"github.com/byebyebymyai/oauth2-api/ent"
+	"github.com/byebyebymyai/oauth2-api/ent/oauth2client"
 	"github.com/go-oauth2/oauth2/v4"
+	"github.com/go-oauth2/oauth2/v4/errors"
+	"github.com/golang-jwt/jwt/v5"
 	"github.com/google/uuid"
 )
 
@@ -53,11 +57,82 @@ func (svc proxyUserService) Get(userID uuid.UUID) (User, error) {
 }
 
 type defaultTokenService struct {
+	issuer string
+	keys   *keySet
 }
 
-// Token implements oauth2.AccessGenerate.
+// Token implements oauth2.AccessGenerate. It signs the access token as a JWT
+// with the active signing key.
 func (j defaultTokenService) Token(ctx context.Context, data *oauth2.GenerateBasic, isGenRefresh bool) (access string, refresh string, err error) {
-	return "", "", nil
+	key := j.keys.Active()
+	if key == nil {
+		return "", "", errors.New("no signing key configured")
+	}
+
+	ti := data.TokenInfo
+	sub := data.UserID
+	if sub == "" {
+		sub = data.Client.GetID()
+	}
+	claims := jwt.MapClaims{
+		"iss":       j.issuer,
+		"sub":       sub,
+		"aud":       tokenRequestAudience(data),
+		"iat":       data.CreateAt.Unix(),
+		"jti":       uuid.NewString(),
+		"client_id": data.Client.GetID(),
+	}
+	if exp := ti.GetAccessExpiresIn(); exp > 0 {
+		claims["exp"] = ti.GetAccessCreateAt().Add(exp).Unix()
+	}
+	if scope := ti.GetScope(); scope != "" {
+		claims["scope"] = scope
+	}
+	for k, v := range tokenClaimsFromContext(ctx) {
+		claims[k] = v
+	}
+	rc, err := accessTokenRBACClaims(ctx, data)
+	if err != nil {
+		return "", "", err
+	}
+	if rc != nil {
+		if err := rc.setClaims(claims); err != nil {
+			return "", "", err
+		}
+	}
+
+	access, err = key.Sign(claims, "at+jwt")
+	if err != nil {
+		return "", "", err
+	}
+
+	if isGenRefresh {
+		refresh = generateRefreshToken(data)
+	}
+	return access, refresh, nil
+}
+
+// tokenRequestAudience returns the audience of a new access token: the client
+// itself plus any audience values of the token request.
+func tokenRequestAudience(data *oauth2.GenerateBasic) []string {
+	aud := []string{data.Client.GetID()}
+	if data.Request != nil {
+		for _, v := range data.Request.Form["audience"] {
+			if v != "" && !slices.Contains(aud, v) {
+				aud = append(aud, v)
+			}
+		}
+	}
+	return aud
+}
+
+// generateRefreshToken creates a random opaque refresh token.
+func generateRefreshToken(data *oauth2.GenerateBasic) string {
+	buf := bytes.NewBufferString(data.Client.GetDomain())
+	buf.WriteString(data.UserID)
+	buf.WriteString(strconv.FormatInt(data.CreateAt.Unix(), 10))
+	refresh := base64.URLEncoding.EncodeToString([]byte(uuid.NewSHA1(uuid.Must(uuid.NewRandom()), buf.Bytes()).String()))
+	return strings.ToUpper(strings.TrimRight(refresh, "="))
 }
 
 type proxyTokenService struct {
@@ -68,11 +143,19 @@ type proxyTokenService struct {
 
 // Token implements oauth2.AccessGenerate.
 func (j proxyTokenService) Token(ctx context.Context, data *oauth2.GenerateBasic, isGenRefresh bool) (access string, refresh string, err error) {
+	claims := tokenClaimsFromContext(ctx)
+	rc, err := accessTokenRBACClaims(ctx, data)
+	if err != nil {
+		return "", "", err
+	}
 	response, err := j.endpoint(ctx, tokenGenerationRequest{
-		Iss: data.Client.GetDomain(),
-		Sub: data.UserID,
-		Exp: int64(data.TokenInfo.GetAccessExpiresIn().Seconds()),
-		Aud: []string{data.Request.Host},
+		Iss:        issuer,
+		Sub:        data.UserID,
+		Exp:        int64(data.TokenInfo.GetAccessExpiresIn().Seconds()),
+		Aud:        tokenRequestAudience(data),
+		Act:        claims["act"],
+		Cnf:        claims["cnf"],
+		rbacClaims: rc,
 	})
 
 	if err != nil {
@@ -83,11 +166,7 @@ func (j proxyTokenService) Token(ctx context.Context, data *oauth2.GenerateBasic
 	refresh = ""
 
 	if isGenRefresh {
-		buf := bytes.NewBufferString(data.Client.GetDomain())
-		buf.WriteString(data.UserID)
-		buf.WriteString(strconv.FormatInt(data.CreateAt.Unix(), 10))
-		refresh = base64.URLEncoding.EncodeToString([]byte(uuid.NewSHA1(uuid.Must(uuid.NewRandom()), buf.Bytes()).String()))
-		refresh = strings.ToUpper(strings.TrimRight(refresh, "="))
+		refresh = generateRefreshToken(data)
 	}
 	return access, refresh, err
 }
@@ -98,9 +177,77 @@ type ClientStorage struct {
 }
 
 func (c *ClientStorage) GetByID(ctx context.Context, id string) (oauth2.ClientInfo, error) {
-	client, err := c.client.Oauth2Client.Get(ctx, uuid.MustParse(id))
+	clientID, err := uuid.Parse(id)
+	if err != nil {
+		return nil, errors.ErrInvalidClient
+	}
+	// the secrets verify the client password
+	client, err := c.client.Oauth2Client.Query().
+		Where(oauth2client.ID(clientID)).
+		WithSecrets().
+		Only(ctx)
 	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errors.ErrInvalidClient
+		}
 		return nil, err
 	}
+	if authenticatedClientFromContext(ctx) == id {
+		return authenticatedClient{client}, nil
+	}
 	return client, nil
 }
+
+// getOauth2Client loads the ent client behind a client id through the manager.
+func getOauth2Client(ctx context.Context, id string) (*ent.Oauth2Client, error) {
+	cli, err := srv.Manager.GetClient(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	switch client := cli.(type) {
+	case *ent.Oauth2Client:
+		return client, nil
+	case authenticatedClient:
+		return client.Oauth2Client, nil
+	}
+	return nil, errors.ErrInvalidClient
+}
+
+type KeyService interface {
+	Keys() ([]*JSONWebKey, error)
+}
+
+type defaultKeyService struct {
+	keys *keySet
+}
+
+// Keys returns the public keys of the local signing keys.
+func (svc defaultKeyService) Keys() ([]*JSONWebKey, error) {
+	return svc.keys.Keys()
+}
+
+type proxyKeyService struct {
+	ctx      context.Context
+	next     KeyService
+	endpoint endpoint.Endpoint
+}
+
+// Keys returns the keys of the JOSE service followed by the local keys.
+func (svc proxyKeyService) Keys() ([]*JSONWebKey, error) {
+	res, err := svc.endpoint(svc.ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	keys := res.([]*JSONWebKey)
+
+	local, err := svc.next.Keys()
+	if err != nil {
+		return nil, err
+	}
+	for _, k := range local {
+		if !slices.ContainsFunc(keys, func(v *JSONWebKey) bool { return v.Kid == k.Kid }) {
+			keys = append(keys, k)
+		}
+	}
+	return keys, nil
+}
@@ -0,0 +1,207 @@
+package main
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/go-oauth2/oauth2/v4"
+	"github.com/go-oauth2/oauth2/v4/errors"
+	"github.com/go-oauth2/oauth2/v4/manage"
+)
+
+// extensionGrants handles the grant types that go-oauth2 does not know. The
+// server rejects them in ValidationTokenRequest, so the token handler
+// dispatches them before.
+var extensionGrants = map[string]http.HandlerFunc{
+	deviceCodeGrantType:    deviceCodeGrant,
+	tokenExchangeGrantType: tokenExchangeGrant,
+	jwtBearerGrantType:     jwtBearerGrant,
+}
+
+// tokenHandler handles token requests like srv.HandleTokenRequest and adds
+// the OpenID Connect ID token to the response when the openid scope is
+// granted. Clients may authenticate with a JWT client assertion or a TLS
+// client certificate.
+func tokenHandler(w http.ResponseWriter, r *http.Request) {
+	if hasClientAssertion(r) || clientCertificate(r) != nil {
+		client, err := authenticateClient(r)
+		if err != nil {
+			tokenError(w, err)
+			return
+		}
+		r = r.WithContext(contextWithAuthenticatedClient(r.Context(), client.GetID()))
+	}
+
+	// tokens issued over mutual TLS or with a DPoP proof are bound to the
+	// certificate or the proof key
+	cnf := make(map[string]interface{})
+	if cert := clientCertificate(r); cert != nil {
+		cnf["x5t#S256"] = certificateThumbprint(cert)
+	}
+	jkt, err := tokenRequestDPoP(w, r)
+	if err != nil {
+		tokenError(w, err)
+		return
+	}
+	if jkt != "" {
+		cnf["jkt"] = jkt
+	}
+	if len(cnf) > 0 {
+		r = r.WithContext(contextWithTokenClaims(r.Context(), map[string]interface{}{"cnf": cnf}))
+	}
+
+	if grant, ok := extensionGrants[r.FormValue("grant_type")]; ok {
+		if !srv.CheckGrantType(oauth2.GrantType(r.FormValue("grant_type"))) {
+			tokenError(w, errors.ErrUnsupportedGrantType)
+			return
+		}
+		grant(w, r)
+		return
+	}
+
+	if err := validateTokenPKCE(r); err != nil {
+		tokenError(w, err)
+		return
+	}
+
+	ctx := r.Context()
+	gt, tgr, err := srv.ValidationTokenRequest(r)
+	if err != nil {
+		tokenError(w, err)
+		return
+	}
+
+	var session *authSession
+	var sessionKey string
+	var family *refreshFamily
+	switch gt {
+	case oauth2.AuthorizationCode:
+		sessionKey = codeSessionKey(tgr.Code)
+	case oauth2.Refreshing:
+		sessionKey = refreshSessionKey(tgr.Refresh)
+
+		// refresh tokens of DPoP bound tokens need a proof of the same key
+		if rti, err := srv.Manager.LoadRefreshToken(ctx, tgr.Refresh); err == nil {
+			if bound := tokenJKT(rti); bound != "" && bound != jkt {
+				tokenError(w, ErrInvalidDPoPProof)
+				return
+			}
+		}
+
+		// a reused refresh token revokes its family
+		family, err = loadRefreshFamily(ctx, tgr.Refresh)
+		if err != nil {
+			tokenError(w, err)
+			return
+		}
+	}
+	if sessionKey != "" {
+		session = loadAuthSession(ctx, sessionKey)
+	}
+
+	ti, err := srv.GetAccessToken(ctx, gt, tgr)
+	if err != nil {
+		tokenError(w, err)
+		return
+	}
+	if refresh := ti.GetRefresh(); refresh != "" && refresh != tgr.Refresh {
+		if err := trackRefreshToken(ctx, family, ti); err != nil {
+			errorLogger.Error("[tokenHandle]", "error", err.Error())
+		}
+	}
+
+	data := tokenData(ctx, ti)
+	if session != nil {
+		if hasScope(ti.GetScope(), "openid") {
+			idToken, err := newIDToken(ctx, ti, session, tgr.Code)
+			if err != nil {
+				errorLogger.Error("[tokenHandle]", "error", err.Error())
+				tokenError(w, err)
+				return
+			}
+			data["id_token"] = idToken
+		}
+
+		// the session follows the refresh token
+		if refresh := ti.GetRefresh(); refresh != "" && refresh != tgr.Refresh {
+			if err := setJSON(ctx, stateStore, refreshSessionKey(refresh), session, ti.GetRefreshExpiresIn()); err != nil {
+				errorLogger.Error("[tokenHandle]", "error", err.Error())
+			}
+		}
+		if sessionKey != refreshSessionKey(ti.GetRefresh()) {
+			if err := stateStore.Del(ctx, sessionKey); err != nil {
+				errorLogger.Error("[tokenHandle]", "error", err.Error())
+			}
+		}
+	}
+
+	writeJSON(w, data, nil, http.StatusOK)
+}
+
+// generateExtensionToken issues the token of an extension grant through the
+// manager. The manager only has token settings for the built-in grants, so the
+// token gets the settings of the password grant when withRefresh is set, and
+// its access token lifetime otherwise. The client must be allowed to use the
+// grant type and the scope.
+func generateExtensionToken(ctx context.Context, gt string, tgr *oauth2.TokenGenerateRequest, withRefresh bool) (oauth2.TokenInfo, error) {
+	allowed, err := srv.ClientAuthorizedHandler(tgr.ClientID, oauth2.GrantType(gt))
+	if err != nil {
+		return nil, err
+	}
+	if !allowed {
+		return nil, errors.ErrUnauthorizedClient
+	}
+	allowed, err = srv.ClientScopeHandler(tgr)
+	if err != nil {
+		return nil, err
+	}
+	if !allowed {
+		return nil, errors.ErrInvalidScope
+	}
+
+	if withRefresh {
+		ti, err := srv.Manager.GenerateAccessToken(ctx, oauth2.PasswordCredentials, tgr)
+		if err != nil {
+			return nil, err
+		}
+		if err := trackRefreshToken(ctx, nil, ti); err != nil {
+			errorLogger.Error("[generateExtensionToken]", "error", err.Error())
+		}
+		return ti, nil
+	}
+	if tgr.AccessTokenExp == 0 {
+		tgr.AccessTokenExp = manage.DefaultPasswordTokenCfg.AccessTokenExp
+	}
+	return srv.Manager.GenerateAccessToken(ctx, oauth2.GrantType(gt), tgr)
+}
+
+type tokenClaimsKey struct{}
+
+// contextWithTokenClaims adds claims to the access tokens generated with the
+// returned context.
+func contextWithTokenClaims(ctx context.Context, claims map[string]interface{}) context.Context {
+	merged := make(map[string]interface{})
+	for k, v := range tokenClaimsFromContext(ctx) {
+		merged[k] = v
+	}
+	for k, v := range claims {
+		merged[k] = v
+	}
+	return context.WithValue(ctx, tokenClaimsKey{}, merged)
+}
+
+// tokenClaimsFromContext returns the additional access token claims of ctx.
+func tokenClaimsFromContext(ctx context.Context) map[string]interface{} {
+	claims, _ := ctx.Value(tokenClaimsKey{}).(map[string]interface{})
+	return claims
+}
+
+// tokenData returns the token response of ti like srv.GetTokenData. Tokens
+// bound to a DPoP key have the DPoP token type.
+func tokenData(ctx context.Context, ti oauth2.TokenInfo) map[string]interface{} {
+	data := srv.GetTokenData(ti)
+	if cnf, ok := tokenClaimsFromContext(ctx)["cnf"].(map[string]interface{}); ok && cnf["jkt"] != nil {
+		data["token_type"] = "DPoP"
+	}
+	return data
+}
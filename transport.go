@@ -4,10 +4,13 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"io"
+	"net"
 	"net/http"
 	"net/url"
 	"strings"
+	"syscall"
 	"time"
 
 	"github.com/byebyebymyai/oauth2-api/endpoint"
@@ -158,10 +161,13 @@ func decodeTokenResponse(_ context.Context, r *http.Response) (interface{}, erro
 }
 
 type tokenGenerationRequest struct {
-	Iss string   `json:"iss,omitempty"`
-	Sub string   `json:"sub,omitempty"`
-	Exp int64    `json:"exp,omitempty"`
-	Aud []string `json:"aud,omitempty"`
+	Iss string      `json:"iss,omitempty"`
+	Sub string      `json:"sub,omitempty"`
+	Exp int64       `json:"exp,omitempty"`
+	Aud []string    `json:"aud,omitempty"`
+	Act interface{} `json:"act,omitempty"`
+	Cnf interface{} `json:"cnf,omitempty"`
+	*rbacClaims
 }
 
 func proxyUserAllEndpoint(_ context.Context, instance string) endpoint.Endpoint {
@@ -233,3 +239,125 @@ func decodeUserResponse(_ context.Context, r *http.Response) (interface{}, error
 	}
 	return result, nil
 }
+
+func proxyKeysEndpoint(_ context.Context, instance string) endpoint.Endpoint {
+	u, err := url.Parse(instance + "/jwks")
+	if err != nil {
+		panic(err)
+	}
+	return httpTransport.NewClient(
+		http.MethodGet,
+		u,
+		func(context.Context, *http.Request, interface{}) error { return nil },
+		decodeKeysResponse,
+		httpTransport.ClientBefore(httpTransport.PopulateRequestContext),
+	).Endpoint()
+}
+
+// maxExternalResponseSize is the largest document read from a URL that a
+// client chose.
+const maxExternalResponseSize = 64 << 10
+
+// externalHTTPClient fetches the documents at URLs that clients chose, such
+// as their jwks_uri. It only connects to public addresses, so that a client
+// cannot make the server reach internal hosts.
+var externalHTTPClient httpTransport.HTTPClient = &http.Client{
+	Timeout: 10 * time.Second,
+	Transport: &http.Transport{
+		DialContext: (&net.Dialer{
+			Timeout: 5 * time.Second,
+			Control: dialPublicAddress,
+		}).DialContext,
+		TLSHandshakeTimeout:   5 * time.Second,
+		ResponseHeaderTimeout: 5 * time.Second,
+	},
+}
+
+// softwareStatementHTTPClient fetches the keys of the software statement
+// issuers, at the jwks_uri the operator configured.
+var softwareStatementHTTPClient httpTransport.HTTPClient = http.DefaultClient
+
+// dialPublicAddress is a net.Dialer Control function refusing connections to
+// loopback, private, link-local and other non-public addresses. It checks the
+// resolved address, so that a public host name cannot point inside either.
+func dialPublicAddress(_, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	ip := net.ParseIP(host)
+	if ip == nil || !isPublicIP(ip) {
+		return fmt.Errorf("address %s is not public", host)
+	}
+	return nil
+}
+
+// sharedAddressSpace is the carrier-grade NAT range of RFC 6598.
+var sharedAddressSpace = &net.IPNet{IP: net.IPv4(100, 64, 0, 0), Mask: net.CIDRMask(10, 32)}
+
+// isPublicIP reports whether ip is a globally routable unicast address.
+func isPublicIP(ip net.IP) bool {
+	return ip.IsGlobalUnicast() &&
+		!ip.IsPrivate() &&
+		!sharedAddressSpace.Contains(ip)
+}
+
+func jwksURIEndpoint(_ context.Context, u *url.URL, client httpTransport.HTTPClient) endpoint.Endpoint {
+	return httpTransport.NewClient(
+		http.MethodGet,
+		u,
+		func(context.Context, *http.Request, interface{}) error { return nil },
+		decodeJWKSURIResponse,
+		httpTransport.ClientBefore(httpTransport.PopulateRequestContext),
+		httpTransport.SetClient(client),
+	).Endpoint()
+}
+
+// decodeJWKSURIResponse decodes the keys at a jwks_uri, reading at most
+// maxExternalResponseSize bytes.
+func decodeJWKSURIResponse(ctx context.Context, r *http.Response) (interface{}, error) {
+	r.Body = io.NopCloser(io.LimitReader(r.Body, maxExternalResponseSize))
+	return decodeKeysResponse(ctx, r)
+}
+
+func decodeKeysResponse(_ context.Context, r *http.Response) (interface{}, error) {
+	if r.StatusCode != http.StatusOK {
+		text, err := io.ReadAll(r.Body)
+		if err != nil {
+			return nil, err
+		}
+		return nil, errors.New(string(text))
+	}
+	var result struct {
+		Keys []*JSONWebKey `json:"keys"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&result); err != nil {
+		return nil, err
+	}
+	return result.Keys, nil
+}
+
+func requestObjectEndpoint(_ context.Context, u *url.URL) endpoint.Endpoint {
+	return httpTransport.NewClient(
+		http.MethodGet,
+		u,
+		func(_ context.Context, r *http.Request, _ interface{}) error {
+			r.Header.Set("Accept", "application/oauth-authz-req+jwt")
+			return nil
+		},
+		decodeRequestObjectResponse,
+		httpTransport.ClientBefore(httpTransport.PopulateRequestContext),
+		httpTransport.SetClient(externalHTTPClient),
+	).Endpoint()
+}
+
+func decodeRequestObjectResponse(_ context.Context, r *http.Response) (interface{}, error) {
+	text, err := io.ReadAll(io.LimitReader(r.Body, maxExternalResponseSize))
+	if err != nil {
+		return nil, err
+	}
+	if r.StatusCode != http.StatusOK {
+		return nil, errors.New(string(text))
+	}
+	return strings.TrimSpace(string(text)), nil
+}
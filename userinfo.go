@@ -0,0 +1,57 @@
+package main
+
+import (
+	"net/http"
+
+	"github.com/go-oauth2/oauth2/v4/errors"
+	"github.com/google/uuid"
+)
+
+// ErrInvalidToken is the invalid_token error of protected resources
+// (RFC 6750).
+var ErrInvalidToken = errors.New("invalid_token")
+
+func init() {
+	errors.Descriptions[ErrInvalidToken] = "The access token is invalid, expired or revoked"
+	errors.StatusCodes[ErrInvalidToken] = http.StatusUnauthorized
+}
+
+// userinfoHandler implements the OpenID Connect UserInfo endpoint. The user
+// behind the bearer or DPoP token is fetched from the user service and only
+// the claims granted by the token scope are returned.
+func userinfoHandler(w http.ResponseWriter, r *http.Request) {
+	ti, err := validateAccessToken(w, r)
+	if err != nil {
+		if err == ErrUseDPoPNonce {
+			w.Header().Set("WWW-Authenticate", `DPoP error="use_dpop_nonce"`)
+			tokenError(w, err)
+			return
+		}
+		w.Header().Set("WWW-Authenticate", `Bearer error="invalid_token"`)
+		tokenError(w, ErrInvalidToken)
+		return
+	}
+	if !hasScope(ti.GetScope(), "openid") {
+		w.Header().Set("WWW-Authenticate", `Bearer error="insufficient_scope", scope="openid"`)
+		http.Error(w, "insufficient_scope", http.StatusForbidden)
+		return
+	}
+
+	userID, err := uuid.Parse(ti.GetUserID())
+	if err != nil {
+		w.Header().Set("WWW-Authenticate", `Bearer error="invalid_token"`)
+		tokenError(w, ErrInvalidToken)
+		return
+	}
+
+	user, err := makeProxyUserService(r.Context(), rbac)(&defaultUserService{}).Get(userID)
+	if err != nil {
+		errorLogger.Error("[userinfoHandle]", "error", err.Error())
+		tokenError(w, err)
+		return
+	}
+
+	claims := userClaims(user, ti.GetScope())
+	claims["sub"] = ti.GetUserID()
+	writeJSON(w, claims, nil, http.StatusOK)
+}
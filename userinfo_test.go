@@ -0,0 +1,112 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-oauth2/oauth2/v4/models"
+	"github.com/google/uuid"
+
+	"github.com/byebyebymyai/oauth2-api/ent"
+)
+
+// serveTestUser runs an RBAC service that returns user for every user id and
+// username.
+func serveTestUser(t *testing.T, user User) {
+	t.Helper()
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/user" {
+			json.NewEncoder(w).Encode([]User{user})
+			return
+		}
+		json.NewEncoder(w).Encode(user)
+	}))
+	t.Cleanup(ts.Close)
+	rbac = ts.URL
+	t.Cleanup(func() { rbac = "" })
+}
+
+func TestUserinfoHandler(t *testing.T) {
+	name := "Jane Doe"
+	serveTestUser(t, User{
+		Username: "jane",
+		Name:     &name,
+		Edges: &UserEdges{
+			Roles: []*Role{{Name: "admin"}},
+			Group: &Group{Code: "G1", Name: "Group"},
+		},
+	})
+	client := addTestClient(&ent.Oauth2Client{})
+
+	tests := []struct {
+		name   string
+		userID string
+		scope  string
+		status int
+		want   map[string]interface{}
+	}{
+		{
+			name:   "profile",
+			userID: uuid.NewString(),
+			scope:  "openid profile",
+			status: http.StatusOK,
+			want:   map[string]interface{}{"preferred_username": "jane", "name": name},
+		},
+		{
+			name:   "roles and group",
+			userID: uuid.NewString(),
+			scope:  "openid roles group",
+			status: http.StatusOK,
+			want: map[string]interface{}{
+				"roles": []interface{}{"admin"},
+				"group": map[string]interface{}{"code": "G1", "name": "Group"},
+			},
+		},
+		{name: "without openid", userID: uuid.NewString(), scope: "profile", status: http.StatusForbidden},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ti := storeTestToken(t, &models.Token{
+				ClientID:        client.GetID(),
+				UserID:          tt.userID,
+				Scope:           tt.scope,
+				Access:          uuid.NewString(),
+				AccessCreateAt:  time.Now(),
+				AccessExpiresIn: time.Hour,
+			})
+
+			r := httptest.NewRequest("GET", "/userinfo", nil)
+			r.Header.Set("Authorization", "Bearer "+ti.Access)
+			w := httptest.NewRecorder()
+			userinfoHandler(w, r)
+			if w.Code != tt.status {
+				t.Fatalf("status = %d, want %d, body %s", w.Code, tt.status, w.Body)
+			}
+			if tt.status != http.StatusOK {
+				return
+			}
+
+			var claims map[string]interface{}
+			if err := json.Unmarshal(w.Body.Bytes(), &claims); err != nil {
+				t.Fatal(err)
+			}
+			tt.want["sub"] = tt.userID
+			got, _ := json.Marshal(claims)
+			want, _ := json.Marshal(tt.want)
+			if string(got) != string(want) {
+				t.Errorf("claims = %s, want %s", got, want)
+			}
+		})
+	}
+
+	t.Run("without token", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		userinfoHandler(w, httptest.NewRequest("GET", "/userinfo", nil))
+		if got := w.Header().Get("WWW-Authenticate"); got != `Bearer error="invalid_token"` {
+			t.Errorf("WWW-Authenticate = %q", got)
+		}
+	})
+}